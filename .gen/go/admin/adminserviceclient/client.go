@@ -74,11 +74,35 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	PurgeTaskDLQ(
+		ctx context.Context,
+		Request *shared.PurgeTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ReadTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReadTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadTaskDLQResponse, error)
+
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *shared.RefreshWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	ReplayTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReplayTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReplayTaskDLQResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -243,6 +267,75 @@ func (c client) GetWorkflowExecutionRawHistoryV2(
 	return
 }
 
+func (c client) PurgeTaskDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PurgeTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PurgeTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PurgeTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReadTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReadTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadTaskDLQResponse, err error) {
+
+	args := admin.AdminService_ReadTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReadTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReadTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RefreshWorkflowTasks(
+	ctx context.Context,
+	_Request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RefreshWorkflowTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RefreshWorkflowTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -265,3 +358,26 @@ func (c client) RemoveTask(
 	err = admin.AdminService_RemoveTask_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ReplayTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReplayTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReplayTaskDLQResponse, err error) {
+
+	args := admin.AdminService_ReplayTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReplayTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReplayTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
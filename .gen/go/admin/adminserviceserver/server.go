@@ -66,10 +66,30 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	PurgeTaskDLQ(
+		ctx context.Context,
+		Request *shared.PurgeTaskDLQRequest,
+	) error
+
+	ReadTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReadTaskDLQRequest,
+	) (*shared.ReadTaskDLQResponse, error)
+
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *shared.RefreshWorkflowTasksRequest,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 	) error
+
+	ReplayTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReplayTaskDLQRequest,
+	) (*shared.ReplayTaskDLQResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -149,6 +169,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PurgeTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeTaskDLQ),
+				},
+				Signature:    "PurgeTaskDLQ(Request *shared.PurgeTaskDLQRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReadTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadTaskDLQ),
+				},
+				Signature:    "ReadTaskDLQ(Request *shared.ReadTaskDLQRequest) (*shared.ReadTaskDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RefreshWorkflowTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RefreshWorkflowTasks),
+				},
+				Signature:    "RefreshWorkflowTasks(Request *shared.RefreshWorkflowTasksRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -159,10 +212,21 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RemoveTask(Request *shared.RemoveTaskRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ReplayTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReplayTaskDLQ),
+				},
+				Signature:    "ReplayTaskDLQ(Request *shared.ReplayTaskDLQRequest) (*shared.ReplayTaskDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 7)
+	procedures := make([]transport.Procedure, 0, 11)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -283,6 +347,63 @@ func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire
 	return response, err
 }
 
+func (h handler) PurgeTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeTaskDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PurgeTaskDLQ_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReadTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ReadTaskDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ReadTaskDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshWorkflowTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RemoveTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -301,3 +422,22 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	}
 	return response, err
 }
+
+func (h handler) ReplayTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReplayTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ReplayTaskDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ReplayTaskDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
@@ -259,6 +259,101 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistoryV2(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistoryV2", args...)
 }
 
+// PurgeTaskDLQ responds to a PurgeTaskDLQ call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PurgeTaskDLQ(gomock.Any(), ...).Return(...)
+// 	... := client.PurgeTaskDLQ(...)
+func (m *MockClient) PurgeTaskDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PurgeTaskDLQ", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PurgeTaskDLQ(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeTaskDLQ", args...)
+}
+
+// ReadTaskDLQ responds to a ReadTaskDLQ call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ReadTaskDLQ(gomock.Any(), ...).Return(...)
+// 	... := client.ReadTaskDLQ(...)
+func (m *MockClient) ReadTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReadTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadTaskDLQResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReadTaskDLQ", args...)
+	success, _ = ret[i].(*shared.ReadTaskDLQResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReadTaskDLQ(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReadTaskDLQ", args...)
+}
+
+// RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RefreshWorkflowTasks(gomock.Any(), ...).Return(...)
+// 	... := client.RefreshWorkflowTasks(...)
+func (m *MockClient) RefreshWorkflowTasks(
+	ctx context.Context,
+	_Request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RefreshWorkflowTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RefreshWorkflowTasks", args...)
+}
+
 // RemoveTask responds to a RemoveTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -289,3 +384,36 @@ func (mr *_MockClientRecorder) RemoveTask(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
+
+// ReplayTaskDLQ responds to a ReplayTaskDLQ call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ReplayTaskDLQ(gomock.Any(), ...).Return(...)
+// 	... := client.ReplayTaskDLQ(...)
+func (m *MockClient) ReplayTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReplayTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReplayTaskDLQResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReplayTaskDLQ", args...)
+	success, _ = ret[i].(*shared.ReplayTaskDLQResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReplayTaskDLQ(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReplayTaskDLQ", args...)
+}
@@ -45,6 +45,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -75,18 +81,36 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
 		opts ...yarpc.CallOption,
 	) (*history.PollMutableStateResponse, error)
 
+	PurgeTaskDLQ(
+		ctx context.Context,
+		Request *shared.PurgeTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	QueryWorkflow(
 		ctx context.Context,
 		QueryRequest *history.QueryWorkflowRequest,
 		opts ...yarpc.CallOption,
 	) (*history.QueryWorkflowResponse, error)
 
+	ReadTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReadTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadTaskDLQResponse, error)
+
 	ReapplyEvents(
 		ctx context.Context,
 		ReapplyEventsRequest *history.ReapplyEventsRequest,
@@ -117,6 +141,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *history.RefreshWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -129,6 +159,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReplayTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReplayTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReplayTaskDLQResponse, error)
+
 	ReplicateEvents(
 		ctx context.Context,
 		ReplicateRequest *history.ReplicateEventsRequest,
@@ -236,6 +272,24 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
+
+	UpsertWorkflowExecutionAttributes(
+		ctx context.Context,
+		UpsertRequest *history.UpsertWorkflowExecutionAttributesRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the HistoryService service.
@@ -285,6 +339,29 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_DeleteWorkflowExecution_Helper.Args(_DeleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -400,6 +477,29 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollMutableState(
 	ctx context.Context,
 	_PollRequest *history.PollMutableStateRequest,
@@ -423,6 +523,29 @@ func (c client) PollMutableState(
 	return
 }
 
+func (c client) PurgeTaskDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PurgeTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PurgeTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PurgeTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) QueryWorkflow(
 	ctx context.Context,
 	_QueryRequest *history.QueryWorkflowRequest,
@@ -446,6 +569,29 @@ func (c client) QueryWorkflow(
 	return
 }
 
+func (c client) ReadTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReadTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadTaskDLQResponse, err error) {
+
+	args := history.HistoryService_ReadTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReadTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ReadTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReapplyEvents(
 	ctx context.Context,
 	_ReapplyEventsRequest *history.ReapplyEventsRequest,
@@ -561,6 +707,29 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) RefreshWorkflowTasks(
+	ctx context.Context,
+	_Request *history.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RefreshWorkflowTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RefreshWorkflowTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RefreshWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -607,6 +776,29 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) ReplayTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReplayTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReplayTaskDLQResponse, err error) {
+
+	args := history.HistoryService_ReplayTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReplayTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ReplayTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReplicateEvents(
 	ctx context.Context,
 	_ReplicateRequest *history.ReplicateEventsRequest,
@@ -1020,3 +1212,72 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_UnpauseWorkflowExecution_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UnpauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_UnpauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpsertWorkflowExecutionAttributes(
+	ctx context.Context,
+	_UpsertRequest *history.UpsertWorkflowExecutionAttributesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_UpsertWorkflowExecutionAttributes_Helper.Args(_UpsertRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpsertWorkflowExecutionAttributes_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_UpsertWorkflowExecutionAttributes_Helper.UnwrapResponse(&result)
+	return
+}
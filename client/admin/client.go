@@ -119,6 +119,70 @@ func (c *clientImpl) CloseShard(
 	return client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RefreshWorkflowTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReadTaskDLQ(
+	ctx context.Context,
+	request *shared.ReadTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadTaskDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReadTaskDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReplayTaskDLQ(
+	ctx context.Context,
+	request *shared.ReplayTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReplayTaskDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReplayTaskDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) PurgeTaskDLQ(
+	ctx context.Context,
+	request *shared.PurgeTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeTaskDLQ(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
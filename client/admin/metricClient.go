@@ -115,6 +115,78 @@ func (c *metricClient) CloseShard(
 	return err
 }
 
+func (c *metricClient) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRefreshWorkflowTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRefreshWorkflowTasksScope, metrics.CadenceClientLatency)
+	err := c.client.RefreshWorkflowTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRefreshWorkflowTasksScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReadTaskDLQ(
+	ctx context.Context,
+	request *shared.ReadTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadTaskDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReadTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReadTaskDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadTaskDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReadTaskDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ReplayTaskDLQ(
+	ctx context.Context,
+	request *shared.ReplayTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReplayTaskDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReplayTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReplayTaskDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReplayTaskDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReplayTaskDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PurgeTaskDLQ(
+	ctx context.Context,
+	request *shared.PurgeTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPurgeTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPurgeTaskDLQScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeTaskDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPurgeTaskDLQScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
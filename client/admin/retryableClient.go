@@ -104,6 +104,68 @@ func (c *retryableClient) CloseShard(
 	return err
 }
 
+func (c *retryableClient) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		var err error
+		err = c.client.RefreshWorkflowTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return err
+}
+
+func (c *retryableClient) ReadTaskDLQ(
+	ctx context.Context,
+	request *shared.ReadTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadTaskDLQResponse, error) {
+
+	var resp *shared.ReadTaskDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadTaskDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReplayTaskDLQ(
+	ctx context.Context,
+	request *shared.ReplayTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReplayTaskDLQResponse, error) {
+
+	var resp *shared.ReplayTaskDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReplayTaskDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeTaskDLQ(
+	ctx context.Context,
+	request *shared.PurgeTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		var err error
+		err = c.client.PurgeTaskDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -199,6 +199,15 @@ func (s *server) startService() common.Daemon {
 	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider)
 
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	if dc.GetBoolProperty(dynamicconfig.EnablePersistenceFaultInjection, false)() {
+		params.PersistenceConfig.FaultInjection = &config.FaultInjectionConfig{
+			TimeoutRate:            dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionTimeoutRate, 0),
+			ConditionFailedRate:    dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionConditionFailedRate, 0),
+			ShardOwnershipLostRate: dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionShardOwnershipLostRate, 0),
+			LatencyRate:            dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionLatencyRate, 0),
+			Latency:                dc.GetDurationProperty(dynamicconfig.PersistenceFaultInjectionLatency, 0),
+		}
+	}
 
 	params.Logger.Info("Starting service " + s.name)
 
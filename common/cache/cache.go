@@ -49,6 +49,10 @@ type Cache interface {
 
 	// Size returns the number of entries currently stored in the Cache
 	Size() int
+
+	// SizeInBytes returns the estimated size of the entries currently stored
+	// in the Cache, or 0 if the Cache has no SizeFunc
+	SizeInBytes() int
 }
 
 // Options control the behavior of the cache
@@ -66,6 +70,20 @@ type Options struct {
 	// RemovedFunc is an optional function called when an element
 	// is scheduled for deletion
 	RemovedFunc RemovedFunc
+
+	// MaxBytes bounds the total estimated size of the entries in the cache.
+	// Unpinned entries are evicted in LRU order once the bound is exceeded.
+	// Zero means no size bound. SizeFunc is required when MaxBytes is set
+	MaxBytes int
+
+	// SizeFunc is an optional function estimating the size in bytes of a
+	// value. In Pin mode the size is re-estimated when the value is released,
+	// so values may grow while they are pinned
+	SizeFunc SizeFunc
+
+	// EvictedFunc is an optional function called when an element is
+	// evicted from the cache
+	EvictedFunc EvictedFunc
 }
 
 // RemovedFunc is a type for notifying applications when an item is
@@ -74,6 +92,43 @@ type Options struct {
 // deletion, Cache calls go f(i)
 type RemovedFunc func(interface{})
 
+// SizeFunc is a type for estimating the size in bytes of a value stored
+// in the Cache. It is called with the Cache lock held and must be cheap
+type SizeFunc func(interface{}) int
+
+// EvictedFunc is a type for notifying applications when an item is
+// evicted from the Cache, along with the reason of the eviction and the
+// total size in bytes of the Cache after the eviction. It is called
+// synchronously with the Cache lock held, so it must not call back into
+// the Cache
+type EvictedFunc func(reason EvictionReason, sizeInBytes int)
+
+// EvictionReason is the reason an item was evicted from the Cache
+type EvictionReason int
+
+const (
+	// EvictionReasonCount means the item was evicted to honor the max entry count
+	EvictionReasonCount EvictionReason = iota
+	// EvictionReasonSize means the item was evicted to honor Options.MaxBytes
+	EvictionReasonSize
+	// EvictionReasonExpired means the item was evicted because its TTL elapsed
+	EvictionReasonExpired
+)
+
+// String returns the name of the eviction reason
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionReasonCount:
+		return "count"
+	case EvictionReasonSize:
+		return "size"
+	case EvictionReasonExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
 // Iterator represents the interface for cache iterators
 type Iterator interface {
 	// Close closes the iterator
@@ -35,13 +35,17 @@ var (
 // lru is a concurrent fixed size cache that evicts elements in lru order
 type (
 	lru struct {
-		mut      sync.Mutex
-		byAccess *list.List
-		byKey    map[interface{}]*list.Element
-		maxSize  int
-		ttl      time.Duration
-		pin      bool
-		rmFunc   RemovedFunc
+		mut         sync.Mutex
+		byAccess    *list.List
+		byKey       map[interface{}]*list.Element
+		maxSize     int
+		maxBytes    int
+		currBytes   int
+		ttl         time.Duration
+		pin         bool
+		rmFunc      RemovedFunc
+		sizeFunc    SizeFunc
+		evictedFunc EvictedFunc
 	}
 
 	iteratorImpl struct {
@@ -55,6 +59,7 @@ type (
 		createTime time.Time
 		value      interface{}
 		refCount   int
+		size       int
 	}
 )
 
@@ -91,7 +96,7 @@ func (it *iteratorImpl) prepareNext() {
 		entry := it.nextItem.Value.(*entryImpl)
 		if it.lru.isEntryExpired(entry, it.createTime) {
 			nextItem := it.nextItem.Next()
-			it.lru.deleteInternal(it.nextItem)
+			it.lru.evictInternal(it.nextItem, EvictionReasonExpired)
 			it.nextItem = nextItem
 		} else {
 			return
@@ -130,14 +135,20 @@ func New(maxSize int, opts *Options) Cache {
 	if opts == nil {
 		opts = &Options{}
 	}
+	if opts.MaxBytes > 0 && opts.SizeFunc == nil {
+		panic("Cannot use MaxBytes without SizeFunc")
+	}
 
 	return &lru{
-		byAccess: list.New(),
-		byKey:    make(map[interface{}]*list.Element, opts.InitialCapacity),
-		ttl:      opts.TTL,
-		maxSize:  maxSize,
-		pin:      opts.Pin,
-		rmFunc:   opts.RemovedFunc,
+		byAccess:    list.New(),
+		byKey:       make(map[interface{}]*list.Element, opts.InitialCapacity),
+		ttl:         opts.TTL,
+		maxSize:     maxSize,
+		maxBytes:    opts.MaxBytes,
+		pin:         opts.Pin,
+		rmFunc:      opts.RemovedFunc,
+		sizeFunc:    opts.SizeFunc,
+		evictedFunc: opts.EvictedFunc,
 	}
 }
 
@@ -169,7 +180,7 @@ func (c *lru) Get(key interface{}) interface{} {
 
 	if c.isEntryExpired(entry, time.Now()) {
 		// Entry has expired
-		c.deleteInternal(element)
+		c.evictInternal(element, EvictionReasonExpired)
 		return nil
 	}
 
@@ -226,6 +237,10 @@ func (c *lru) Release(key interface{}) {
 	}
 	entry := elt.Value.(*entryImpl)
 	entry.refCount--
+
+	// the value may have changed while it was pinned
+	c.updateSize(entry)
+	c.evictBySize()
 }
 
 // Size returns the number of entries currently in the lru, useful if cache is not full
@@ -236,6 +251,14 @@ func (c *lru) Size() int {
 	return len(c.byKey)
 }
 
+// SizeInBytes returns the estimated size of the entries currently in the lru
+func (c *lru) SizeInBytes() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return c.currBytes
+}
+
 // Put puts a new value associated with a given key, returning the existing value (if present)
 // allowUpdate flag is used to control overwrite behavior if the value exists
 func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool) (interface{}, error) {
@@ -247,7 +270,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		entry := elt.Value.(*entryImpl)
 		if c.isEntryExpired(entry, time.Now()) {
 			// Entry has expired
-			c.deleteInternal(elt)
+			c.evictInternal(elt, EvictionReasonExpired)
 		} else {
 			existing := entry.value
 			if allowUpdate {
@@ -255,12 +278,14 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 				if c.ttl != 0 {
 					entry.createTime = time.Now()
 				}
+				c.updateSize(entry)
 			}
 
 			c.byAccess.MoveToFront(elt)
 			if c.pin {
 				entry.refCount++
 			}
+			c.evictBySize()
 			return existing, nil
 		}
 	}
@@ -279,6 +304,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	}
 
 	c.byKey[key] = c.byAccess.PushFront(entry)
+	c.updateSize(entry)
 	if len(c.byKey) == c.maxSize {
 		oldest := c.byAccess.Back().Value.(*entryImpl)
 
@@ -289,17 +315,51 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			return nil, ErrCacheFull
 		}
 
-		c.deleteInternal(c.byAccess.Back())
+		c.evictInternal(c.byAccess.Back(), EvictionReasonCount)
 	}
+	c.evictBySize()
 
 	return nil, nil
 }
 
+// updateSize re-estimates the size of the entry and adjusts the total size of the lru
+func (c *lru) updateSize(entry *entryImpl) {
+	if c.sizeFunc == nil {
+		return
+	}
+	size := c.sizeFunc(entry.value)
+	c.currBytes += size - entry.size
+	entry.size = size
+}
+
+// evictBySize evicts unpinned entries in lru order until the total size fits in maxBytes.
+// Pinned entries are skipped, so the lru may stay above maxBytes until they are released.
+func (c *lru) evictBySize() {
+	if c.maxBytes <= 0 {
+		return
+	}
+	for elt := c.byAccess.Back(); elt != nil && c.currBytes > c.maxBytes; {
+		prev := elt.Prev()
+		if elt.Value.(*entryImpl).refCount == 0 {
+			c.evictInternal(elt, EvictionReasonSize)
+		}
+		elt = prev
+	}
+}
+
+func (c *lru) evictInternal(element *list.Element, reason EvictionReason) {
+	c.deleteInternal(element)
+	if c.evictedFunc != nil {
+		c.evictedFunc(reason, c.currBytes)
+	}
+}
+
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	if c.rmFunc != nil {
 		go c.rmFunc(entry.value)
 	}
+	c.currBytes -= entry.size
 	delete(c.byKey, entry.key)
 }
 
@@ -263,3 +263,89 @@ func TestIterator(t *testing.T) {
 	it.Close()
 	assert.Equal(t, expected, actual)
 }
+
+func TestLRUWithMaxBytes(t *testing.T) {
+	var evictions []EvictionReason
+	var sizeAfterEviction int
+	cache := New(5, &Options{
+		MaxBytes: 10,
+		SizeFunc: func(value interface{}) int {
+			return len(value.(string))
+		},
+		EvictedFunc: func(reason EvictionReason, sizeInBytes int) {
+			evictions = append(evictions, reason)
+			sizeAfterEviction = sizeInBytes
+		},
+	})
+
+	cache.Put("A", "1234")
+	cache.Put("B", "1234")
+	assert.Equal(t, 8, cache.SizeInBytes())
+
+	// Access A, B is now LRU and gets evicted to fit C
+	cache.Get("A")
+	cache.Put("C", "1234")
+	assert.Nil(t, cache.Get("B"))
+	assert.Equal(t, 8, sizeAfterEviction)
+	assert.Equal(t, "1234", cache.Get("A"))
+	assert.Equal(t, 8, cache.SizeInBytes())
+
+	// Growing an existing value also evicts
+	cache.Put("A", "1234567")
+	assert.Nil(t, cache.Get("C"))
+	assert.Equal(t, 7, cache.SizeInBytes())
+
+	cache.Delete("A")
+	assert.Equal(t, 0, cache.SizeInBytes())
+	assert.Equal(t, 0, cache.Size())
+
+	for i := 0; i < 5; i++ {
+		cache.Put(i, "1")
+	}
+	assert.Equal(t, 4, cache.Size())
+	assert.Equal(t, 4, cache.SizeInBytes())
+	assert.Equal(t, []EvictionReason{EvictionReasonSize, EvictionReasonSize, EvictionReasonCount}, evictions)
+}
+
+func TestLRUWithMaxBytes_Pin(t *testing.T) {
+	type value struct {
+		size int
+	}
+	cache := New(5, &Options{
+		Pin:      true,
+		MaxBytes: 10,
+		SizeFunc: func(v interface{}) int {
+			return v.(*value).size
+		},
+	})
+
+	_, err := cache.PutIfNotExist("B", &value{size: 4})
+	assert.NoError(t, err)
+	cache.Release("B")
+	a := &value{size: 4}
+	_, err = cache.PutIfNotExist("A", a)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, cache.SizeInBytes())
+
+	// A grows while pinned, B is evicted when A is released
+	a.size = 8
+	cache.Release("A")
+	assert.Equal(t, 8, cache.SizeInBytes())
+	assert.Nil(t, cache.Get("B"))
+	assert.Equal(t, a, cache.Get("A"))
+
+	// pinned entries are never evicted by size
+	_, err = cache.PutIfNotExist("C", &value{size: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 13, cache.SizeInBytes())
+	cache.Release("C")
+	assert.Equal(t, 8, cache.SizeInBytes())
+	assert.Nil(t, cache.Get("C"))
+	cache.Release("A")
+}
+
+func TestLRUWithMaxBytes_WithoutSizeFunc(t *testing.T) {
+	assert.Panics(t, func() {
+		New(5, &Options{MaxBytes: 10})
+	})
+}
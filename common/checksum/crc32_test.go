@@ -0,0 +1,53 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package checksum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+func TestCRC32OverThrift(t *testing.T) {
+	payload := &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr("some random run ID"),
+		},
+		HistoryLength: common.Int64Ptr(123),
+		StartTime:     common.Int64Ptr(456),
+	}
+
+	csum, err := GenerateCRC32(payload, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, FlavorIEEECRC32OverThriftBinary, csum.Flavor)
+	assert.Equal(t, 1, csum.Version)
+	assert.Len(t, csum.Value, 4)
+	assert.NoError(t, Verify(payload, csum))
+
+	payload.HistoryLength = common.Int64Ptr(124)
+	assert.Equal(t, ErrMismatch, Verify(payload, csum))
+
+	csum.Flavor = FlavorUnknown
+	assert.Error(t, Verify(payload, csum))
+}
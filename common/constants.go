@@ -58,11 +58,14 @@ const (
 
 // Data encoding types
 const (
-	EncodingTypeJSON     EncodingType = "json"
-	EncodingTypeThriftRW EncodingType = "thriftrw"
-	EncodingTypeGob      EncodingType = "gob"
-	EncodingTypeUnknown  EncodingType = "unknow"
-	EncodingTypeEmpty    EncodingType = ""
+	EncodingTypeJSON           EncodingType = "json"
+	EncodingTypeThriftRW       EncodingType = "thriftrw"
+	EncodingTypeThriftRWSnappy EncodingType = "thriftrw+snappy"
+	EncodingTypeThriftRWZstd   EncodingType = "thriftrw+zstd"
+	EncodingTypeEncrypted      EncodingType = "encrypted"
+	EncodingTypeGob            EncodingType = "gob"
+	EncodingTypeUnknown        EncodingType = "unknow"
+	EncodingTypeEmpty          EncodingType = ""
 )
 
 type (
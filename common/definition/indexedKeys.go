@@ -33,6 +33,7 @@ const (
 	CloseTime     = "CloseTime"
 	CloseStatus   = "CloseStatus"
 	HistoryLength = "HistoryLength"
+	Paused        = "Paused"
 	Encoding      = "Encoding"
 	KafkaKey      = "KafkaKey"
 
@@ -86,6 +87,7 @@ var systemIndexedKeys = map[string]interface{}{
 	CloseTime:     shared.IndexedValueTypeInt,
 	CloseStatus:   shared.IndexedValueTypeInt,
 	HistoryLength: shared.IndexedValueTypeInt,
+	Paused:        shared.IndexedValueTypeBool,
 }
 
 // IsSystemIndexedKey return true is key is system added
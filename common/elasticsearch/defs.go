@@ -33,6 +33,7 @@ const (
 	CloseTime     = "CloseTime"
 	CloseStatus   = "CloseStatus"
 	HistoryLength = "HistoryLength"
+	Paused        = "Paused"
 	Memo          = "Memo"
 	Encoding      = "Encoding"
 
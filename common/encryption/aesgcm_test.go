@@ -0,0 +1,53 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	plaintext := []byte("workflow payload")
+	additionalData := []byte("key-1")
+
+	ciphertext1, err := Encrypt(key, plaintext, additionalData)
+	require.NoError(t, err)
+	ciphertext2, err := Encrypt(key, plaintext, additionalData)
+	require.NoError(t, err)
+	require.NotEqual(t, ciphertext1, ciphertext2)
+
+	decrypted, err := Decrypt(key, ciphertext1, additionalData)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypted)
+
+	_, err = Decrypt(key, ciphertext1, []byte("key-2"))
+	require.Equal(t, ErrInvalidCiphertext, err)
+	_, err = Decrypt([]byte("fedcba9876543210fedcba9876543210"), ciphertext1, additionalData)
+	require.Equal(t, ErrInvalidCiphertext, err)
+	_, err = Decrypt(key, ciphertext1[:4], additionalData)
+	require.Equal(t, ErrInvalidCiphertext, err)
+
+	_, err = Encrypt([]byte("short"), plaintext, additionalData)
+	require.Equal(t, ErrInvalidKey, err)
+}
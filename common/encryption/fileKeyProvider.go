@@ -0,0 +1,93 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// keyFile is the on disk format of the key file, keys are base64 encoded
+	//
+	//   activeKeyID: key-2
+	//   keys:
+	//     key-1: <base64 encoded key>
+	//     key-2: <base64 encoded key>
+	keyFile struct {
+		ActiveKeyID string            `yaml:"activeKeyID"`
+		Keys        map[string]string `yaml:"keys"`
+	}
+
+	fileKeyProvider struct {
+		activeKeyID string
+		keys        map[string][]byte
+	}
+)
+
+var _ KeyProvider = (*fileKeyProvider)(nil)
+
+// NewFileKeyProvider returns a KeyProvider which serves keys loaded from a local key file
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file %v: %v", path, err)
+	}
+	var file keyFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse key file %v: %v", path, err)
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for keyID, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key file %v: key %v is not base64 encoded: %v", path, keyID, err)
+		}
+		if _, err := newAEAD(key); err != nil {
+			return nil, fmt.Errorf("key file %v: key %v: %v", path, keyID, err)
+		}
+		keys[keyID] = key
+	}
+	if _, ok := keys[file.ActiveKeyID]; !ok {
+		return nil, fmt.Errorf("key file %v: active key %q is not defined", path, file.ActiveKeyID)
+	}
+
+	return &fileKeyProvider{
+		activeKeyID: file.ActiveKeyID,
+		keys:        keys,
+	}, nil
+}
+
+func (p *fileKeyProvider) ActiveKeyID() string {
+	return p.activeKeyID
+}
+
+func (p *fileKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
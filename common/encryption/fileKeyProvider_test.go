@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	fileKeyProviderSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestFileKeyProviderSuite(t *testing.T) {
+	suite.Run(t, new(fileKeyProviderSuite))
+}
+
+func (s *fileKeyProviderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *fileKeyProviderSuite) TestNewFileKeyProvider() {
+	key1 := make([]byte, 32)
+	key2 := make([]byte, 16)
+	key2[0] = 1
+	path := s.writeKeyFile(`
+activeKeyID: key-2
+keys:
+  key-1: ` + base64.StdEncoding.EncodeToString(key1) + `
+  key-2: ` + base64.StdEncoding.EncodeToString(key2) + `
+`)
+	defer os.Remove(path)
+
+	provider, err := NewFileKeyProvider(path)
+	s.NoError(err)
+	s.Equal("key-2", provider.ActiveKeyID())
+
+	key, err := provider.GetKey("key-1")
+	s.NoError(err)
+	s.Equal(key1, key)
+	key, err = provider.GetKey("key-2")
+	s.NoError(err)
+	s.Equal(key2, key)
+
+	_, err = provider.GetKey("key-3")
+	s.Equal(ErrKeyNotFound, err)
+}
+
+func (s *fileKeyProviderSuite) TestNewFileKeyProvider_Invalid() {
+	testCases := []string{
+		"activeKeyID: key-1\nkeys:\n  key-1: not-base64!\n",
+		"activeKeyID: key-1\nkeys:\n  key-1: " + base64.StdEncoding.EncodeToString([]byte("short")) + "\n",
+		"activeKeyID: key-2\nkeys:\n  key-1: " + base64.StdEncoding.EncodeToString(make([]byte, 32)) + "\n",
+		"keys: [",
+	}
+	for _, content := range testCases {
+		path := s.writeKeyFile(content)
+		_, err := NewFileKeyProvider(path)
+		s.Error(err)
+		os.Remove(path)
+	}
+
+	_, err := NewFileKeyProvider("/path/does/not/exist")
+	s.Error(err)
+}
+
+func (s *fileKeyProviderSuite) writeKeyFile(content string) string {
+	file, err := ioutil.TempFile("", "cadence-keys")
+	s.NoError(err)
+	defer file.Close()
+	_, err = file.WriteString(content)
+	s.NoError(err)
+	return file.Name()
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"errors"
+)
+
+type (
+	// KeyProvider provides the symmetric keys used to encrypt payloads at rest. Every key is
+	// identified by a key ID which is recorded alongside the encrypted payload, so that keys
+	// can be rotated by switching the active key while keeping the old ones readable
+	KeyProvider interface {
+		// ActiveKeyID returns the ID of the key which should be used to encrypt new payloads
+		ActiveKeyID() string
+		// GetKey returns the key for given key ID
+		GetKey(keyID string) ([]byte, error)
+	}
+)
+
+var (
+	// ErrKeyNotFound indicates that the requested key ID is unknown to the key provider
+	ErrKeyNotFound = errors.New("encryption key not found")
+	// ErrInvalidKey indicates that the key is not a valid AES-128, AES-192 or AES-256 key
+	ErrInvalidKey = errors.New("encryption key must be 16, 24 or 32 bytes")
+	// ErrInvalidCiphertext indicates that the ciphertext is malformed or cannot be authenticated
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+)
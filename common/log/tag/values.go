@@ -36,6 +36,12 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionUpsertWorkflowMemo             = workflowAction("add-workflow-upsert-memo-event")
+	WorkflowActionWorkflowUpdateAccepted         = workflowAction("add-workflow-update-accepted-event")
+	WorkflowActionWorkflowUpdateCompleted        = workflowAction("add-workflow-update-completed-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
+	WorkflowActionWorkflowAttributesUpserted     = workflowAction("add-workflow-attributes-upserted-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -120,6 +126,7 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentScheduler                = component("scheduler")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 )
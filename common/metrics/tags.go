@@ -20,6 +20,10 @@
 
 package metrics
 
+import (
+	"strconv"
+)
+
 const (
 	revisionTag     = "revision"
 	branchTag       = "branch"
@@ -27,10 +31,12 @@ const (
 	buildVersionTag = "build_version"
 	goVersionTag    = "go_version"
 
-	instance      = "instance"
-	domain        = "domain"
-	targetCluster = "target_cluster"
-	taskList      = "tasklist"
+	instance       = "instance"
+	domain         = "domain"
+	targetCluster  = "target_cluster"
+	taskList       = "tasklist"
+	evictionReason = "eviction_reason"
+	shard          = "shard"
 
 	domainAllValue = "all"
 	unknownValue   = "_unknown_"
@@ -60,6 +66,14 @@ type (
 	taskListTag struct {
 		value string
 	}
+
+	evictionReasonTag struct {
+		value string
+	}
+
+	shardTag struct {
+		value string
+	}
 )
 
 // DomainTag returns a new domain tag. For timers, this also ensures that we
@@ -147,3 +161,36 @@ func (d taskListTag) Key() string {
 func (d taskListTag) Value() string {
 	return d.value
 }
+
+// CacheEvictionReasonTag returns a new cache eviction reason tag.
+func CacheEvictionReasonTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return evictionReasonTag{value}
+}
+
+// Key returns the key of the cache eviction reason tag
+func (d evictionReasonTag) Key() string {
+	return evictionReason
+}
+
+// Value returns the value of the cache eviction reason tag
+func (d evictionReasonTag) Value() string {
+	return d.value
+}
+
+// ShardTag returns a new shard tag.
+func ShardTag(shardID int) Tag {
+	return shardTag{strconv.Itoa(shardID)}
+}
+
+// Key returns the key of the shard tag
+func (d shardTag) Key() string {
+	return shard
+}
+
+// Value returns the value of the shard tag
+func (d shardTag) Value() string {
+	return d.value
+}
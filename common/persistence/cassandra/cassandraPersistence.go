@@ -169,7 +169,8 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`paused: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -180,6 +181,12 @@ const (
 		`last_replication_info: ?` +
 		`}`
 
+	templateChecksumType = `{` +
+		`version: ?, ` +
+		`flavor: ?, ` +
+		`value: ? ` +
+		`}`
+
 	templateTransferTaskType = `{` +
 		`domain_id: ?, ` +
 		`workflow_id: ?, ` +
@@ -371,16 +378,16 @@ workflow_state = ? ` +
 		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, {run_id: ?, create_request_id: ?, state: ?, close_status: ?}, {start_version: ?, last_write_version: ?}, ?, ?) IF NOT EXISTS USING TTL 0 `
 
 	templateCreateWorkflowExecutionQuery = `INSERT INTO executions (` +
-		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, visibility_ts, task_id) ` +
-		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ?, ?, ?) IF NOT EXISTS `
+		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, visibility_ts, task_id, checksum) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ?, ?, ?, ` + templateChecksumType + `) IF NOT EXISTS `
 
 	templateCreateWorkflowExecutionWithReplicationQuery = `INSERT INTO executions (` +
-		`shard_id, domain_id, workflow_id, run_id, type, execution, replication_state, next_event_id, visibility_ts, task_id) ` +
-		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ` + templateReplicationStateType + `, ?, ?, ?) IF NOT EXISTS `
+		`shard_id, domain_id, workflow_id, run_id, type, execution, replication_state, next_event_id, visibility_ts, task_id, checksum) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ` + templateReplicationStateType + `, ?, ?, ?, ` + templateChecksumType + `) IF NOT EXISTS `
 
 	templateCreateWorkflowExecutionWithVersionHistoriesQuery = `INSERT INTO executions (` +
-		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, visibility_ts, task_id, version_histories, version_histories_encoding) ` +
-		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ?, ?, ?, ?, ?) IF NOT EXISTS `
+		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, visibility_ts, task_id, version_histories, version_histories_encoding, checksum) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ?, ?, ?, ?, ?, ` + templateChecksumType + `) IF NOT EXISTS `
 
 	templateCreateTransferTaskQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, transfer, visibility_ts, task_id) ` +
@@ -405,7 +412,7 @@ workflow_state = ? ` +
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map, version_histories, version_histories_encoding ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map, version_histories, version_histories_encoding, checksum ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -439,6 +446,7 @@ workflow_state = ? ` +
 	templateUpdateWorkflowExecutionQuery = `UPDATE executions ` +
 		`SET execution = ` + templateWorkflowExecutionType +
 		`, next_event_id = ? ` +
+		`, checksum = ` + templateChecksumType + ` ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -452,6 +460,7 @@ workflow_state = ? ` +
 		`SET execution = ` + templateWorkflowExecutionType +
 		`, replication_state = ` + templateReplicationStateType +
 		`, next_event_id = ? ` +
+		`, checksum = ` + templateChecksumType + ` ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -466,6 +475,7 @@ workflow_state = ? ` +
 		`, next_event_id = ? ` +
 		`, version_histories = ? ` +
 		`, version_histories_encoding = ? ` +
+		`, checksum = ` + templateChecksumType + ` ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -686,6 +696,11 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateListConcreteExecutionsQuery = `SELECT domain_id, workflow_id, run_id ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
 	templateGetTransferTasksQuery = `SELECT transfer ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -755,6 +770,28 @@ workflow_state = ? ` +
 		`and visibility_ts >= ? ` +
 		`and visibility_ts < ?`
 
+	templatePutTaskToDLQQuery = `INSERT INTO task_dlq (` +
+		`shard_id, category, task_id, data, data_encoding) ` +
+		`VALUES(?, ?, ?, ?, ?)`
+
+	templateGetTasksFromDLQQuery = `SELECT data, data_encoding ` +
+		`FROM task_dlq ` +
+		`WHERE shard_id = ? ` +
+		`and category = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+
+	templateDeleteTaskFromDLQQuery = `DELETE FROM task_dlq ` +
+		`WHERE shard_id = ? ` +
+		`and category = ? ` +
+		`and task_id = ?`
+
+	templateRangeDeleteTasksFromDLQQuery = `DELETE FROM task_dlq ` +
+		`WHERE shard_id = ? ` +
+		`and category = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
 		`domain_id, task_list_name, task_list_type, type, task_id, task) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTaskType + `)`
@@ -1328,6 +1365,9 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *p.GetWorkflowExecut
 	}
 	state.BufferedEvents = bufferedEventsBlobs
 
+	cs := result["checksum"].(map[string]interface{})
+	state.Checksum = createChecksum(cs)
+
 	return &p.InternalGetWorkflowExecutionResponse{State: state}, nil
 }
 
@@ -2030,6 +2070,54 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
+func (d *cassandraPersistence) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.ListConcreteExecutionsResponse, error) {
+
+	query := d.session.Query(templateListConcreteExecutionsQuery,
+		d.shardID,
+		rowTypeExecution,
+	).PageSize(request.PageSize).PageState(request.PageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListConcreteExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.ListConcreteExecutionsResponse{}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		runID := result["run_id"].(gocql.UUID).String()
+		// the current execution records share the row type with the concrete ones
+		if runID != permanentRunID {
+			response.Executions = append(response.Executions, &p.ConcreteExecutionKey{
+				DomainID:   result["domain_id"].(gocql.UUID).String(),
+				WorkflowID: result["workflow_id"].(string),
+				RunID:      runID,
+			})
+		}
+		result = make(map[string]interface{})
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
 func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
@@ -2249,6 +2337,114 @@ func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTi
 	return nil
 }
 
+func (d *cassandraPersistence) PutTaskToDLQ(request *p.InternalPutTaskToDLQRequest) error {
+	query := d.session.Query(templatePutTaskToDLQQuery,
+		d.shardID,
+		request.Category,
+		request.TaskID,
+		request.Task.Data,
+		request.Task.GetEncoding(),
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutTaskToDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetTasksFromDLQ(
+	request *p.GetTasksFromDLQRequest,
+) (*p.InternalGetTasksFromDLQResponse, error) {
+
+	query := d.session.Query(templateGetTasksFromDLQQuery,
+		d.shardID,
+		request.Category,
+		request.ReadLevel,
+		request.MaxReadLevel,
+	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetTasksFromDLQ operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.InternalGetTasksFromDLQResponse{}
+	var data []byte
+	var encoding string
+	for iter.Scan(&data, &encoding) {
+		response.Tasks = append(response.Tasks, p.NewDataBlob(data, common.EncodingType(encoding)))
+		// Reset data to get it ready for next scan
+		data = nil
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetTasksFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) DeleteTaskFromDLQ(request *p.DeleteTaskFromDLQRequest) error {
+	query := d.session.Query(templateDeleteTaskFromDLQQuery,
+		d.shardID,
+		request.Category,
+		request.TaskID,
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) RangeDeleteTasksFromDLQ(request *p.RangeDeleteTasksFromDLQRequest) error {
+	query := d.session.Query(templateRangeDeleteTasksFromDLQQuery,
+		d.shardID,
+		request.Category,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("RangeDeleteTasksFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteTasksFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
 // From TaskManager interface
 func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
 	if len(request.TaskList) == 0 {
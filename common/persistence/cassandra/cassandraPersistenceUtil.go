@@ -27,6 +27,7 @@ import (
 	"github.com/gocql/gocql"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	p "github.com/uber/cadence/common/persistence"
 )
 
@@ -45,6 +46,7 @@ func applyWorkflowMutationBatch(
 	executionInfo := workflowMutation.ExecutionInfo
 	replicationState := workflowMutation.ReplicationState
 	versionHistories := workflowMutation.VersionHistories
+	checksum := workflowMutation.Checksum
 	domainID := executionInfo.DomainID
 	workflowID := executionInfo.WorkflowID
 	runID := executionInfo.RunID
@@ -56,6 +58,7 @@ func applyWorkflowMutationBatch(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		cqlNowTimestampMillis,
 		condition,
 	); err != nil {
@@ -160,6 +163,7 @@ func applyWorkflowSnapshotBatchAsReset(
 	executionInfo := workflowSnapshot.ExecutionInfo
 	replicationState := workflowSnapshot.ReplicationState
 	versionHistories := workflowSnapshot.VersionHistories
+	checksum := workflowSnapshot.Checksum
 	domainID := executionInfo.DomainID
 	workflowID := executionInfo.WorkflowID
 	runID := executionInfo.RunID
@@ -171,6 +175,7 @@ func applyWorkflowSnapshotBatchAsReset(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		cqlNowTimestampMillis,
 		condition,
 	); err != nil {
@@ -267,6 +272,7 @@ func applyWorkflowSnapshotBatchAsNew(
 	executionInfo := workflowSnapshot.ExecutionInfo
 	replicationState := workflowSnapshot.ReplicationState
 	versionHistories := workflowSnapshot.VersionHistories
+	checksum := workflowSnapshot.Checksum
 	domainID := executionInfo.DomainID
 	workflowID := executionInfo.WorkflowID
 	runID := executionInfo.RunID
@@ -277,6 +283,7 @@ func applyWorkflowSnapshotBatchAsNew(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		cqlNowTimestampMillis,
 	); err != nil {
 		return err
@@ -365,6 +372,7 @@ func createExecution(
 	executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
 	versionHistories *p.DataBlob,
+	checksum checksum.Checksum,
 	cqlNowTimestampMillis int64,
 ) error {
 
@@ -461,9 +469,13 @@ func createExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
 			executionInfo.NextEventID,
 			defaultVisibilityTimestamp,
-			rowTypeExecutionTaskID)
+			rowTypeExecutionTaskID,
+			checksum.Version,
+			checksum.Flavor,
+			checksum.Value)
 	} else if versionHistories != nil {
 		// TODO also need to set the start / current / last write version
 		versionHistoriesData, versionHistoriesEncoding := p.FromDataBlob(versionHistories)
@@ -531,11 +543,15 @@ func createExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
 			executionInfo.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID,
 			versionHistoriesData,
-			versionHistoriesEncoding)
+			versionHistoriesEncoding,
+			checksum.Version,
+			checksum.Flavor,
+			checksum.Value)
 	} else if replicationState != nil {
 		lastReplicationInfo := make(map[string]map[string]interface{})
 		for k, v := range replicationState.LastReplicationInfo {
@@ -606,6 +622,7 @@ func createExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -613,7 +630,10 @@ func createExecution(
 			lastReplicationInfo,
 			executionInfo.NextEventID,
 			defaultVisibilityTimestamp,
-			rowTypeExecutionTaskID)
+			rowTypeExecutionTaskID,
+			checksum.Version,
+			checksum.Flavor,
+			checksum.Value)
 	} else {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Create workflow execution with both version histories and replication state."),
@@ -628,6 +648,7 @@ func updateExecution(
 	executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
 	versionHistories *p.DataBlob,
+	checksum checksum.Checksum,
 	cqlNowTimestampMillis int64,
 	condition int64,
 ) error {
@@ -719,7 +740,11 @@ func updateExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
 			executionInfo.NextEventID,
+			checksum.Version,
+			checksum.Flavor,
+			checksum.Value,
 			shardID,
 			rowTypeExecution,
 			domainID,
@@ -790,9 +815,13 @@ func updateExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
 			executionInfo.NextEventID,
 			versionHistoriesData,
 			versionHistoriesEncoding,
+			checksum.Version,
+			checksum.Flavor,
+			checksum.Value,
 			shardID,
 			rowTypeExecution,
 			domainID,
@@ -866,12 +895,16 @@ func updateExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
 			replicationState.LastWriteEventID,
 			lastReplicationInfo,
 			executionInfo.NextEventID,
+			checksum.Version,
+			checksum.Flavor,
+			checksum.Value,
 			shardID,
 			rowTypeExecution,
 			domainID,
@@ -1927,6 +1960,8 @@ func createWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "paused":
+			info.Paused = v.(bool)
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
@@ -1965,6 +2000,29 @@ func createReplicationState(
 	return info
 }
 
+func createChecksum(
+	result map[string]interface{},
+) checksum.Checksum {
+
+	csum := checksum.Checksum{}
+	if len(result) == 0 {
+		return csum
+	}
+
+	for k, v := range result {
+		switch k {
+		case "version":
+			csum.Version = v.(int)
+		case "flavor":
+			csum.Flavor = checksum.Flavor(v.(int))
+		case "value":
+			csum.Value = v.([]byte)
+		}
+	}
+
+	return csum
+}
+
 func createTransferTaskInfo(
 	result map[string]interface{},
 ) *p.TransferTaskInfo {
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/codec"
 )
 
@@ -146,6 +147,12 @@ const (
 	TaskTypeWorkflowBackoffTimer
 )
 
+// Categories of tasks in the shard DLQ
+const (
+	DLQCategoryTransfer = iota + 1
+	DLQCategoryTimer
+)
+
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
 const UnknownNumRowsAffected = -1
 
@@ -313,6 +320,8 @@ type (
 		// Cron
 		CronSchedule      string
 		ExpirationSeconds int32
+		// Paused is set while the workflow execution is paused, no task is dispatched for it
+		Paused bool
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -619,6 +628,7 @@ type (
 		ReplicationState    *ReplicationState
 		BufferedEvents      []*workflow.HistoryEvent
 		VersionHistories    *VersionHistories
+		Checksum            checksum.Checksum
 	}
 
 	// ActivityInfo details.
@@ -736,6 +746,8 @@ type (
 		PreviousLastWriteVersion int64
 
 		NewWorkflowSnapshot WorkflowSnapshot
+
+		Encoding common.EncodingType // optional binary encoding type
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -769,6 +781,25 @@ type (
 		LastWriteVersion int64
 	}
 
+	// ListConcreteExecutionsRequest is request to ListConcreteExecutions
+	ListConcreteExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListConcreteExecutionsResponse is response to ListConcreteExecutions
+	ListConcreteExecutionsResponse struct {
+		Executions    []*ConcreteExecutionKey
+		NextPageToken []byte
+	}
+
+	// ConcreteExecutionKey identifies a concrete workflow execution of a shard
+	ConcreteExecutionKey struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
 	UpdateWorkflowExecutionRequest struct {
 		RangeID int64
@@ -869,6 +900,7 @@ type (
 		TimerTasks       []Task
 
 		Condition int64
+		Checksum  checksum.Checksum
 	}
 
 	// WorkflowSnapshot is used as generic workflow execution state snapshot
@@ -890,6 +922,7 @@ type (
 		TimerTasks       []Task
 
 		Condition int64
+		Checksum  checksum.Checksum
 	}
 
 	// DeleteWorkflowExecutionRequest is used to delete a workflow execution
@@ -907,6 +940,54 @@ type (
 		ShardID int
 	}
 
+	// DLQTaskInfo describes a transfer or timer task moved into the shard DLQ
+	// after exhausting its processing attempts, exactly one of TransferTask
+	// and TimerTask is set according to the category
+	DLQTaskInfo struct {
+		Category     int
+		TaskID       int64
+		Attempt      int
+		LastError    string
+		EnqueueTime  time.Time
+		TransferTask *TransferTaskInfo
+		TimerTask    *TimerTaskInfo
+	}
+
+	// PutTaskToDLQRequest is used to put a task into the shard DLQ
+	PutTaskToDLQRequest struct {
+		Task *DLQTaskInfo
+	}
+
+	// GetTasksFromDLQRequest is used to read tasks of a category from the shard DLQ
+	GetTasksFromDLQRequest struct {
+		Category int
+		// exclusive
+		ReadLevel int64
+		// inclusive
+		MaxReadLevel  int64
+		BatchSize     int
+		NextPageToken []byte
+	}
+
+	// GetTasksFromDLQResponse is the response to GetTasksFromDLQ
+	GetTasksFromDLQResponse struct {
+		Tasks         []*DLQTaskInfo
+		NextPageToken []byte
+	}
+
+	// DeleteTaskFromDLQRequest is used to delete a task from the shard DLQ
+	DeleteTaskFromDLQRequest struct {
+		Category int
+		TaskID   int64
+	}
+
+	// RangeDeleteTasksFromDLQRequest is used to delete a range of tasks from the shard DLQ
+	RangeDeleteTasksFromDLQRequest struct {
+		Category             int
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
 	// DeleteCurrentWorkflowExecutionRequest is used to delete the current workflow execution
 	DeleteCurrentWorkflowExecutionRequest struct {
 		DomainID   string
@@ -1334,6 +1415,8 @@ type (
 		NextPageToken []byte
 		// The shard to get history branch data
 		ShardID *int
+		// ReadFromPrimary skips the read replicas of the store, for callers which have to read their own writes
+		ReadFromPrimary bool
 	}
 
 	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
@@ -1489,6 +1572,8 @@ type (
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		// ListConcreteExecutions pages through the keys of all concrete executions of the shard
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
 
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -1506,6 +1591,12 @@ type (
 
 		// Remove Task due to corrupted data
 		DeleteTask(request *DeleteTaskRequest) error
+
+		// DLQ related methods
+		PutTaskToDLQ(request *PutTaskToDLQRequest) error
+		GetTasksFromDLQ(request *GetTasksFromDLQRequest) (*GetTasksFromDLQResponse, error)
+		DeleteTaskFromDLQ(request *DeleteTaskFromDLQRequest) error
+		RangeDeleteTasksFromDLQ(request *RangeDeleteTasksFromDLQRequest) error
 	}
 
 	// ExecutionManagerFactory creates an instance of ExecutionManager for a given shard
@@ -80,6 +80,7 @@ type (
 		CloseTime     int64
 		CloseStatus   workflow.WorkflowExecutionCloseStatus
 		HistoryLength int64
+		Paused        bool
 		Memo          []byte
 		Encoding      string
 		Attr          map[string]interface{}
@@ -160,6 +161,7 @@ func (v *esVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWor
 		request.Memo.GetEncoding(),
 		request.SearchAttributes,
 	)
+	msg.Fields[es.Paused] = &indexer.Field{Type: &es.FieldTypeBool, BoolData: common.BoolPtr(request.Paused)}
 	return v.producer.Publish(msg)
 }
 
@@ -897,6 +899,7 @@ func (v *esVisibilityStore) convertSearchResultToVisibilityRecord(hit *elastic.S
 		ExecutionTime:    time.Unix(0, source.ExecutionTime),
 		Memo:             p.NewDataBlob(source.Memo, common.EncodingType(source.Encoding)),
 		SearchAttributes: source.Attr,
+		Paused:           source.Paused,
 	}
 	if source.CloseTime != 0 {
 		record.CloseTime = time.Unix(0, source.CloseTime)
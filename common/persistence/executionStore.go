@@ -42,10 +42,11 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 func NewExecutionManagerImpl(
 	persistence ExecutionStore,
 	logger log.Logger,
+	serializer PayloadSerializer,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    serializer,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -76,6 +77,7 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 			SignalInfos:        response.State.SignalInfos,
 			SignalRequestedIDs: response.State.SignalRequestedIDs,
 			ReplicationState:   response.State.ReplicationState,
+			Checksum:           response.State.Checksum,
 		},
 	}
 
@@ -175,6 +177,7 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
+		Paused:                             info.Paused,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -257,6 +260,14 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		details, err := m.serializer.DecryptPayload(v.Details, v.ScheduledEvent.GetEncoding())
+		if err != nil {
+			return nil, err
+		}
+		lastFailureDetails, err := m.serializer.DecryptPayload(v.LastFailureDetails, v.ScheduledEvent.GetEncoding())
+		if err != nil {
+			return nil, err
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -269,7 +280,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			StartedTime:                    v.StartedTime,
 			ActivityID:                     v.ActivityID,
 			RequestID:                      v.RequestID,
-			Details:                        v.Details,
+			Details:                        details,
 			ScheduleToStartTimeout:         v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:         v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:            v.StartToCloseTimeout,
@@ -291,7 +302,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			NonRetriableErrors:             v.NonRetriableErrors,
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
-			LastFailureDetails:             v.LastFailureDetails,
+			LastFailureDetails:             lastFailureDetails,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos[k] = a
@@ -378,6 +389,14 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		details, err := m.serializer.EncryptPayload(v.Details, scheduledEvent.GetEncoding())
+		if err != nil {
+			return nil, err
+		}
+		lastFailureDetails, err := m.serializer.EncryptPayload(v.LastFailureDetails, scheduledEvent.GetEncoding())
+		if err != nil {
+			return nil, err
+		}
 		i := &InternalActivityInfo{
 			Version:                        v.Version,
 			ScheduleID:                     v.ScheduleID,
@@ -389,7 +408,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			StartedTime:                    v.StartedTime,
 			ActivityID:                     v.ActivityID,
 			RequestID:                      v.RequestID,
-			Details:                        v.Details,
+			Details:                        details,
 			ScheduleToStartTimeout:         v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:         v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:            v.StartToCloseTimeout,
@@ -411,7 +430,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			NonRetriableErrors:             v.NonRetriableErrors,
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
-			LastFailureDetails:             v.LastFailureDetails,
+			LastFailureDetails:             lastFailureDetails,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos = append(newInfos, i)
@@ -493,6 +512,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		ExpirationSeconds:                  info.ExpirationSeconds,
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
+		Paused:                             info.Paused,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -582,7 +602,10 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 	request *CreateWorkflowExecutionRequest,
 ) (*CreateWorkflowExecutionResponse, error) {
 
-	encoding := common.EncodingTypeThriftRW
+	encoding := request.Encoding
+	if encoding == common.EncodingTypeEmpty {
+		encoding = common.EncodingTypeThriftRW
+	}
 
 	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.NewWorkflowSnapshot, encoding)
 	if err != nil {
@@ -672,6 +695,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		TimerTasks:       input.TimerTasks,
 
 		Condition: input.Condition,
+		Checksum:  input.Checksum,
 	}, nil
 }
 
@@ -729,6 +753,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 		TimerTasks:       input.TimerTasks,
 
 		Condition: input.Condition,
+		Checksum:  input.Checksum,
 	}, nil
 }
 
@@ -781,6 +806,12 @@ func (m *executionManagerImpl) GetCurrentExecution(
 	return m.persistence.GetCurrentExecution(request)
 }
 
+func (m *executionManagerImpl) ListConcreteExecutions(
+	request *ListConcreteExecutionsRequest,
+) (*ListConcreteExecutionsResponse, error) {
+	return m.persistence.ListConcreteExecutions(request)
+}
+
 // Transfer task related methods
 func (m *executionManagerImpl) GetTransferTasks(
 	request *GetTransferTasksRequest,
@@ -832,6 +863,56 @@ func (m *executionManagerImpl) RangeCompleteTimerTask(
 	return m.persistence.RangeCompleteTimerTask(request)
 }
 
+// DLQ related methods.
+func (m *executionManagerImpl) PutTaskToDLQ(
+	request *PutTaskToDLQRequest,
+) error {
+
+	blob, err := m.serializer.SerializeDLQTask(request.Task)
+	if err != nil {
+		return err
+	}
+	return m.persistence.PutTaskToDLQ(&InternalPutTaskToDLQRequest{
+		Category: request.Task.Category,
+		TaskID:   request.Task.TaskID,
+		Task:     blob,
+	})
+}
+
+func (m *executionManagerImpl) GetTasksFromDLQ(
+	request *GetTasksFromDLQRequest,
+) (*GetTasksFromDLQResponse, error) {
+
+	response, err := m.persistence.GetTasksFromDLQ(request)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*DLQTaskInfo, 0, len(response.Tasks))
+	for _, blob := range response.Tasks {
+		task, err := m.serializer.DeserializeDLQTask(blob)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return &GetTasksFromDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+func (m *executionManagerImpl) DeleteTaskFromDLQ(
+	request *DeleteTaskFromDLQRequest,
+) error {
+	return m.persistence.DeleteTaskFromDLQ(request)
+}
+
+func (m *executionManagerImpl) RangeDeleteTasksFromDLQ(
+	request *RangeDeleteTasksFromDLQRequest,
+) error {
+	return m.persistence.RangeDeleteTasksFromDLQ(request)
+}
+
 func (m *executionManagerImpl) Close() {
 	m.persistence.Close()
 }
@@ -57,10 +57,11 @@ func NewHistoryV2ManagerImpl(
 	persistence HistoryV2Store,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	serializer PayloadSerializer,
 ) HistoryV2Manager {
 
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     serializer,
 		persistence:           persistence,
 		logger:                logger,
 		thriftEncoder:         codec.NewThriftRWEncoder(),
@@ -302,6 +303,12 @@ func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 	if err != nil {
 		return nil, err
 	}
+	// raw history is handed out for replication, so encrypted blobs are decrypted here
+	for i, blob := range dataBlobs {
+		if dataBlobs[i], err = m.historySerializer.DecryptBlob(blob); err != nil {
+			return nil, err
+		}
+	}
 
 	nextPageToken, err := m.serializeToken(token)
 	if err != nil {
@@ -412,6 +419,7 @@ func (m *historyV2ManagerImpl) readRawHistoryBranch(
 		LastTransactionID: token.LastTransactionID,
 		ShardID:           shardID,
 		PageSize:          pageSize,
+		ReadFromPrimary:   request.ReadFromPrimary,
 	}
 
 	resp, err := m.persistence.ReadHistoryBranch(req)
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/uber/cadence/common/log"
+)
+
+const (
+	// storeName is the name returned by GetName of all the memory stores
+	storeName = "memory"
+)
+
+type memoryStore struct {
+	db     *database
+	logger log.Logger
+}
+
+func (m *memoryStore) GetName() string {
+	return storeName
+}
+
+// Close is a noop, the state is owned by the database and outlives the store
+func (m *memoryStore) Close() {
+}
+
+func serializePageToken(offset int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(offset))
+	return b
+}
+
+func deserializePageToken(payload []byte) (int64, error) {
+	if len(payload) != 8 {
+		return 0, fmt.Errorf("Invalid token of %v length", len(payload))
+	}
+	return int64(binary.LittleEndian.Uint64(payload)), nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type copySuite struct {
+	suite.Suite
+}
+
+func TestCopySuite(t *testing.T) {
+	suite.Run(t, new(copySuite))
+}
+
+func (s *copySuite) TestDeepCopy_Nil() {
+	s.Nil(deepCopy(nil))
+	var info *p.ShardInfo
+	s.Nil(deepCopy(info).(*p.ShardInfo))
+}
+
+func (s *copySuite) TestDeepCopy_SharesNoState() {
+	now := time.Now()
+	info := &p.InternalWorkflowExecutionInfo{
+		DomainID:           "domain",
+		StartTimestamp:     now,
+		ExecutionContext:   []byte("context"),
+		CompletionEvent:    p.NewDataBlob([]byte("event"), common.EncodingTypeThriftRW),
+		NonRetriableErrors: []string{"error"},
+		Memo:               map[string][]byte{"key": []byte("value")},
+	}
+
+	result := deepCopy(info).(*p.InternalWorkflowExecutionInfo)
+	s.Equal(info, result)
+	s.True(now.Equal(result.StartTimestamp))
+
+	result.DomainID = "another domain"
+	result.ExecutionContext[0] = 'C'
+	result.CompletionEvent.Data[0] = 'E'
+	result.NonRetriableErrors[0] = "another error"
+	result.Memo["key"][0] = 'V'
+	result.Memo["another key"] = nil
+
+	s.Equal("domain", info.DomainID)
+	s.Equal([]byte("context"), info.ExecutionContext)
+	s.Equal([]byte("event"), info.CompletionEvent.Data)
+	s.Equal([]string{"error"}, info.NonRetriableErrors)
+	s.Equal(map[string][]byte{"key": []byte("value")}, info.Memo)
+}
+
+func (s *copySuite) TestDeepCopy_ThriftStruct() {
+	branch := &workflow.HistoryBranch{
+		TreeID:   common.StringPtr("tree"),
+		BranchID: common.StringPtr("branch"),
+		Ancestors: []*workflow.HistoryBranchRange{
+			{BranchID: common.StringPtr("ancestor"), EndNodeID: common.Int64Ptr(10)},
+		},
+	}
+
+	result := deepCopy(branch).(*workflow.HistoryBranch)
+	s.Equal(branch, result)
+
+	*result.TreeID = "another tree"
+	*result.Ancestors[0].EndNodeID = 20
+	s.Equal("tree", branch.GetTreeID())
+	s.Equal(int64(10), branch.Ancestors[0].GetEndNodeID())
+}
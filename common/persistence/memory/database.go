@@ -0,0 +1,195 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"sync"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// database holds the state of every store backed by one in-memory
+	// database. A single lock guards all of it, which gives each operation
+	// the same atomicity a transaction gives the sql stores
+	database struct {
+		sync.Mutex
+
+		// shard store
+		shards map[int]*p.ShardInfo
+
+		// execution store
+		executions        map[executionKey]*executionRow
+		currentExecutions map[currentExecutionKey]*currentExecutionRow
+		transferTasks     map[int]map[int64]*p.TransferTaskInfo
+		timerTasks        map[int]map[timerTaskKey]*p.TimerTaskInfo
+		replicationTasks  map[int]map[int64]*p.ReplicationTaskInfo
+		taskDLQ           map[taskDLQKey]map[int64]*p.DataBlob
+
+		// history store
+		historyNodes map[historyBranchKey]map[historyNodeKey]*p.DataBlob
+		historyTrees map[historyTreeKey]map[string]*historyTreeRow
+
+		// metadata store
+		domains             map[string]*p.InternalGetDomainResponse
+		notificationVersion int64
+
+		// task store
+		taskLists map[taskListKey]*p.TaskListInfo
+		tasks     map[taskListKey]map[int64]*p.TaskInfo
+
+		// visibility store
+		visibility map[visibilityKey]*p.VisibilityWorkflowExecutionInfo
+
+		// queue
+		queueMessages  map[common.QueueType][]*p.QueueMessage
+		queueAckLevels map[common.QueueType]map[string]int
+	}
+
+	executionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	currentExecutionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+	}
+
+	taskDLQKey struct {
+		shardID  int
+		category int
+	}
+
+	timerTaskKey struct {
+		visibilityTimestamp int64
+		taskID              int64
+	}
+
+	historyTreeKey struct {
+		shardID int
+		treeID  string
+	}
+
+	historyBranchKey struct {
+		shardID  int
+		treeID   string
+		branchID string
+	}
+
+	historyNodeKey struct {
+		nodeID int64
+		txnID  int64
+	}
+
+	taskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
+	visibilityKey struct {
+		domainID string
+		runID    string
+	}
+
+	executionRow struct {
+		executionInfo       *p.InternalWorkflowExecutionInfo
+		replicationState    *p.ReplicationState
+		versionHistories    *p.DataBlob
+		lastWriteVersion    int64
+		activityInfos       map[int64]*p.InternalActivityInfo
+		timerInfos          map[string]*p.TimerInfo
+		childExecutionInfos map[int64]*p.InternalChildExecutionInfo
+		requestCancelInfos  map[int64]*p.RequestCancelInfo
+		signalInfos         map[int64]*p.SignalInfo
+		signalRequestedIDs  map[string]struct{}
+		bufferedEvents      []*p.DataBlob
+	}
+
+	currentExecutionRow struct {
+		runID            string
+		createRequestID  string
+		state            int
+		closeStatus      int
+		startVersion     int64
+		lastWriteVersion int64
+	}
+
+	historyTreeRow struct {
+		ancestors   []*workflow.HistoryBranchRange
+		info        string
+		inProgress  bool
+		createdTime time.Time
+	}
+)
+
+var (
+	databasesLock sync.Mutex
+	databases     = make(map[string]*database)
+)
+
+// getDatabase returns the database with the given name, creating it
+// if this is the first time the name is seen in this process
+func getDatabase(name string) *database {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+	db, ok := databases[name]
+	if !ok {
+		db = newDatabase()
+		databases[name] = db
+	}
+	return db
+}
+
+// dropDatabase forgets the database with the given name. Stores that still
+// reference it keep working, but new stores will start from an empty database
+func dropDatabase(name string) {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+	delete(databases, name)
+}
+
+func newDatabase() *database {
+	return &database{
+		shards:            make(map[int]*p.ShardInfo),
+		executions:        make(map[executionKey]*executionRow),
+		currentExecutions: make(map[currentExecutionKey]*currentExecutionRow),
+		transferTasks:     make(map[int]map[int64]*p.TransferTaskInfo),
+		timerTasks:        make(map[int]map[timerTaskKey]*p.TimerTaskInfo),
+		replicationTasks:  make(map[int]map[int64]*p.ReplicationTaskInfo),
+		taskDLQ:           make(map[taskDLQKey]map[int64]*p.DataBlob),
+		historyNodes:      make(map[historyBranchKey]map[historyNodeKey]*p.DataBlob),
+		historyTrees:      make(map[historyTreeKey]map[string]*historyTreeRow),
+		domains:           make(map[string]*p.InternalGetDomainResponse),
+		taskLists:         make(map[taskListKey]*p.TaskListInfo),
+		tasks:             make(map[taskListKey]map[int64]*p.TaskInfo),
+		visibility:        make(map[visibilityKey]*p.VisibilityWorkflowExecutionInfo),
+		queueMessages:     make(map[common.QueueType][]*p.QueueMessage),
+		queueAckLevels:    make(map[common.QueueType]map[string]int),
+	}
+}
@@ -0,0 +1,867 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryExecutionStore struct {
+		memoryStore
+		shardID int
+	}
+
+	timerTaskPageToken struct {
+		TaskID    int64
+		Timestamp time.Time
+	}
+)
+
+var _ p.ExecutionStore = (*memoryExecutionStore)(nil)
+
+// newExecutionStore creates an instance of ExecutionStore
+func newExecutionStore(db *database, logger log.Logger, shardID int) p.ExecutionStore {
+	return &memoryExecutionStore{
+		shardID: shardID,
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+// txExecuteShardLocked runs fn with the database locked after checking that the
+// shard is still owned, the writes of fn are only applied if it succeeds
+func (m *memoryExecutionStore) txExecuteShardLocked(
+	rangeID int64,
+	fn func(txn *executionTxn) error,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkShardRangeID(m.shardID, rangeID); err != nil {
+		return err
+	}
+	txn := &executionTxn{}
+	if err := fn(txn); err != nil {
+		return err
+	}
+	txn.commit()
+	return nil
+}
+
+func (m *memoryExecutionStore) GetShardID() int {
+	return m.shardID
+}
+
+func (m *memoryExecutionStore) CreateWorkflowExecution(
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.CreateWorkflowExecutionResponse, error) {
+
+	if err := m.txExecuteShardLocked(request.RangeID, func(txn *executionTxn) error {
+		return m.createWorkflowExecutionTxn(txn, request)
+	}); err != nil {
+		return nil, err
+	}
+	return &p.CreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *memoryExecutionStore) createWorkflowExecutionTxn(
+	txn *executionTxn,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) error {
+
+	newWorkflow := request.NewWorkflowSnapshot
+	executionInfo := newWorkflow.ExecutionInfo
+	workflowID := executionInfo.WorkflowID
+
+	if err := p.ValidateCreateWorkflowModeState(
+		request.Mode,
+		newWorkflow,
+	); err != nil {
+		return err
+	}
+
+	switch request.Mode {
+	case p.CreateWorkflowModeContinueAsNew:
+		// cannot create workflow with continue as new mode
+		return &workflow.InternalServiceError{
+			Message: "CreateWorkflowExecution: operation failed, encounter invalid CreateWorkflowModeContinueAsNew",
+		}
+	}
+
+	currentKey := m.currentExecutionKey(executionInfo.DomainID, workflowID)
+
+	// current workflow record check
+	if row, ok := m.db.currentExecutions[currentKey]; ok {
+		// current run ID, last write version, current workflow state check
+		switch request.Mode {
+		case p.CreateWorkflowModeBrandNew:
+			return &p.WorkflowExecutionAlreadyStartedError{
+				Msg:              fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID),
+				StartRequestID:   row.createRequestID,
+				RunID:            row.runID,
+				State:            row.state,
+				CloseStatus:      row.closeStatus,
+				LastWriteVersion: row.lastWriteVersion,
+			}
+
+		case p.CreateWorkflowModeWorkflowIDReuse:
+			if request.PreviousLastWriteVersion != row.lastWriteVersion {
+				return &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"LastWriteVersion: %v, PreviousLastWriteVersion: %v",
+						workflowID, row.lastWriteVersion, request.PreviousLastWriteVersion),
+				}
+			}
+			if row.state != p.WorkflowStateCompleted {
+				return &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"State: %v, Expected: %v",
+						workflowID, row.state, p.WorkflowStateCompleted),
+				}
+			}
+			if row.runID != request.PreviousRunID {
+				return &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"RunID: %v, PreviousRunID: %v",
+						workflowID, row.runID, request.PreviousRunID),
+				}
+			}
+
+		case p.CreateWorkflowModeZombie:
+			// zombie workflow creation with existence of current record, this is a noop
+			if err := assertRunIDMismatch(executionInfo.RunID, row.runID); err != nil {
+				return err
+			}
+
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf(
+					"CreteWorkflowExecution: unknown mode: %v",
+					request.Mode,
+				),
+			}
+		}
+	}
+
+	if err := m.createOrUpdateCurrentExecution(txn,
+		request.Mode,
+		currentKey,
+		newCurrentExecutionRow(executionInfo, newWorkflow.StartVersion, newWorkflow.LastWriteVersion),
+	); err != nil {
+		return err
+	}
+
+	return m.applyWorkflowSnapshotAsNew(txn, &request.NewWorkflowSnapshot)
+}
+
+func (m *memoryExecutionStore) GetWorkflowExecution(
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	row, ok := m.db.executions[m.executionKey(
+		request.DomainID,
+		request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId(),
+	)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf(
+				"Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				request.Execution.GetWorkflowId(),
+				request.Execution.GetRunId(),
+			),
+		}
+	}
+
+	state := &p.InternalWorkflowMutableState{
+		ExecutionInfo:       deepCopy(row.executionInfo).(*p.InternalWorkflowExecutionInfo),
+		ReplicationState:    deepCopy(row.replicationState).(*p.ReplicationState),
+		VersionHistories:    deepCopy(row.versionHistories).(*p.DataBlob),
+		ActivityInfos:       deepCopy(row.activityInfos).(map[int64]*p.InternalActivityInfo),
+		TimerInfos:          deepCopy(row.timerInfos).(map[string]*p.TimerInfo),
+		ChildExecutionInfos: deepCopy(row.childExecutionInfos).(map[int64]*p.InternalChildExecutionInfo),
+		RequestCancelInfos:  deepCopy(row.requestCancelInfos).(map[int64]*p.RequestCancelInfo),
+		SignalInfos:         deepCopy(row.signalInfos).(map[int64]*p.SignalInfo),
+		SignalRequestedIDs:  deepCopy(row.signalRequestedIDs).(map[string]struct{}),
+		BufferedEvents:      deepCopy(row.bufferedEvents).([]*p.DataBlob),
+	}
+	return &p.InternalGetWorkflowExecutionResponse{State: state}, nil
+}
+
+func (m *memoryExecutionStore) UpdateWorkflowExecution(
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+
+	return m.txExecuteShardLocked(request.RangeID, func(txn *executionTxn) error {
+		return m.updateWorkflowExecutionTxn(txn, request)
+	})
+}
+
+func (m *memoryExecutionStore) updateWorkflowExecutionTxn(
+	txn *executionTxn,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+
+	updateWorkflow := request.UpdateWorkflowMutation
+	newWorkflow := request.NewWorkflowSnapshot
+
+	executionInfo := updateWorkflow.ExecutionInfo
+	domainID := executionInfo.DomainID
+	runID := executionInfo.RunID
+	currentKey := m.currentExecutionKey(domainID, executionInfo.WorkflowID)
+
+	if err := p.ValidateUpdateWorkflowModeState(
+		request.Mode,
+		updateWorkflow,
+		newWorkflow,
+	); err != nil {
+		return err
+	}
+
+	switch request.Mode {
+	case p.UpdateWorkflowModeBypassCurrent:
+		if err := m.assertNotCurrentExecution(currentKey, runID); err != nil {
+			return err
+		}
+
+	case p.UpdateWorkflowModeUpdateCurrent:
+		if newWorkflow != nil {
+			newExecutionInfo := newWorkflow.ExecutionInfo
+			if domainID != newExecutionInfo.DomainID {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("UpdateWorkflowExecution: cannot continue as new to another domain"),
+				}
+			}
+
+			if err := m.assertRunIDAndUpdateCurrentExecution(txn,
+				currentKey,
+				runID,
+				newCurrentExecutionRow(newExecutionInfo, newWorkflow.StartVersion, newWorkflow.LastWriteVersion),
+			); err != nil {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("UpdateWorkflowExecution: failed to continue as new current execution. Error: %v", err),
+				}
+			}
+		} else {
+			// this is only to update the current record
+			if err := m.assertRunIDAndUpdateCurrentExecution(txn,
+				currentKey,
+				runID,
+				newCurrentExecutionRow(executionInfo, updateWorkflow.StartVersion, updateWorkflow.LastWriteVersion),
+			); err != nil {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("UpdateWorkflowExecution: failed to update current execution. Error: %v", err),
+				}
+			}
+		}
+
+	default:
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution: unknown mode: %v", request.Mode),
+		}
+	}
+
+	if err := m.applyWorkflowMutation(txn, &updateWorkflow); err != nil {
+		return err
+	}
+	if newWorkflow != nil {
+		if err := m.applyWorkflowSnapshotAsNew(txn, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) ResetWorkflowExecution(
+	request *p.InternalResetWorkflowExecutionRequest,
+) error {
+
+	return m.txExecuteShardLocked(request.RangeID, func(txn *executionTxn) error {
+		return m.resetWorkflowExecutionTxn(txn, request)
+	})
+}
+
+func (m *memoryExecutionStore) resetWorkflowExecutionTxn(
+	txn *executionTxn,
+	request *p.InternalResetWorkflowExecutionRequest,
+) error {
+
+	newWorkflow := request.NewWorkflowSnapshot
+	newExecutionInfo := newWorkflow.ExecutionInfo
+	domainID := newExecutionInfo.DomainID
+	workflowID := newExecutionInfo.WorkflowID
+
+	// 1. update current execution
+	if err := m.updateCurrentExecution(txn,
+		m.currentExecutionKey(domainID, workflowID),
+		newCurrentExecutionRow(newExecutionInfo, newWorkflow.StartVersion, newWorkflow.LastWriteVersion),
+	); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed at updateCurrentExecution. Error: %v", err),
+		}
+	}
+
+	// 2. check base run: it is only needed when base run is not current run,
+	// because current run is checked anyway
+	if request.BaseRunID != request.CurrentRunID {
+		if err := m.lockAndCheckNextEventID(
+			m.executionKey(domainID, workflowID, request.BaseRunID),
+			request.BaseRunNextEventID,
+		); err != nil {
+			switch err.(type) {
+			case *p.ConditionFailedError:
+				return err
+			default:
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err),
+				}
+			}
+		}
+	}
+
+	// 3. update or check current run
+	if request.CurrentWorkflowMutation != nil {
+		if err := m.applyWorkflowMutation(txn, request.CurrentWorkflowMutation); err != nil {
+			return err
+		}
+	} else {
+		// even the current run is not running, we need to check the current run:
+		// 1). in case it is changed by conflict resolution
+		// 2). in case delete history timer kicks in if the base is current
+		if err := m.lockAndCheckNextEventID(
+			m.executionKey(domainID, workflowID, request.CurrentRunID),
+			request.CurrentRunNextEventID,
+		); err != nil {
+			switch err.(type) {
+			case *p.ConditionFailedError:
+				return err
+			default:
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err),
+				}
+			}
+		}
+	}
+
+	// 4. create the new reset workflow
+	return m.applyWorkflowSnapshotAsNew(txn, &request.NewWorkflowSnapshot)
+}
+
+func (m *memoryExecutionStore) ConflictResolveWorkflowExecution(
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+
+	return m.txExecuteShardLocked(request.RangeID, func(txn *executionTxn) error {
+		return m.conflictResolveWorkflowExecutionTxn(txn, request)
+	})
+}
+
+func (m *memoryExecutionStore) conflictResolveWorkflowExecutionTxn(
+	txn *executionTxn,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+
+	currentWorkflow := request.CurrentWorkflowMutation
+	resetWorkflow := request.ResetWorkflowSnapshot
+	newWorkflow := request.NewWorkflowSnapshot
+
+	currentKey := m.currentExecutionKey(resetWorkflow.ExecutionInfo.DomainID, resetWorkflow.ExecutionInfo.WorkflowID)
+
+	if err := p.ValidateConflictResolveWorkflowModeState(
+		request.Mode,
+		resetWorkflow,
+		newWorkflow,
+		currentWorkflow,
+	); err != nil {
+		return err
+	}
+
+	switch request.Mode {
+	case p.ConflictResolveWorkflowModeBypassCurrent:
+		if err := m.assertNotCurrentExecution(currentKey, resetWorkflow.ExecutionInfo.RunID); err != nil {
+			return err
+		}
+
+	case p.ConflictResolveWorkflowModeUpdateCurrent:
+		row := newCurrentExecutionRow(resetWorkflow.ExecutionInfo, resetWorkflow.StartVersion, resetWorkflow.LastWriteVersion)
+		if newWorkflow != nil {
+			row = newCurrentExecutionRow(newWorkflow.ExecutionInfo, newWorkflow.StartVersion, newWorkflow.LastWriteVersion)
+		}
+
+		var err error
+		if request.CurrentWorkflowCAS != nil {
+			err = m.assertAndUpdateCurrentExecution(txn, currentKey, request.CurrentWorkflowCAS, row)
+		} else if currentWorkflow != nil {
+			err = m.assertRunIDAndUpdateCurrentExecution(txn, currentKey, currentWorkflow.ExecutionInfo.RunID, row)
+		} else {
+			// reset workflow is current
+			err = m.assertRunIDAndUpdateCurrentExecution(txn, currentKey, resetWorkflow.ExecutionInfo.RunID, row)
+		}
+		if err != nil {
+			return &workflow.InternalServiceError{Message: fmt.Sprintf(
+				"ConflictResolveWorkflowExecution. Failed to comare and swap the current record. Error: %v",
+				err,
+			)}
+		}
+
+	default:
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ConflictResolveWorkflowExecution: unknown mode: %v", request.Mode),
+		}
+	}
+
+	if err := m.applyWorkflowSnapshotAsReset(txn, &resetWorkflow); err != nil {
+		return err
+	}
+	if currentWorkflow != nil {
+		if err := m.applyWorkflowMutation(txn, currentWorkflow); err != nil {
+			return err
+		}
+	}
+	if newWorkflow != nil {
+		if err := m.applyWorkflowSnapshotAsNew(txn, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) DeleteTask(request *p.DeleteTaskRequest) error {
+	// tasks in memory can never be corrupted
+	return nil
+}
+
+func (m *memoryExecutionStore) DeleteWorkflowExecution(
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.executions, m.executionKey(request.DomainID, request.WorkflowID, request.RunID))
+	return nil
+}
+
+// its possible for a new run of the same workflow to have started after the run we are deleting
+// here was finished. In that case, the current record will have the same workflowID but different
+// runID. The current record is only deleted if the runID is the same as the one we are deleting here
+func (m *memoryExecutionStore) DeleteCurrentWorkflowExecution(
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := m.currentExecutionKey(request.DomainID, request.WorkflowID)
+	if row, ok := m.db.currentExecutions[key]; ok && row.runID == request.RunID {
+		delete(m.db.currentExecutions, key)
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetCurrentExecution(
+	request *p.GetCurrentExecutionRequest,
+) (*p.GetCurrentExecutionResponse, error) {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	row, ok := m.db.currentExecutions[m.currentExecutionKey(request.DomainID, request.WorkflowID)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found. WorkflowId: %v", request.WorkflowID),
+		}
+	}
+	return &p.GetCurrentExecutionResponse{
+		StartRequestID:   row.createRequestID,
+		RunID:            row.runID,
+		State:            row.state,
+		CloseStatus:      row.closeStatus,
+		LastWriteVersion: row.lastWriteVersion,
+	}, nil
+}
+
+func (m *memoryExecutionStore) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.ListConcreteExecutionsResponse, error) {
+
+	var last *p.ConcreteExecutionKey
+	if len(request.PageToken) > 0 {
+		last = &p.ConcreteExecutionKey{}
+		if err := json.Unmarshal(request.PageToken, last); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing concrete execution page token: %v", err),
+			}
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var keys []*p.ConcreteExecutionKey
+	for key := range m.db.executions {
+		if key.shardID != m.shardID {
+			continue
+		}
+		execution := &p.ConcreteExecutionKey{DomainID: key.domainID, WorkflowID: key.workflowID, RunID: key.runID}
+		if last == nil || concreteExecutionKeyLess(last, execution) {
+			keys = append(keys, execution)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return concreteExecutionKeyLess(keys[i], keys[j])
+	})
+
+	resp := &p.ListConcreteExecutionsResponse{Executions: keys}
+	if len(keys) > request.PageSize {
+		resp.Executions = keys[:request.PageSize]
+		nextToken, err := json.Marshal(resp.Executions[request.PageSize-1])
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListConcreteExecutions: error serializing page token: %v", err),
+			}
+		}
+		resp.NextPageToken = nextToken
+	}
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) GetTransferTasks(
+	request *p.GetTransferTasksRequest,
+) (*p.GetTransferTasksResponse, error) {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	resp := &p.GetTransferTasksResponse{}
+	for taskID, task := range m.db.transferTasks[m.shardID] {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			resp.Tasks = append(resp.Tasks, deepCopy(task).(*p.TransferTaskInfo))
+		}
+	}
+	sort.Slice(resp.Tasks, func(i, j int) bool {
+		return resp.Tasks[i].TaskID < resp.Tasks[j].TaskID
+	})
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) CompleteTransferTask(
+	request *p.CompleteTransferTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.transferTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTransferTask(
+	request *p.RangeCompleteTransferTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.db.transferTasks[m.shardID]
+	for taskID := range tasks {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(tasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTasks(
+	request *p.GetReplicationTasksRequest,
+) (*p.GetReplicationTasksResponse, error) {
+
+	var readLevel int64
+	var err error
+	if len(request.NextPageToken) > 0 {
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		readLevel = request.ReadLevel
+	}
+	maxReadLevelInclusive := collection.MaxInt64(
+		readLevel+int64(request.BatchSize), request.MaxReadLevel)
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var tasks []*p.ReplicationTaskInfo
+	for taskID, task := range m.db.replicationTasks[m.shardID] {
+		if taskID > readLevel && taskID <= maxReadLevelInclusive {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) == 0 {
+		return &p.GetReplicationTasksResponse{}, nil
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].TaskID < tasks[j].TaskID
+	})
+	if len(tasks) > request.BatchSize {
+		tasks = tasks[:request.BatchSize]
+	}
+
+	resp := &p.GetReplicationTasksResponse{Tasks: make([]*p.ReplicationTaskInfo, len(tasks))}
+	for i, task := range tasks {
+		resp.Tasks[i] = deepCopy(task).(*p.ReplicationTaskInfo)
+	}
+	lastTaskID := tasks[len(tasks)-1].TaskID
+	if lastTaskID < request.MaxReadLevel {
+		resp.NextPageToken = serializePageToken(lastTaskID)
+	}
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) CompleteReplicationTask(
+	request *p.CompleteReplicationTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.replicationTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) GetTimerIndexTasks(
+	request *p.GetTimerIndexTasksRequest,
+) (*p.GetTimerIndexTasksResponse, error) {
+
+	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.MinTimestamp}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing timerTaskPageToken: %v", err),
+			}
+		}
+	}
+	minTimestamp := pageToken.Timestamp.UnixNano()
+	maxTimestamp := request.MaxTimestamp.UnixNano()
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var keys []timerTaskKey
+	for key := range m.db.timerTasks[m.shardID] {
+		if key.visibilityTimestamp >= maxTimestamp {
+			continue
+		}
+		if key.visibilityTimestamp > minTimestamp ||
+			(key.visibilityTimestamp >= minTimestamp && key.taskID >= pageToken.TaskID) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return timerTaskKeyLess(keys[i], keys[j])
+	})
+
+	resp := &p.GetTimerIndexTasksResponse{}
+	if len(keys) > request.BatchSize {
+		pageToken = &timerTaskPageToken{
+			TaskID:    keys[request.BatchSize].taskID,
+			Timestamp: time.Unix(0, keys[request.BatchSize].visibilityTimestamp),
+		}
+		keys = keys[:request.BatchSize]
+		nextToken, err := json.Marshal(pageToken)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetTimerTasks: error serializing page token: %v", err),
+			}
+		}
+		resp.NextPageToken = nextToken
+	}
+	resp.Timers = make([]*p.TimerTaskInfo, len(keys))
+	for i, key := range keys {
+		resp.Timers[i] = deepCopy(m.db.timerTasks[m.shardID][key]).(*p.TimerTaskInfo)
+	}
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) CompleteTimerTask(
+	request *p.CompleteTimerTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.timerTasks[m.shardID], timerTaskKey{
+		visibilityTimestamp: request.VisibilityTimestamp.UnixNano(),
+		taskID:              request.TaskID,
+	})
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTimerTask(
+	request *p.RangeCompleteTimerTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	start := request.InclusiveBeginTimestamp.UnixNano()
+	end := request.ExclusiveEndTimestamp.UnixNano()
+	tasks := m.db.timerTasks[m.shardID]
+	for key := range tasks {
+		if key.visibilityTimestamp >= start && key.visibilityTimestamp < end {
+			delete(tasks, key)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) PutTaskToDLQ(
+	request *p.InternalPutTaskToDLQRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskDLQKey{shardID: m.shardID, category: request.Category}
+	tasks, ok := m.db.taskDLQ[key]
+	if !ok {
+		tasks = make(map[int64]*p.DataBlob)
+		m.db.taskDLQ[key] = tasks
+	}
+	tasks[request.TaskID] = deepCopy(request.Task).(*p.DataBlob)
+	return nil
+}
+
+func (m *memoryExecutionStore) GetTasksFromDLQ(
+	request *p.GetTasksFromDLQRequest,
+) (*p.InternalGetTasksFromDLQResponse, error) {
+
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.db.taskDLQ[taskDLQKey{shardID: m.shardID, category: request.Category}]
+	var taskIDs []int64
+	for taskID := range tasks {
+		if taskID > readLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool {
+		return taskIDs[i] < taskIDs[j]
+	})
+
+	resp := &p.InternalGetTasksFromDLQResponse{}
+	if len(taskIDs) > request.BatchSize {
+		taskIDs = taskIDs[:request.BatchSize]
+		resp.NextPageToken = serializePageToken(taskIDs[len(taskIDs)-1])
+	}
+	for _, taskID := range taskIDs {
+		resp.Tasks = append(resp.Tasks, deepCopy(tasks[taskID]).(*p.DataBlob))
+	}
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) DeleteTaskFromDLQ(
+	request *p.DeleteTaskFromDLQRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.taskDLQ[taskDLQKey{shardID: m.shardID, category: request.Category}], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeDeleteTasksFromDLQ(
+	request *p.RangeDeleteTasksFromDLQRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.db.taskDLQ[taskDLQKey{shardID: m.shardID, category: request.Category}]
+	for taskID := range tasks {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(tasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) executionKey(domainID string, workflowID string, runID string) executionKey {
+	return executionKey{
+		shardID:    m.shardID,
+		domainID:   domainID,
+		workflowID: workflowID,
+		runID:      runID,
+	}
+}
+
+func (m *memoryExecutionStore) currentExecutionKey(domainID string, workflowID string) currentExecutionKey {
+	return currentExecutionKey{
+		shardID:    m.shardID,
+		domainID:   domainID,
+		workflowID: workflowID,
+	}
+}
+
+func timerTaskKeyLess(a, b timerTaskKey) bool {
+	if a.visibilityTimestamp != b.visibilityTimestamp {
+		return a.visibilityTimestamp < b.visibilityTimestamp
+	}
+	return a.taskID < b.taskID
+}
+
+func concreteExecutionKeyLess(a, b *p.ConcreteExecutionKey) bool {
+	if a.DomainID != b.DomainID {
+		return a.DomainID < b.DomainID
+	}
+	if a.WorkflowID != b.WorkflowID {
+		return a.WorkflowID < b.WorkflowID
+	}
+	return a.RunID < b.RunID
+}
@@ -0,0 +1,736 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// executionTxn buffers the writes of a single request. They are applied only
+// once every condition of the request holds, so that a failed request leaves
+// the database untouched just like a rolled back transaction would
+type executionTxn struct {
+	writes []func()
+}
+
+func (t *executionTxn) write(fn func()) {
+	t.writes = append(t.writes, fn)
+}
+
+func (t *executionTxn) commit() {
+	for _, fn := range t.writes {
+		fn()
+	}
+}
+
+func (m *memoryExecutionStore) applyWorkflowMutation(
+	txn *executionTxn,
+	workflowMutation *p.InternalWorkflowMutation,
+) error {
+
+	executionInfo := workflowMutation.ExecutionInfo
+	key := m.executionKey(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID)
+
+	if err := m.lockAndCheckNextEventID(key, workflowMutation.Condition); err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err),
+			}
+		}
+	}
+
+	// validate workflow state & close status
+	if err := p.ValidateUpdateWorkflowStateCloseStatus(
+		executionInfo.State,
+		executionInfo.CloseStatus); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to update executions row. Erorr: %v", err),
+		}
+	}
+
+	// TODO we should set the last update time on business logic layer
+	executionInfo.LastUpdatedTimestamp = time.Now()
+
+	if err := m.applyTasks(txn,
+		executionInfo,
+		workflowMutation.TransferTasks,
+		workflowMutation.ReplicationTasks,
+		workflowMutation.TimerTasks); err != nil {
+		return err
+	}
+
+	updated := newExecutionRow(
+		executionInfo,
+		workflowMutation.ReplicationState,
+		workflowMutation.VersionHistories,
+		workflowMutation.StartVersion,
+		workflowMutation.LastWriteVersion,
+	)
+	txn.write(func() {
+		row := m.db.executions[key]
+		row.executionInfo = updated.executionInfo
+		row.replicationState = updated.replicationState
+		row.versionHistories = updated.versionHistories
+		row.lastWriteVersion = updated.lastWriteVersion
+
+		for _, info := range workflowMutation.UpsertActivityInfos {
+			row.activityInfos[info.ScheduleID] = deepCopy(info).(*p.InternalActivityInfo)
+		}
+		for _, scheduleID := range workflowMutation.DeleteActivityInfos {
+			delete(row.activityInfos, scheduleID)
+		}
+		for _, info := range workflowMutation.UpsertTimerInfos {
+			row.timerInfos[info.TimerID] = deepCopy(info).(*p.TimerInfo)
+		}
+		for _, timerID := range workflowMutation.DeleteTimerInfos {
+			delete(row.timerInfos, timerID)
+		}
+		for _, info := range workflowMutation.UpsertChildExecutionInfos {
+			row.childExecutionInfos[info.InitiatedID] = deepCopy(info).(*p.InternalChildExecutionInfo)
+		}
+		if workflowMutation.DeleteChildExecutionInfo != nil {
+			delete(row.childExecutionInfos, *workflowMutation.DeleteChildExecutionInfo)
+		}
+		for _, info := range workflowMutation.UpsertRequestCancelInfos {
+			row.requestCancelInfos[info.InitiatedID] = deepCopy(info).(*p.RequestCancelInfo)
+		}
+		if workflowMutation.DeleteRequestCancelInfo != nil {
+			delete(row.requestCancelInfos, *workflowMutation.DeleteRequestCancelInfo)
+		}
+		for _, info := range workflowMutation.UpsertSignalInfos {
+			row.signalInfos[info.InitiatedID] = deepCopy(info).(*p.SignalInfo)
+		}
+		if workflowMutation.DeleteSignalInfo != nil {
+			delete(row.signalInfos, *workflowMutation.DeleteSignalInfo)
+		}
+		for _, signalRequestedID := range workflowMutation.UpsertSignalRequestedIDs {
+			row.signalRequestedIDs[signalRequestedID] = struct{}{}
+		}
+		if workflowMutation.DeleteSignalRequestedID != "" {
+			delete(row.signalRequestedIDs, workflowMutation.DeleteSignalRequestedID)
+		}
+
+		if workflowMutation.ClearBufferedEvents {
+			row.bufferedEvents = nil
+		}
+		if workflowMutation.NewBufferedEvents != nil {
+			row.bufferedEvents = append(row.bufferedEvents, deepCopy(workflowMutation.NewBufferedEvents).(*p.DataBlob))
+		}
+	})
+	return nil
+}
+
+func (m *memoryExecutionStore) applyWorkflowSnapshotAsReset(
+	txn *executionTxn,
+	workflowSnapshot *p.InternalWorkflowSnapshot,
+) error {
+
+	executionInfo := workflowSnapshot.ExecutionInfo
+	key := m.executionKey(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID)
+
+	if err := m.lockAndCheckNextEventID(key, workflowSnapshot.Condition); err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ConflictResolveWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err),
+			}
+		}
+	}
+
+	// validate workflow state & close status
+	if err := p.ValidateUpdateWorkflowStateCloseStatus(
+		executionInfo.State,
+		executionInfo.CloseStatus); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ConflictResolveWorkflowExecution operation failed. Failed to update executions row. Erorr: %v", err),
+		}
+	}
+
+	// TODO we should set the last update time on business logic layer
+	executionInfo.LastUpdatedTimestamp = time.Now()
+
+	if err := m.applyTasks(txn,
+		executionInfo,
+		workflowSnapshot.TransferTasks,
+		workflowSnapshot.ReplicationTasks,
+		workflowSnapshot.TimerTasks); err != nil {
+		return err
+	}
+
+	// buffered events are dropped along with the rest of the previous state
+	row := newExecutionRowFromSnapshot(workflowSnapshot)
+	txn.write(func() {
+		m.db.executions[key] = row
+	})
+	return nil
+}
+
+func (m *memoryExecutionStore) applyWorkflowSnapshotAsNew(
+	txn *executionTxn,
+	workflowSnapshot *p.InternalWorkflowSnapshot,
+) error {
+
+	executionInfo := workflowSnapshot.ExecutionInfo
+	key := m.executionKey(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID)
+
+	// validate workflow state & close status
+	if err := p.ValidateCreateWorkflowStateCloseStatus(
+		executionInfo.State,
+		executionInfo.CloseStatus); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to insert executions row. Erorr: %v", err),
+		}
+	}
+	if _, ok := m.db.executions[key]; ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf(
+				"CreateWorkflowExecution operation failed. Executions row with (shard, domain, workflow, run) = (%v,%v,%v,%v) already exists.",
+				key.shardID,
+				key.domainID,
+				key.workflowID,
+				key.runID,
+			),
+		}
+	}
+
+	// TODO we should set the start time and last update time on business logic layer
+	executionInfo.StartTimestamp = time.Now()
+	executionInfo.LastUpdatedTimestamp = executionInfo.StartTimestamp
+
+	if err := m.applyTasks(txn,
+		executionInfo,
+		workflowSnapshot.TransferTasks,
+		workflowSnapshot.ReplicationTasks,
+		workflowSnapshot.TimerTasks); err != nil {
+		return err
+	}
+
+	row := newExecutionRowFromSnapshot(workflowSnapshot)
+	txn.write(func() {
+		m.db.executions[key] = row
+	})
+	return nil
+}
+
+func (m *memoryExecutionStore) applyTasks(
+	txn *executionTxn,
+	executionInfo *p.InternalWorkflowExecutionInfo,
+	transferTasks []p.Task,
+	replicationTasks []p.Task,
+	timerTasks []p.Task,
+) error {
+
+	transferTaskInfos, err := m.buildTransferTasks(executionInfo, transferTasks)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to create transfer tasks. Error: %v", err),
+		}
+	}
+	replicationTaskInfos, err := m.buildReplicationTasks(executionInfo, replicationTasks)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to create replication tasks. Error: %v", err),
+		}
+	}
+	timerTaskInfos, err := m.buildTimerTasks(executionInfo, timerTasks)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to create timer tasks. Error: %v", err),
+		}
+	}
+
+	txn.write(func() {
+		if len(transferTaskInfos) > 0 && m.db.transferTasks[m.shardID] == nil {
+			m.db.transferTasks[m.shardID] = make(map[int64]*p.TransferTaskInfo)
+		}
+		for _, info := range transferTaskInfos {
+			m.db.transferTasks[m.shardID][info.TaskID] = info
+		}
+
+		if len(replicationTaskInfos) > 0 && m.db.replicationTasks[m.shardID] == nil {
+			m.db.replicationTasks[m.shardID] = make(map[int64]*p.ReplicationTaskInfo)
+		}
+		for _, info := range replicationTaskInfos {
+			m.db.replicationTasks[m.shardID][info.TaskID] = info
+		}
+
+		if len(timerTaskInfos) > 0 && m.db.timerTasks[m.shardID] == nil {
+			m.db.timerTasks[m.shardID] = make(map[timerTaskKey]*p.TimerTaskInfo)
+		}
+		for _, info := range timerTaskInfos {
+			m.db.timerTasks[m.shardID][timerTaskKey{
+				visibilityTimestamp: info.VisibilityTimestamp.UnixNano(),
+				taskID:              info.TaskID,
+			}] = info
+		}
+	})
+	return nil
+}
+
+func (m *memoryExecutionStore) buildTransferTasks(
+	executionInfo *p.InternalWorkflowExecutionInfo,
+	transferTasks []p.Task,
+) ([]*p.TransferTaskInfo, error) {
+
+	infos := make([]*p.TransferTaskInfo, len(transferTasks))
+	for i, task := range transferTasks {
+		if _, ok := m.db.transferTasks[m.shardID][task.GetTaskID()]; ok {
+			return nil, fmt.Errorf("transfer task with ID %v already exists", task.GetTaskID())
+		}
+
+		info := &p.TransferTaskInfo{
+			TaskID:              task.GetTaskID(),
+			DomainID:            executionInfo.DomainID,
+			WorkflowID:          executionInfo.WorkflowID,
+			RunID:               executionInfo.RunID,
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TargetDomainID:      executionInfo.DomainID,
+			TargetWorkflowID:    p.TransferTaskTransferTargetWorkflowID,
+		}
+
+		switch t := task.(type) {
+		case *p.ActivityTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+
+		case *p.DecisionTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+			info.RecordVisibility = t.RecordVisibility
+
+		case *p.CancelExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+
+		case *p.SignalExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+
+		case *p.StartChildExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.ScheduleID = t.InitiatedID
+
+		case *p.CloseExecutionTask,
+			*p.RecordWorkflowStartedTask,
+			*p.ResetWorkflowTask,
+			*p.UpsertWorkflowSearchAttributesTask:
+			// No explicit property needs to be set
+
+		default:
+			return nil, fmt.Errorf("unknow transfer type: %v", task.GetType())
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
+
+func (m *memoryExecutionStore) buildReplicationTasks(
+	executionInfo *p.InternalWorkflowExecutionInfo,
+	replicationTasks []p.Task,
+) ([]*p.ReplicationTaskInfo, error) {
+
+	infos := make([]*p.ReplicationTaskInfo, len(replicationTasks))
+	for i, task := range replicationTasks {
+		if _, ok := m.db.replicationTasks[m.shardID][task.GetTaskID()]; ok {
+			return nil, fmt.Errorf("replication task with ID %v already exists", task.GetTaskID())
+		}
+
+		info := &p.ReplicationTaskInfo{
+			TaskID:       task.GetTaskID(),
+			DomainID:     executionInfo.DomainID,
+			WorkflowID:   executionInfo.WorkflowID,
+			RunID:        executionInfo.RunID,
+			TaskType:     task.GetType(),
+			FirstEventID: common.EmptyEventID,
+			NextEventID:  common.EmptyEventID,
+			Version:      common.EmptyVersion,
+			ScheduledID:  common.EmptyEventID,
+		}
+
+		switch t := task.(type) {
+		case *p.HistoryReplicationTask:
+			info.FirstEventID = t.FirstEventID
+			info.NextEventID = t.NextEventID
+			info.Version = t.Version
+			info.BranchToken = append([]byte(nil), t.BranchToken...)
+			info.NewRunBranchToken = append([]byte(nil), t.NewRunBranchToken...)
+			info.ResetWorkflow = t.ResetWorkflow
+			info.LastReplicationInfo = copyReplicationInfo(t.LastReplicationInfo)
+
+		case *p.SyncActivityTask:
+			info.Version = t.Version
+			info.ScheduledID = t.ScheduledID
+
+		default:
+			return nil, fmt.Errorf("unknown replication task: %v", task.GetType())
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
+
+func (m *memoryExecutionStore) buildTimerTasks(
+	executionInfo *p.InternalWorkflowExecutionInfo,
+	timerTasks []p.Task,
+) ([]*p.TimerTaskInfo, error) {
+
+	infos := make([]*p.TimerTaskInfo, len(timerTasks))
+	for i, task := range timerTasks {
+		key := timerTaskKey{
+			visibilityTimestamp: task.GetVisibilityTimestamp().UnixNano(),
+			taskID:              task.GetTaskID(),
+		}
+		if _, ok := m.db.timerTasks[m.shardID][key]; ok {
+			return nil, fmt.Errorf("timer task with ID %v already exists", task.GetTaskID())
+		}
+
+		info := &p.TimerTaskInfo{
+			TaskID:              task.GetTaskID(),
+			DomainID:            executionInfo.DomainID,
+			WorkflowID:          executionInfo.WorkflowID,
+			RunID:               executionInfo.RunID,
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+		}
+
+		switch t := task.(type) {
+		case *p.DecisionTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+
+		case *p.ActivityTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+
+		case *p.UserTimerTask:
+			info.EventID = t.EventID
+
+		case *p.ActivityRetryTimerTask:
+			info.EventID = t.EventID
+			info.ScheduleAttempt = int64(t.Attempt)
+
+		case *p.WorkflowBackoffTimerTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+
+		case *p.WorkflowTimeoutTask:
+			// noop
+
+		case *p.DeleteHistoryEventTask:
+			// noop
+
+		default:
+			return nil, fmt.Errorf("unknown timer task: %v", task.GetType())
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
+
+func (m *memoryExecutionStore) createOrUpdateCurrentExecution(
+	txn *executionTxn,
+	createMode p.CreateWorkflowMode,
+	key currentExecutionKey,
+	row *currentExecutionRow,
+) error {
+
+	switch createMode {
+	case p.CreateWorkflowModeContinueAsNew:
+		if err := m.updateCurrentExecution(txn, key, row); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to continue as new. Error: %v", err),
+			}
+		}
+	case p.CreateWorkflowModeWorkflowIDReuse:
+		if err := m.updateCurrentExecution(txn, key, row); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to reuse workflow ID. Error: %v", err),
+			}
+		}
+	case p.CreateWorkflowModeBrandNew:
+		if _, ok := m.db.currentExecutions[key]; ok {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Current execution of workflow %v already exists.", key.workflowID),
+			}
+		}
+		txn.write(func() {
+			m.db.currentExecutions[key] = row
+		})
+	case p.CreateWorkflowModeZombie:
+		// noop
+	default:
+		return fmt.Errorf("Unknown workflow creation mode: %v", createMode)
+	}
+
+	return nil
+}
+
+func (m *memoryExecutionStore) lockAndCheckNextEventID(
+	key executionKey,
+	condition int64,
+) error {
+
+	row, ok := m.db.executions[key]
+	if !ok {
+		return &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf(
+				"Failed to lock executions row with (shard, domain, workflow, run) = (%v,%v,%v,%v) which does not exist.",
+				key.shardID,
+				key.domainID,
+				key.workflowID,
+				key.runID,
+			),
+		}
+	}
+	if row.executionInfo.NextEventID != condition {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("next_event_id was %v when it should have been %v.", row.executionInfo.NextEventID, condition),
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) assertNotCurrentExecution(
+	key currentExecutionKey,
+	runID string,
+) error {
+
+	return m.assertCurrentExecution(key, func(currentRow *currentExecutionRow) error {
+		return assertRunIDMismatch(runID, currentRow.runID)
+	})
+}
+
+func (m *memoryExecutionStore) assertRunIDAndUpdateCurrentExecution(
+	txn *executionTxn,
+	key currentExecutionKey,
+	previousRunID string,
+	row *currentExecutionRow,
+) error {
+
+	assertFn := func(currentRow *currentExecutionRow) error {
+		if currentRow.runID != previousRunID {
+			return &p.ConditionFailedError{Msg: fmt.Sprintf(
+				"Update current record failed failed. Current run ID was %v, expected %v",
+				currentRow.runID,
+				previousRunID,
+			)}
+		}
+		return nil
+	}
+	if err := m.assertCurrentExecution(key, assertFn); err != nil {
+		return err
+	}
+
+	return m.updateCurrentExecution(txn, key, row)
+}
+
+func (m *memoryExecutionStore) assertAndUpdateCurrentExecution(
+	txn *executionTxn,
+	key currentExecutionKey,
+	cas *p.CurrentWorkflowCAS,
+	row *currentExecutionRow,
+) error {
+
+	assertFn := func(currentRow *currentExecutionRow) error {
+		if currentRow.runID != cas.PrevRunID {
+			return &p.ConditionFailedError{Msg: fmt.Sprintf(
+				"Update current record failed failed. Current run ID was %v, expected %v",
+				currentRow.runID,
+				cas.PrevRunID,
+			)}
+		}
+		if currentRow.lastWriteVersion != cas.PrevLastWriteVersion {
+			return &p.ConditionFailedError{Msg: fmt.Sprintf(
+				"Update current record failed failed. Current last write version was %v, expected %v",
+				currentRow.lastWriteVersion,
+				cas.PrevLastWriteVersion,
+			)}
+		}
+		if currentRow.state != cas.PrevState {
+			return &p.ConditionFailedError{Msg: fmt.Sprintf(
+				"Update current record failed failed. Current state %v, expected %v",
+				currentRow.state,
+				cas.PrevState,
+			)}
+		}
+		return nil
+	}
+	if err := m.assertCurrentExecution(key, assertFn); err != nil {
+		return err
+	}
+
+	return m.updateCurrentExecution(txn, key, row)
+}
+
+func (m *memoryExecutionStore) assertCurrentExecution(
+	key currentExecutionKey,
+	assertFn func(currentRow *currentExecutionRow) error,
+) error {
+
+	currentRow, ok := m.db.currentExecutions[key]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Unable to load current record of workflow %v.", key.workflowID),
+		}
+	}
+	return assertFn(currentRow)
+}
+
+func assertRunIDMismatch(runID string, currentRunID string) error {
+	// zombie workflow creation with existence of current record, this is a noop
+	if currentRunID == runID {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"Assert not current record failed failed. Current run ID was %v, input %v",
+			currentRunID,
+			runID,
+		)}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) updateCurrentExecution(
+	txn *executionTxn,
+	key currentExecutionKey,
+	row *currentExecutionRow,
+) error {
+
+	if _, ok := m.db.currentExecutions[key]; !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ContinueAsNew failed. Current execution of workflow %v does not exist.", key.workflowID),
+		}
+	}
+	txn.write(func() {
+		m.db.currentExecutions[key] = row
+	})
+	return nil
+}
+
+func newCurrentExecutionRow(
+	executionInfo *p.InternalWorkflowExecutionInfo,
+	startVersion int64,
+	lastWriteVersion int64,
+) *currentExecutionRow {
+
+	return &currentExecutionRow{
+		runID:            executionInfo.RunID,
+		createRequestID:  executionInfo.CreateRequestID,
+		state:            executionInfo.State,
+		closeStatus:      executionInfo.CloseStatus,
+		startVersion:     startVersion,
+		lastWriteVersion: lastWriteVersion,
+	}
+}
+
+// newExecutionRow copies the execution level state of a workflow. The versions
+// of the stored replication state are the ones of the request, as with the
+// other stores
+func newExecutionRow(
+	executionInfo *p.InternalWorkflowExecutionInfo,
+	replicationState *p.ReplicationState,
+	versionHistories *p.DataBlob,
+	startVersion int64,
+	lastWriteVersion int64,
+) *executionRow {
+
+	row := &executionRow{
+		executionInfo:       deepCopy(executionInfo).(*p.InternalWorkflowExecutionInfo),
+		lastWriteVersion:    lastWriteVersion,
+		activityInfos:       make(map[int64]*p.InternalActivityInfo),
+		timerInfos:          make(map[string]*p.TimerInfo),
+		childExecutionInfos: make(map[int64]*p.InternalChildExecutionInfo),
+		requestCancelInfos:  make(map[int64]*p.RequestCancelInfo),
+		signalInfos:         make(map[int64]*p.SignalInfo),
+		signalRequestedIDs:  make(map[string]struct{}),
+	}
+	if replicationState != nil {
+		row.replicationState = &p.ReplicationState{
+			StartVersion:        startVersion,
+			CurrentVersion:      replicationState.CurrentVersion,
+			LastWriteVersion:    lastWriteVersion,
+			LastWriteEventID:    replicationState.LastWriteEventID,
+			LastReplicationInfo: copyReplicationInfo(replicationState.LastReplicationInfo),
+		}
+	} else if versionHistories != nil {
+		row.versionHistories = deepCopy(versionHistories).(*p.DataBlob)
+	}
+	return row
+}
+
+func newExecutionRowFromSnapshot(workflowSnapshot *p.InternalWorkflowSnapshot) *executionRow {
+	row := newExecutionRow(
+		workflowSnapshot.ExecutionInfo,
+		workflowSnapshot.ReplicationState,
+		workflowSnapshot.VersionHistories,
+		workflowSnapshot.StartVersion,
+		workflowSnapshot.LastWriteVersion,
+	)
+	for _, info := range workflowSnapshot.ActivityInfos {
+		row.activityInfos[info.ScheduleID] = deepCopy(info).(*p.InternalActivityInfo)
+	}
+	for _, info := range workflowSnapshot.TimerInfos {
+		row.timerInfos[info.TimerID] = deepCopy(info).(*p.TimerInfo)
+	}
+	for _, info := range workflowSnapshot.ChildExecutionInfos {
+		row.childExecutionInfos[info.InitiatedID] = deepCopy(info).(*p.InternalChildExecutionInfo)
+	}
+	for _, info := range workflowSnapshot.RequestCancelInfos {
+		row.requestCancelInfos[info.InitiatedID] = deepCopy(info).(*p.RequestCancelInfo)
+	}
+	for _, info := range workflowSnapshot.SignalInfos {
+		row.signalInfos[info.InitiatedID] = deepCopy(info).(*p.SignalInfo)
+	}
+	for _, signalRequestedID := range workflowSnapshot.SignalRequestedIDs {
+		row.signalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+	return row
+}
+
+func copyReplicationInfo(replicationInfo map[string]*p.ReplicationInfo) map[string]*p.ReplicationInfo {
+	result := make(map[string]*p.ReplicationInfo, len(replicationInfo))
+	for k, v := range replicationInfo {
+		result[k] = &p.ReplicationInfo{Version: v.Version, LastEventID: v.LastEventID}
+	}
+	return result
+}
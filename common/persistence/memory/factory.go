@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// Factory vends store objects backed by process memory
+	Factory struct {
+		cfg         config.Memory
+		db          *database
+		clusterName string
+		logger      log.Logger
+	}
+)
+
+// NewFactory returns an instance of a factory object which can be used to create
+// datastores whose state lives in the memory of the current process
+func NewFactory(cfg config.Memory, clusterName string, logger log.Logger) *Factory {
+	return &Factory{
+		cfg:         cfg,
+		db:          getDatabase(cfg.DatabaseName),
+		clusterName: clusterName,
+		logger:      logger,
+	}
+}
+
+// NewTaskStore returns a new task store
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	return newTaskStore(f.db, f.logger), nil
+}
+
+// NewShardStore returns a new shard store
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	return newShardStore(f.db, f.clusterName, f.logger), nil
+}
+
+// NewHistoryV2Store returns a new history store
+func (f *Factory) NewHistoryV2Store() (p.HistoryV2Store, error) {
+	return newHistoryV2Store(f.db, f.logger), nil
+}
+
+// NewMetadataStore returns a new metadata store
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	return newMetadataStore(f.db, f.clusterName, f.logger), nil
+}
+
+// NewExecutionStore returns an ExecutionStore for a given shardID
+func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
+	return newExecutionStore(f.db, f.logger, shardID), nil
+}
+
+// NewVisibilityStore returns a visibility store
+func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
+	return newVisibilityStore(f.db, f.logger), nil
+}
+
+// NewQueue returns a new queue backed by memory
+func (f *Factory) NewQueue(queueType common.QueueType) (p.Queue, error) {
+	return newQueue(f.db, f.logger, queueType), nil
+}
+
+// Close is a noop, the database outlives the factory so that other
+// factories configured with the same database name keep seeing its state
+func (f *Factory) Close() {
+}
@@ -0,0 +1,433 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryHistoryV2Store struct {
+	memoryStore
+}
+
+// newHistoryV2Store creates an instance of HistoryV2Store
+func newHistoryV2Store(db *database, logger log.Logger) p.HistoryV2Store {
+	return &memoryHistoryV2Store{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (m *memoryHistoryV2Store) AppendHistoryNodes(
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+
+	branchInfo := request.BranchInfo
+	beginNodeID := p.GetBeginNodeID(branchInfo)
+
+	if request.NodeID < beginNodeID {
+		return &p.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("cannot append to ancestors' nodes"),
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	branchKey := historyBranchKey{
+		shardID:  request.ShardID,
+		treeID:   branchInfo.GetTreeID(),
+		branchID: branchInfo.GetBranchID(),
+	}
+	nodeKey := historyNodeKey{nodeID: request.NodeID, txnID: request.TransactionID}
+	if _, ok := m.db.historyNodes[branchKey][nodeKey]; ok {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("AppendHistoryNodes: node %v with transaction %v already exist", request.NodeID, request.TransactionID),
+		}
+	}
+
+	if request.IsNewBranch {
+		treeKey := historyTreeKey{shardID: request.ShardID, treeID: branchInfo.GetTreeID()}
+		if _, ok := m.db.historyTrees[treeKey][branchInfo.GetBranchID()]; ok {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("AppendHistoryNodes: branch %v already exist", branchInfo.GetBranchID()),
+			}
+		}
+		m.db.putHistoryTreeRow(treeKey, branchInfo.GetBranchID(), &historyTreeRow{
+			ancestors:   deepCopy(branchInfo.Ancestors).([]*shared.HistoryBranchRange),
+			info:        request.Info,
+			inProgress:  false,
+			createdTime: time.Now(),
+		})
+	}
+
+	nodes, ok := m.db.historyNodes[branchKey]
+	if !ok {
+		nodes = make(map[historyNodeKey]*p.DataBlob)
+		m.db.historyNodes[branchKey] = nodes
+	}
+	nodes[nodeKey] = deepCopy(request.Events).(*p.DataBlob)
+	return nil
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (m *memoryHistoryV2Store) ReadHistoryBranch(
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+
+	minNodeID := request.MinNodeID
+	maxNodeID := request.MaxNodeID
+
+	lastNodeID := request.LastNodeID
+	lastTxnID := request.LastTransactionID
+
+	if len(request.NextPageToken) > 0 {
+		tokenNodeID, err := deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &shared.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken)}
+		}
+		minNodeID = tokenNodeID + 1
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	nodes := m.db.historyNodes[historyBranchKey{
+		shardID:  request.ShardID,
+		treeID:   request.TreeID,
+		branchID: request.BranchID,
+	}]
+	var keys []historyNodeKey
+	for key := range nodes {
+		if key.nodeID >= minNodeID && key.nodeID < maxNodeID {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return &p.InternalReadHistoryBranchResponse{}, nil
+	}
+	// nodes in ascending order, the batch with the largest transaction ID first
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].nodeID != keys[j].nodeID {
+			return keys[i].nodeID < keys[j].nodeID
+		}
+		return keys[i].txnID > keys[j].txnID
+	})
+	if len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+	}
+
+	history := make([]*p.DataBlob, 0, request.PageSize)
+	for _, key := range keys {
+		if key.txnID < lastTxnID {
+			// assuming that business logic layer is correct and transaction ID only increase
+			// thus, valid event batch will come with increasing transaction ID
+
+			// event batches with smaller node ID
+			//  -> should not be possible since records are already sorted
+			// event batches with same node ID
+			//  -> batch with higher transaction ID is valid
+			// event batches with larger node ID
+			//  -> batch with lower transaction ID is invalid (happens before)
+			//  -> batch with higher transaction ID is valid
+			if key.nodeID < lastNodeID {
+				return nil, &shared.InternalServiceError{
+					Message: fmt.Sprintf("corrupted data, nodeID cannot decrease"),
+				}
+			} else if key.nodeID > lastNodeID {
+				// update lastNodeID so that our pagination can make progress in the corner case that
+				// the page are all rows with smaller txnID
+				// because next page we always have minNodeID = lastNodeID+1
+				lastNodeID = key.nodeID
+			}
+			continue
+		}
+
+		switch {
+		case key.nodeID < lastNodeID:
+			return nil, &shared.InternalServiceError{
+				Message: fmt.Sprintf("corrupted data, nodeID cannot decrease"),
+			}
+		case key.nodeID == lastNodeID:
+			return nil, &shared.InternalServiceError{
+				Message: fmt.Sprintf("corrupted data, same nodeID must have smaller txnID"),
+			}
+		default: // key.nodeID > lastNodeID:
+			// NOTE: when key.nodeID > lastNodeID, we expect the one with largest txnID comes first
+			lastTxnID = key.txnID
+			lastNodeID = key.nodeID
+			history = append(history, deepCopy(nodes[key]).(*p.DataBlob))
+		}
+	}
+
+	var pagingToken []byte
+	if len(keys) >= request.PageSize {
+		pagingToken = serializePageToken(lastNodeID)
+	}
+
+	return &p.InternalReadHistoryBranchResponse{
+		History:           history,
+		NextPageToken:     pagingToken,
+		LastNodeID:        lastNodeID,
+		LastTransactionID: lastTxnID,
+	}, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing branch, see the
+// documentation of the SQL store for the details of the ancestors computation
+func (m *memoryHistoryV2Store) ForkHistoryBranch(
+	request *p.InternalForkHistoryBranchRequest,
+) (*p.InternalForkHistoryBranchResponse, error) {
+
+	forkB := request.ForkBranchInfo
+	treeID := forkB.GetTreeID()
+	newAncestors := make([]*shared.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
+
+	beginNodeID := p.GetBeginNodeID(forkB)
+	if beginNodeID >= request.ForkNodeID {
+		// this is the case that new branch's ancestors doesn't include the forking branch
+		for _, br := range forkB.Ancestors {
+			if br.GetEndNodeID() >= request.ForkNodeID {
+				newAncestors = append(newAncestors, &shared.HistoryBranchRange{
+					BranchID:    br.BranchID,
+					BeginNodeID: br.BeginNodeID,
+					EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+				})
+				break
+			} else {
+				newAncestors = append(newAncestors, br)
+			}
+		}
+	} else {
+		// this is the case the new branch will inherit all ancestors from forking branch
+		newAncestors = append(newAncestors, forkB.Ancestors...)
+		newAncestors = append(newAncestors, &shared.HistoryBranchRange{
+			BranchID:    forkB.BranchID,
+			BeginNodeID: common.Int64Ptr(beginNodeID),
+			EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+		})
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	treeKey := historyTreeKey{shardID: request.ShardID, treeID: treeID}
+	if _, ok := m.db.historyTrees[treeKey][request.NewBranchID]; ok {
+		return nil, fmt.Errorf("branch %v of tree %v already exists", request.NewBranchID, treeID)
+	}
+	m.db.putHistoryTreeRow(treeKey, request.NewBranchID, &historyTreeRow{
+		ancestors:   deepCopy(newAncestors).([]*shared.HistoryBranchRange),
+		info:        request.Info,
+		inProgress:  true,
+		createdTime: time.Now(),
+	})
+
+	return &p.InternalForkHistoryBranchResponse{
+		NewBranchInfo: shared.HistoryBranch{
+			TreeID:    common.StringPtr(treeID),
+			BranchID:  common.StringPtr(request.NewBranchID),
+			Ancestors: newAncestors,
+		},
+	}, nil
+}
+
+// DeleteHistoryBranch removes a branch
+func (m *memoryHistoryV2Store) DeleteHistoryBranch(
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+
+	branch := request.BranchInfo
+	treeID := branch.GetTreeID()
+	brsToDelete := append([]*shared.HistoryBranchRange(nil), branch.Ancestors...)
+	brsToDelete = append(brsToDelete, &shared.HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: common.Int64Ptr(p.GetBeginNodeID(branch)),
+	})
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	treeKey := historyTreeKey{shardID: request.ShardID, treeID: treeID}
+	tree := m.db.historyTrees[treeKey]
+	// We won't delete the branch if there is any branch forking in progress. We will return error.
+	for _, row := range tree {
+		if row.inProgress {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("There are branches in progress of forking"),
+			}
+		}
+	}
+
+	// validBRsMaxEndNode is to for each branch range that is being used, we want to know what is the max nodeID referred by other valid branch
+	validBRsMaxEndNode := map[string]int64{}
+	for _, row := range tree {
+		for _, br := range row.ancestors {
+			curr, ok := validBRsMaxEndNode[br.GetBranchID()]
+			if !ok || curr < br.GetEndNodeID() {
+				validBRsMaxEndNode[br.GetBranchID()] = br.GetEndNodeID()
+			}
+		}
+	}
+
+	delete(tree, branch.GetBranchID())
+	if len(tree) == 0 {
+		delete(m.db.historyTrees, treeKey)
+	}
+
+	// for each branch range to delete, we iterate from bottom to up, and delete up to the point according to validBRsEndNode
+	for i := len(brsToDelete) - 1; i >= 0; i-- {
+		br := brsToDelete[i]
+		maxReferredEndNodeID, ok := validBRsMaxEndNode[br.GetBranchID()]
+		minNodeID := br.GetBeginNodeID()
+		if ok {
+			// we can only delete from the maxEndNode and stop here
+			minNodeID = maxReferredEndNodeID
+		}
+		m.db.deleteHistoryNodes(historyBranchKey{
+			shardID:  request.ShardID,
+			treeID:   treeID,
+			branchID: br.GetBranchID(),
+		}, minNodeID)
+		if ok {
+			break
+		}
+	}
+	return nil
+}
+
+// CompleteForkBranch update a branch
+func (m *memoryHistoryV2Store) CompleteForkBranch(
+	request *p.InternalCompleteForkBranchRequest,
+) error {
+
+	branch := request.BranchInfo
+	treeKey := historyTreeKey{shardID: request.ShardID, treeID: branch.GetTreeID()}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tree := m.db.historyTrees[treeKey]
+	row, ok := tree[branch.GetBranchID()]
+	if !ok {
+		return fmt.Errorf("branch %v of tree %v does not exist", branch.GetBranchID(), branch.GetTreeID())
+	}
+
+	if request.Success {
+		row.inProgress = false
+		return nil
+	}
+	// request.Success == false
+	m.db.deleteHistoryNodes(historyBranchKey{
+		shardID:  request.ShardID,
+		treeID:   branch.GetTreeID(),
+		branchID: branch.GetBranchID(),
+	}, 1)
+	delete(tree, branch.GetBranchID())
+	if len(tree) == 0 {
+		delete(m.db.historyTrees, treeKey)
+	}
+	return nil
+}
+
+func (m *memoryHistoryV2Store) GetAllHistoryTreeBranches(
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.GetAllHistoryTreeBranchesResponse, error) {
+
+	// Implement it when we need, as for the SQL store
+	panic("not implemented yet")
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (m *memoryHistoryV2Store) GetHistoryTree(
+	request *p.GetHistoryTreeRequest,
+) (*p.GetHistoryTreeResponse, error) {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tree := m.db.historyTrees[historyTreeKey{shardID: *request.ShardID, treeID: request.TreeID}]
+	if len(tree) == 0 {
+		return &p.GetHistoryTreeResponse{}, nil
+	}
+
+	branchIDs := make([]string, 0, len(tree))
+	for branchID := range tree {
+		branchIDs = append(branchIDs, branchID)
+	}
+	sort.Strings(branchIDs)
+
+	branches := make([]*shared.HistoryBranch, 0, len(tree))
+	forkingBranches := make([]p.HistoryBranchDetail, 0)
+	for _, branchID := range branchIDs {
+		row := tree[branchID]
+		if row.inProgress {
+			forkingBranches = append(forkingBranches, p.HistoryBranchDetail{
+				TreeID:   request.TreeID,
+				BranchID: branchID,
+				ForkTime: row.createdTime,
+				Info:     row.info,
+			})
+		}
+		branches = append(branches, &shared.HistoryBranch{
+			TreeID:    common.StringPtr(request.TreeID),
+			BranchID:  common.StringPtr(branchID),
+			Ancestors: deepCopy(row.ancestors).([]*shared.HistoryBranchRange),
+		})
+	}
+
+	return &p.GetHistoryTreeResponse{
+		Branches:                  branches,
+		ForkingInProgressBranches: forkingBranches,
+	}, nil
+}
+
+func (db *database) putHistoryTreeRow(key historyTreeKey, branchID string, row *historyTreeRow) {
+	tree, ok := db.historyTrees[key]
+	if !ok {
+		tree = make(map[string]*historyTreeRow)
+		db.historyTrees[key] = tree
+	}
+	tree[branchID] = row
+}
+
+// deleteHistoryNodes removes the nodes of the branch starting from minNodeID
+func (db *database) deleteHistoryNodes(key historyBranchKey, minNodeID int64) {
+	nodes := db.historyNodes[key]
+	for nodeKey := range nodes {
+		if nodeKey.nodeID >= minNodeID {
+			delete(nodes, nodeKey)
+		}
+	}
+	if len(nodes) == 0 {
+		delete(db.historyNodes, key)
+	}
+}
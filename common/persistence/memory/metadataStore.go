@@ -0,0 +1,203 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryMetadataStore struct {
+	memoryStore
+	activeClusterName string
+}
+
+// newMetadataStore creates an instance of MetadataStore
+func newMetadataStore(db *database, currentClusterName string, logger log.Logger) p.MetadataStore {
+	return &memoryMetadataStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		activeClusterName: currentClusterName,
+	}
+}
+
+func (m *memoryMetadataStore) CreateDomain(request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.domains[request.Info.ID]; ok || m.db.getDomainByName(request.Info.Name) != nil {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("name: %v", request.Info.Name),
+		}
+	}
+
+	m.db.domains[request.Info.ID] = deepCopy(&p.InternalGetDomainResponse{
+		Info:                        request.Info,
+		Config:                      request.Config,
+		ReplicationConfig:           request.ReplicationConfig,
+		IsGlobalDomain:              request.IsGlobalDomain,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		FailoverNotificationVersion: p.InitialFailoverNotificationVersion,
+		NotificationVersion:         m.db.notificationVersion,
+	}).(*p.InternalGetDomainResponse)
+	m.db.notificationVersion++
+	return &p.CreateDomainResponse{ID: request.Info.ID}, nil
+}
+
+func (m *memoryMetadataStore) GetDomain(request *p.GetDomainRequest) (*p.InternalGetDomainResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var domain *p.InternalGetDomainResponse
+	identity := request.Name
+	switch {
+	case request.Name != "" && request.ID != "":
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+		}
+	case request.Name != "":
+		domain = m.db.getDomainByName(request.Name)
+	case request.ID != "":
+		domain = m.db.domains[request.ID]
+		identity = request.ID
+	default:
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	if domain == nil {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Domain %s does not exist.", identity),
+		}
+	}
+	return m.toGetDomainResponse(domain), nil
+}
+
+func (m *memoryMetadataStore) UpdateDomain(request *p.InternalUpdateDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	domain, ok := m.db.domains[request.Info.ID]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain: domain %v does not exist", request.Info.ID),
+		}
+	}
+	if m.db.notificationVersion != request.NotificationVersion {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to update domain metadata. Notification version was %v, expected %v",
+				m.db.notificationVersion, request.NotificationVersion),
+		}
+	}
+
+	// the name of a domain never changes
+	info := deepCopy(request.Info).(*p.DomainInfo)
+	info.Name = domain.Info.Name
+	m.db.domains[request.Info.ID] = &p.InternalGetDomainResponse{
+		Info:                        info,
+		Config:                      deepCopy(request.Config).(*p.InternalDomainConfig),
+		ReplicationConfig:           deepCopy(request.ReplicationConfig).(*p.DomainReplicationConfig),
+		IsGlobalDomain:              domain.IsGlobalDomain,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		FailoverNotificationVersion: request.FailoverNotificationVersion,
+		NotificationVersion:         request.NotificationVersion,
+	}
+	m.db.notificationVersion++
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteDomain(request *p.DeleteDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.domains, request.ID)
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteDomainByName(request *p.DeleteDomainByNameRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if domain := m.db.getDomainByName(request.Name); domain != nil {
+		delete(m.db.domains, domain.Info.ID)
+	}
+	return nil
+}
+
+func (m *memoryMetadataStore) ListDomains(request *p.ListDomainsRequest) (*p.InternalListDomainsResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	lastID := string(request.NextPageToken)
+	var ids []string
+	for id := range m.db.domains {
+		if len(request.NextPageToken) == 0 || id > lastID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	resp := &p.InternalListDomainsResponse{}
+	if len(ids) > request.PageSize {
+		ids = ids[:request.PageSize]
+		resp.NextPageToken = []byte(ids[len(ids)-1])
+	}
+	for _, id := range ids {
+		resp.Domains = append(resp.Domains, m.toGetDomainResponse(m.db.domains[id]))
+	}
+	return resp, nil
+}
+
+func (m *memoryMetadataStore) GetMetadata() (*p.GetMetadataResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	return &p.GetMetadataResponse{NotificationVersion: m.db.notificationVersion}, nil
+}
+
+func (m *memoryMetadataStore) toGetDomainResponse(domain *p.InternalGetDomainResponse) *p.InternalGetDomainResponse {
+	resp := deepCopy(domain).(*p.InternalGetDomainResponse)
+	resp.ReplicationConfig.ActiveClusterName = p.GetOrUseDefaultActiveCluster(
+		m.activeClusterName,
+		resp.ReplicationConfig.ActiveClusterName,
+	)
+	resp.ReplicationConfig.Clusters = p.GetOrUseDefaultClusters(m.activeClusterName, resp.ReplicationConfig.Clusters)
+	return resp
+}
+
+// getDomainByName must be called with the database lock held
+func (db *database) getDomainByName(name string) *p.InternalGetDomainResponse {
+	for _, domain := range db.domains {
+		if domain.Info.Name == name {
+			return domain
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryQueue struct {
+	memoryStore
+	queueType common.QueueType
+}
+
+// newQueue creates an instance of Queue
+func newQueue(db *database, logger log.Logger, queueType common.QueueType) p.Queue {
+	return &memoryQueue{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		queueType: queueType,
+	}
+}
+
+func (q *memoryQueue) EnqueueMessage(messagePayload []byte) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	messages := q.db.queueMessages[q.queueType]
+	messageID := 0
+	if len(messages) > 0 {
+		messageID = messages[len(messages)-1].ID + 1
+	}
+	q.db.queueMessages[q.queueType] = append(messages, &p.QueueMessage{
+		ID:      messageID,
+		Payload: deepCopy(messagePayload).([]byte),
+	})
+	return nil
+}
+
+func (q *memoryQueue) ReadMessages(lastMessageID int, maxCount int) ([]*p.QueueMessage, error) {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	var result []*p.QueueMessage
+	for _, message := range q.db.queueMessages[q.queueType] {
+		if len(result) >= maxCount {
+			break
+		}
+		if message.ID > lastMessageID {
+			result = append(result, deepCopy(message).(*p.QueueMessage))
+		}
+	}
+	return result, nil
+}
+
+func (q *memoryQueue) DeleteMessagesBefore(messageID int) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	messages := q.db.queueMessages[q.queueType]
+	i := 0
+	for i < len(messages) && messages[i].ID < messageID {
+		i++
+	}
+	q.db.queueMessages[q.queueType] = messages[i:]
+	return nil
+}
+
+func (q *memoryQueue) UpdateAckLevel(messageID int, clusterName string) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	clusterAckLevels, ok := q.db.queueAckLevels[q.queueType]
+	if !ok {
+		clusterAckLevels = make(map[string]int)
+		q.db.queueAckLevels[q.queueType] = clusterAckLevels
+	}
+
+	// Ignore possibly delayed message
+	if ackLevel, ok := clusterAckLevels[clusterName]; ok && ackLevel > messageID {
+		return nil
+	}
+	clusterAckLevels[clusterName] = messageID
+	return nil
+}
+
+func (q *memoryQueue) GetAckLevels() (map[string]int, error) {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	return deepCopy(q.db.queueAckLevels[q.queueType]).(map[string]int), nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryShardStore struct {
+	memoryStore
+	currentClusterName string
+}
+
+// newShardStore creates an instance of ShardStore
+func newShardStore(db *database, currentClusterName string, logger log.Logger) p.ShardStore {
+	return &memoryShardStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		currentClusterName: currentClusterName,
+	}
+}
+
+func (m *memoryShardStore) CreateShard(request *p.CreateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	if _, ok := m.db.shards[shardID]; ok {
+		return &p.ShardAlreadyExistError{
+			Msg: fmt.Sprintf("CreateShard operaiton failed. Shard with ID %v already exists.", shardID),
+		}
+	}
+	m.db.shards[shardID] = deepCopy(request.ShardInfo).(*p.ShardInfo)
+	return nil
+}
+
+func (m *memoryShardStore) GetShard(request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard, ok := m.db.shards[request.ShardID]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("GetShard operation failed. Shard with ID %v not found.", request.ShardID),
+		}
+	}
+
+	shardInfo := deepCopy(shard).(*p.ShardInfo)
+	if len(shardInfo.ClusterTransferAckLevel) == 0 {
+		shardInfo.ClusterTransferAckLevel = map[string]int64{
+			m.currentClusterName: shardInfo.TransferAckLevel,
+		}
+	}
+	if len(shardInfo.ClusterTimerAckLevel) == 0 {
+		shardInfo.ClusterTimerAckLevel = map[string]time.Time{
+			m.currentClusterName: shardInfo.TimerAckLevel,
+		}
+	}
+	if shardInfo.ClusterReplicationLevel == nil {
+		shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+	return &p.GetShardResponse{ShardInfo: shardInfo}, nil
+}
+
+func (m *memoryShardStore) UpdateShard(request *p.UpdateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	if err := m.db.checkShardRangeID(shardID, request.PreviousRangeID); err != nil {
+		return err
+	}
+	m.db.shards[shardID] = deepCopy(request.ShardInfo).(*p.ShardInfo)
+	return nil
+}
+
+// checkShardRangeID fails with ShardOwnershipLostError when the shard has been
+// acquired by someone else since the caller read it. It must be called with the
+// database lock held
+func (db *database) checkShardRangeID(shardID int, rangeID int64) error {
+	shard, ok := db.shards[shardID]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to lock shard with ID %v that does not exist.", shardID),
+		}
+	}
+	if shard.RangeID != rangeID {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("Failed to lock shard. Previous range ID: %v; new range ID: %v", rangeID, shard.RangeID),
+		}
+	}
+	return nil
+}
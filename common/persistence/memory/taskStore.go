@@ -0,0 +1,299 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryTaskStore struct {
+		memoryStore
+	}
+
+	taskListPageToken struct {
+		DomainID string
+		Name     string
+		TaskType int
+	}
+)
+
+const (
+	stickyTaskListTTL = 24 * time.Hour
+)
+
+// newTaskStore creates an instance of TaskStore
+func newTaskStore(db *database, logger log.Logger) p.TaskStore {
+	return &memoryTaskStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *memoryTaskStore) LeaseTaskList(request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	tlInfo, ok := m.db.taskLists[key]
+	if !ok {
+		tlInfo = &p.TaskListInfo{
+			DomainID:    request.DomainID,
+			Name:        request.TaskList,
+			TaskType:    request.TaskType,
+			Kind:        request.TaskListKind,
+			LastUpdated: time.Now(),
+		}
+		m.db.taskLists[key] = tlInfo
+	}
+
+	if request.RangeID > 0 && request.RangeID != tlInfo.RangeID {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("leaseTaskList:renew failed:taskList:%v, taskListType:%v, haveRangeID:%v, gotRangeID:%v",
+				request.TaskList, request.TaskType, request.RangeID, tlInfo.RangeID),
+		}
+	}
+
+	now := time.Now()
+	tlInfo.RangeID++
+	tlInfo.LastUpdated = now
+	return &p.LeaseTaskListResponse{TaskListInfo: &p.TaskListInfo{
+		DomainID:    request.DomainID,
+		Name:        request.TaskList,
+		TaskType:    request.TaskType,
+		RangeID:     tlInfo.RangeID,
+		AckLevel:    tlInfo.AckLevel,
+		Kind:        request.TaskListKind,
+		LastUpdated: now,
+	}}, nil
+}
+
+func (m *memoryTaskStore) UpdateTaskList(request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	info := request.TaskListInfo
+	key := taskListKey{domainID: info.DomainID, name: info.Name, taskType: info.TaskType}
+	tlInfo := &p.TaskListInfo{
+		DomainID:    info.DomainID,
+		Name:        info.Name,
+		TaskType:    info.TaskType,
+		RangeID:     info.RangeID,
+		AckLevel:    info.AckLevel,
+		Kind:        info.Kind,
+		LastUpdated: time.Now(),
+	}
+
+	if info.Kind == p.TaskListKindSticky {
+		// sticky task lists are created on demand and expire when unused
+		tlInfo.Expiry = time.Now().Add(stickyTaskListTTL)
+		m.db.taskLists[key] = tlInfo
+		return &p.UpdateTaskListResponse{}, nil
+	}
+
+	if err := m.db.checkTaskListRangeID(key, info.RangeID); err != nil {
+		return nil, err
+	}
+	m.db.taskLists[key] = tlInfo
+	return &p.UpdateTaskListResponse{}, nil
+}
+
+func (m *memoryTaskStore) ListTaskList(request *p.ListTaskListRequest) (*p.ListTaskListResponse, error) {
+	var lastKey *taskListKey
+	if len(request.PageToken) > 0 {
+		var pageToken taskListPageToken
+		if err := json.Unmarshal(request.PageToken, &pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+		lastKey = &taskListKey{domainID: pageToken.DomainID, name: pageToken.Name, taskType: pageToken.TaskType}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	keys := make([]taskListKey, 0, len(m.db.taskLists))
+	for key := range m.db.taskLists {
+		if lastKey == nil || taskListKeyLess(*lastKey, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return taskListKeyLess(keys[i], keys[j])
+	})
+
+	resp := &p.ListTaskListResponse{}
+	if len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+		lastKey := keys[len(keys)-1]
+		nextPageToken, err := json.Marshal(&taskListPageToken{
+			DomainID: lastKey.domainID,
+			Name:     lastKey.name,
+			TaskType: lastKey.taskType,
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+		resp.NextPageToken = nextPageToken
+	}
+	resp.Items = make([]p.TaskListInfo, len(keys))
+	for i, key := range keys {
+		resp.Items[i] = *m.db.taskLists[key]
+	}
+	return resp, nil
+}
+
+func (m *memoryTaskStore) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskListName, taskType: request.TaskListType}
+	tlInfo, ok := m.db.taskLists[key]
+	if !ok || tlInfo.RangeID != request.RangeID {
+		return &workflow.InternalServiceError{Message: "delete failed: 0 rows affected instead of 1"}
+	}
+	delete(m.db.taskLists, key)
+	return nil
+}
+
+func (m *memoryTaskStore) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	info := request.TaskListInfo
+	key := taskListKey{domainID: info.DomainID, name: info.Name, taskType: info.TaskType}
+	if err := m.db.checkTaskListRangeID(key, info.RangeID); err != nil {
+		return nil, err
+	}
+
+	tasks, ok := m.db.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*p.TaskInfo)
+		m.db.tasks[key] = tasks
+	}
+	now := time.Now()
+	for _, task := range request.Tasks {
+		taskInfo := deepCopy(task.Data).(*p.TaskInfo)
+		taskInfo.TaskID = task.TaskID
+		taskInfo.CreatedTime = now
+		taskInfo.Expiry = time.Time{}
+		if taskInfo.ScheduleToStartTimeout > 0 {
+			taskInfo.Expiry = now.Add(time.Second * time.Duration(taskInfo.ScheduleToStartTimeout))
+		}
+		tasks[task.TaskID] = taskInfo
+	}
+	return &p.CreateTasksResponse{}, nil
+}
+
+func (m *memoryTaskStore) GetTasks(request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	var tasks []*p.TaskInfo
+	for taskID, task := range m.db.tasks[key] {
+		if taskID <= request.ReadLevel {
+			continue
+		}
+		if request.MaxReadLevel != nil && taskID > *request.MaxReadLevel {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].TaskID < tasks[j].TaskID
+	})
+	if len(tasks) > request.BatchSize {
+		tasks = tasks[:request.BatchSize]
+	}
+	for i, task := range tasks {
+		tasks[i] = deepCopy(task).(*p.TaskInfo)
+	}
+	return &p.GetTasksResponse{Tasks: tasks}, nil
+}
+
+func (m *memoryTaskStore) CompleteTask(request *p.CompleteTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	taskList := request.TaskList
+	key := taskListKey{domainID: taskList.DomainID, name: taskList.Name, taskType: taskList.TaskType}
+	delete(m.db.tasks[key], request.TaskID)
+	return nil
+}
+
+func (m *memoryTaskStore) CompleteTasksLessThan(request *p.CompleteTasksLessThanRequest) (int, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskListName, taskType: request.TaskType}
+	var taskIDs []int64
+	for taskID := range m.db.tasks[key] {
+		if taskID <= request.TaskID {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool {
+		return taskIDs[i] < taskIDs[j]
+	})
+	if len(taskIDs) > request.Limit {
+		taskIDs = taskIDs[:request.Limit]
+	}
+	for _, taskID := range taskIDs {
+		delete(m.db.tasks[key], taskID)
+	}
+	return len(taskIDs), nil
+}
+
+// checkTaskListRangeID fails with ConditionFailedError when the task list has
+// been leased by someone else. It must be called with the database lock held
+func (db *database) checkTaskListRangeID(key taskListKey, rangeID int64) error {
+	tlInfo, ok := db.taskLists[key]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to lock task list. Task list %v of type %v does not exist", key.name, key.taskType),
+		}
+	}
+	if tlInfo.RangeID != rangeID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Task list range ID was %v when it was should have been %v", tlInfo.RangeID, rangeID),
+		}
+	}
+	return nil
+}
+
+func taskListKeyLess(a, b taskListKey) bool {
+	if a.domainID != b.domainID {
+		return a.domainID < b.domainID
+	}
+	if a.name != b.name {
+		return a.name < b.name
+	}
+	return a.taskType < b.taskType
+}
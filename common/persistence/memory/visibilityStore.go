@@ -0,0 +1,285 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryVisibilityStore struct {
+		memoryStore
+	}
+
+	visibilityPageToken struct {
+		Time  time.Time
+		RunID string
+	}
+
+	// visibilityFilter selects the executions to list, nil fields match everything
+	visibilityFilter struct {
+		domainID         string
+		closed           bool
+		workflowTypeName *string
+		workflowID       *string
+		closeStatus      *workflow.WorkflowExecutionCloseStatus
+	}
+)
+
+// newVisibilityStore creates an instance of VisibilityStore
+func newVisibilityStore(db *database, logger log.Logger) p.VisibilityStore {
+	return &memoryVisibilityStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *memoryVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := visibilityKey{domainID: request.DomainUUID, runID: request.RunID}
+	if _, ok := m.db.visibility[key]; ok {
+		// the execution may have been closed already, never overwrite the record
+		return nil
+	}
+	m.db.visibility[key] = &p.VisibilityWorkflowExecutionInfo{
+		WorkflowID:    request.WorkflowID,
+		RunID:         request.RunID,
+		TypeName:      request.WorkflowTypeName,
+		StartTime:     time.Unix(0, request.StartTimestamp),
+		ExecutionTime: executionTime(request.StartTimestamp, request.ExecutionTimestamp),
+		Memo:          copyMemo(request.Memo),
+	}
+	return nil
+}
+
+func (m *memoryVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	status := request.Status
+	key := visibilityKey{domainID: request.DomainUUID, runID: request.RunID}
+	m.db.visibility[key] = &p.VisibilityWorkflowExecutionInfo{
+		WorkflowID:    request.WorkflowID,
+		RunID:         request.RunID,
+		TypeName:      request.WorkflowTypeName,
+		StartTime:     time.Unix(0, request.StartTimestamp),
+		ExecutionTime: executionTime(request.StartTimestamp, request.ExecutionTimestamp),
+		CloseTime:     time.Unix(0, request.CloseTimestamp),
+		Status:        &status,
+		HistoryLength: request.HistoryLength,
+		Memo:          copyMemo(request.Memo),
+	}
+	return nil
+}
+
+func (m *memoryVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
+	return p.NewOperationNotSupportErrorForVis()
+}
+
+func (m *memoryVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(request, &visibilityFilter{
+		domainID: request.DomainUUID,
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(request, &visibilityFilter{
+		domainID: request.DomainUUID,
+		closed:   true,
+	})
+}
+
+func (m *memoryVisibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, &visibilityFilter{
+		domainID:         request.DomainUUID,
+		workflowTypeName: &request.WorkflowTypeName,
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, &visibilityFilter{
+		domainID:         request.DomainUUID,
+		closed:           true,
+		workflowTypeName: &request.WorkflowTypeName,
+	})
+}
+
+func (m *memoryVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, &visibilityFilter{
+		domainID:   request.DomainUUID,
+		workflowID: &request.WorkflowID,
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, &visibilityFilter{
+		domainID:   request.DomainUUID,
+		closed:     true,
+		workflowID: &request.WorkflowID,
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, &visibilityFilter{
+		domainID:    request.DomainUUID,
+		closed:      true,
+		closeStatus: &request.Status,
+	})
+}
+
+func (m *memoryVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	execution := request.Execution
+	info, ok := m.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: execution.GetRunId()}]
+	if !ok || info.Status == nil {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &p.InternalGetClosedWorkflowExecutionResponse{
+		Execution: deepCopy(info).(*p.VisibilityWorkflowExecutionInfo),
+	}, nil
+}
+
+func (m *memoryVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.visibility, visibilityKey{domainID: request.DomainID, runID: request.RunID})
+	return nil
+}
+
+func (m *memoryVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+func (m *memoryVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+func (m *memoryVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+// listWorkflowExecutions returns the executions matching the filter ordered by
+// start time descending, and run ID for executions started at the same time
+func (m *memoryVisibilityStore) listWorkflowExecutions(
+	request *p.ListWorkflowExecutionsRequest,
+	filter *visibilityFilter,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+
+	minStartTime := time.Unix(0, request.EarliestStartTime)
+	readLevel := &visibilityPageToken{Time: time.Unix(0, request.LatestStartTime)}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, readLevel); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken),
+			}
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var infos []*p.VisibilityWorkflowExecutionInfo
+	for key, info := range m.db.visibility {
+		if key.domainID != filter.domainID || !filter.matches(info) {
+			continue
+		}
+		if info.StartTime.Before(minStartTime) || info.StartTime.After(readLevel.Time) {
+			continue
+		}
+		if info.StartTime.Equal(readLevel.Time) && info.RunID <= readLevel.RunID {
+			continue
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if !infos[i].StartTime.Equal(infos[j].StartTime) {
+			return infos[i].StartTime.After(infos[j].StartTime)
+		}
+		return infos[i].RunID < infos[j].RunID
+	})
+
+	resp := &p.InternalListWorkflowExecutionsResponse{}
+	if len(infos) > request.PageSize {
+		infos = infos[:request.PageSize]
+		lastInfo := infos[len(infos)-1]
+		nextPageToken, err := json.Marshal(&visibilityPageToken{
+			Time:  lastInfo.StartTime,
+			RunID: lastInfo.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.NextPageToken = nextPageToken
+	}
+	for _, info := range infos {
+		resp.Executions = append(resp.Executions, deepCopy(info).(*p.VisibilityWorkflowExecutionInfo))
+	}
+	return resp, nil
+}
+
+func (f *visibilityFilter) matches(info *p.VisibilityWorkflowExecutionInfo) bool {
+	if f.closed != (info.Status != nil) {
+		return false
+	}
+	if f.workflowTypeName != nil && *f.workflowTypeName != info.TypeName {
+		return false
+	}
+	if f.workflowID != nil && *f.workflowID != info.WorkflowID {
+		return false
+	}
+	if f.closeStatus != nil && *f.closeStatus != *info.Status {
+		return false
+	}
+	return true
+}
+
+// executionTime falls back to the start time for executions that
+// were not started with a delay
+func executionTime(startTimestamp int64, executionTimestamp int64) time.Time {
+	if executionTimestamp == 0 {
+		return time.Unix(0, startTimestamp)
+	}
+	return time.Unix(0, executionTimestamp)
+}
+
+func copyMemo(memo *p.DataBlob) *p.DataBlob {
+	if memo == nil {
+		return nil
+	}
+	return p.NewDataBlob(append([]byte(nil), memo.Data...), memo.Encoding)
+}
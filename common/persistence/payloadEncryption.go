@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
+)
+
+// Encrypted payloads are stored with EncodingTypeEncrypted and the following envelope as data:
+//
+//	| version (1 byte) | len(keyID) (1 byte) | keyID | len(encoding) (1 byte) | encoding | ciphertext |
+//
+// where encoding is the encoding of the plaintext blob and the header preceding the ciphertext
+// is authenticated as additional data, so that neither key ID nor encoding can be tampered with.
+const (
+	encryptionEnvelopeVersion1 byte = 1
+
+	encryptedEncodingPrefix = string(common.EncodingTypeEncrypted) + "+"
+)
+
+var (
+	errNoKeyProvider           = errors.New("payload encryption is not configured")
+	errInvalidEncryptedPayload = errors.New("invalid encrypted payload envelope")
+)
+
+// EncryptedEncoding returns the encoding type which serializes payloads with given encoding and
+// then encrypts them with the active key of the serializer's key provider
+func EncryptedEncoding(encodingType common.EncodingType) common.EncodingType {
+	return common.EncodingType(encryptedEncodingPrefix + string(encodingType))
+}
+
+func parseEncryptedEncoding(encodingType common.EncodingType) (common.EncodingType, bool) {
+	if !strings.HasPrefix(string(encodingType), encryptedEncodingPrefix) {
+		return encodingType, false
+	}
+	return common.EncodingType(strings.TrimPrefix(string(encodingType), encryptedEncodingPrefix)), true
+}
+
+func (t *serializerImpl) encrypt(blob *DataBlob) (*DataBlob, error) {
+	if t.keyProvider == nil {
+		return nil, errNoKeyProvider
+	}
+	keyID := t.keyProvider.ActiveKeyID()
+	key, err := t.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get encryption key %v: %v", keyID, err)
+	}
+	if len(keyID) > 255 || len(blob.Encoding) > 255 {
+		return nil, errInvalidEncryptedPayload
+	}
+
+	header := make([]byte, 0, 3+len(keyID)+len(blob.Encoding))
+	header = append(header, encryptionEnvelopeVersion1, byte(len(keyID)))
+	header = append(header, keyID...)
+	header = append(header, byte(len(blob.Encoding)))
+	header = append(header, blob.Encoding...)
+
+	ciphertext, err := encryption.Encrypt(key, blob.Data, header)
+	if err != nil {
+		return nil, err
+	}
+	return &DataBlob{
+		Encoding: common.EncodingTypeEncrypted,
+		Data:     append(header, ciphertext...),
+		KeyID:    keyID,
+	}, nil
+}
+
+func (t *serializerImpl) decrypt(blob *DataBlob) (*DataBlob, error) {
+	if t.keyProvider == nil {
+		return nil, errNoKeyProvider
+	}
+
+	data := blob.Data
+	if len(data) < 2 || data[0] != encryptionEnvelopeVersion1 {
+		return nil, errInvalidEncryptedPayload
+	}
+	offset := 2 + int(data[1])
+	if len(data) < offset+1 {
+		return nil, errInvalidEncryptedPayload
+	}
+	keyID := string(data[2:offset])
+	encodingOffset := offset + 1
+	offset = encodingOffset + int(data[offset])
+	if len(data) < offset {
+		return nil, errInvalidEncryptedPayload
+	}
+	encoding := common.EncodingType(data[encodingOffset:offset])
+
+	key, err := t.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get encryption key %v: %v", keyID, err)
+	}
+	plaintext, err := encryption.Decrypt(key, data[offset:], data[:offset])
+	if err != nil {
+		return nil, err
+	}
+	return &DataBlob{
+		Encoding: encoding,
+		Data:     plaintext,
+	}, nil
+}
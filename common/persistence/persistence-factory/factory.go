@@ -21,13 +21,16 @@
 package persistence
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service/config"
@@ -86,6 +89,7 @@ type (
 		logger        log.Logger
 		datastores    map[storeType]Datastore
 		clusterName   string
+		serializer    p.PayloadSerializer
 	}
 
 	storeType int
@@ -117,22 +121,31 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. In addition, all objects will emit metrics automatically.
+// An error is returned if the configured encryption keys cannot be loaded
 func New(
 	cfg *config.Persistence,
 	clusterName string,
 	metricsClient metrics.Client,
 	logger log.Logger,
-) Factory {
+) (Factory, error) {
 	factory := &factoryImpl{
 		config:        cfg,
 		metricsClient: metricsClient,
 		logger:        logger,
 		clusterName:   clusterName,
+		serializer:    p.NewPayloadSerializer(),
+	}
+	if cfg.Encryption != nil {
+		keyProvider, err := encryption.NewFileKeyProvider(cfg.Encryption.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config: unable to load encryption keys: %v", err)
+		}
+		factory.serializer = p.NewPayloadSerializerWithEncryption(keyProvider)
 	}
 	limiters := buildRatelimiters(cfg)
 	factory.init(clusterName, limiters)
-	return factory
+	return factory, nil
 }
 
 // NewTaskManager returns a new task manager
@@ -142,6 +155,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.config.FaultInjection != nil {
+		result = p.NewTaskPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -158,6 +174,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.config.FaultInjection != nil {
+		result = p.NewShardPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -174,7 +193,10 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit, f.serializer)
+	if f.config.FaultInjection != nil {
+		result = p.NewHistoryV2PersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -195,6 +217,9 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 	}
 
 	result := p.NewMetadataManagerImpl(store, f.logger)
+	if f.config.FaultInjection != nil {
+		result = p.NewMetadataPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -211,7 +236,10 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
+	result := p.NewExecutionManagerImpl(store, f.logger, f.serializer)
+	if f.config.FaultInjection != nil {
+		result = p.NewWorkflowExecutionPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -234,6 +262,9 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	}
 
 	result := p.NewVisibilityManagerImpl(store, f.logger)
+	if f.config.FaultInjection != nil {
+		result = p.NewVisibilityPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -271,7 +302,7 @@ func (f *factoryImpl) Close() {
 
 func (f *factoryImpl) isCassandra() bool {
 	cfg := f.config
-	return cfg.DataStores[cfg.VisibilityStore].SQL == nil
+	return cfg.DataStores[cfg.VisibilityStore].Cassandra != nil
 }
 
 func (f *factoryImpl) getCassandraConfig() *config.Cassandra {
@@ -287,9 +318,11 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	case defaultCfg.Cassandra != nil:
 		defaultDataStore.factory = cassandra.NewFactory(*defaultCfg.Cassandra, clusterName, f.logger)
 	case defaultCfg.SQL != nil:
-		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.logger)
+		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.config.ReadFromReplicas, f.logger)
+	case defaultCfg.Memory != nil:
+		defaultDataStore.factory = memory.NewFactory(*defaultCfg.Memory, clusterName, f.logger)
 	default:
-		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
+		f.logger.Fatal("invalid config: one of cassandra, sql or memory params must be specified")
 	}
 
 	for _, st := range storeTypes {
@@ -301,12 +334,14 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	visibilityCfg := f.config.DataStores[f.config.VisibilityStore]
 	visibilityDataStore := Datastore{ratelimit: limiters[f.config.VisibilityStore]}
 	switch {
-	case defaultCfg.Cassandra != nil:
+	case visibilityCfg.Cassandra != nil:
 		visibilityDataStore.factory = cassandra.NewFactory(*visibilityCfg.Cassandra, clusterName, f.logger)
 	case visibilityCfg.SQL != nil:
-		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, f.logger)
+		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, f.config.ReadFromReplicas, f.logger)
+	case visibilityCfg.Memory != nil:
+		visibilityDataStore.factory = memory.NewFactory(*visibilityCfg.Memory, clusterName, f.logger)
 	default:
-		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
+		f.logger.Fatal("invalid config: one of cassandra, sql or memory params must be specified")
 	}
 
 	f.datastores[storeTypeVisibility] = visibilityDataStore
@@ -322,6 +357,9 @@ func buildRatelimiters(cfg *config.Persistence) map[string]quotas.Limiter {
 		if ds.SQL != nil {
 			qps = ds.SQL.MaxQPS
 		}
+		if ds.Memory != nil {
+			qps = ds.Memory.MaxQPS
+		}
 		if qps > 0 {
 			result[dsName] = quotas.NewSimpleRateLimiter(qps)
 		}
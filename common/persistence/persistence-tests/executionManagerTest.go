@@ -1692,6 +1692,176 @@ func (s *ExecutionManagerSuite) TestGetCurrentWorkflow() {
 	s.Empty(task1, "Expected empty task identifier.")
 }
 
+// TestListConcreteExecutions test
+func (s *ExecutionManagerSuite) TestListConcreteExecutions() {
+	domainID := "b0f3a6f2-8a8e-4d5b-9a4e-0d2d0c1d8e21"
+	runIDs := []string{
+		"0b2a2d0e-7f7c-4a39-9c2e-3b3f7f5a1a01",
+		"0b2a2d0e-7f7c-4a39-9c2e-3b3f7f5a1a02",
+		"0b2a2d0e-7f7c-4a39-9c2e-3b3f7f5a1a03",
+	}
+	expected := make(map[string]bool)
+	for i, runID := range runIDs {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(fmt.Sprintf("list-concrete-executions-test-%v", i)),
+			RunId:      common.StringPtr(runID),
+		}
+		_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+		s.NoError(err)
+		expected[runID] = true
+	}
+
+	var pageToken []byte
+	for {
+		response, err := s.ExecutionManager.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  1,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		s.True(len(response.Executions) <= 1)
+		for _, execution := range response.Executions {
+			if execution.DomainID == domainID {
+				s.True(expected[execution.RunID])
+				delete(expected, execution.RunID)
+			}
+		}
+		pageToken = response.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Empty(expected)
+}
+
+// TestTaskDLQ test
+func (s *ExecutionManagerSuite) TestTaskDLQ() {
+	domainID := "4ca1faac-1a3a-47af-8e51-fdaa2b3d45b9"
+	workflowID := "task-dlq-test"
+	runID := "6b0b2fd2-3c41-4b2b-8f59-1fd1b0e6e1a7"
+	enqueueTime := time.Now().UTC().Round(time.Millisecond)
+
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		err := s.ExecutionManager.PutTaskToDLQ(&p.PutTaskToDLQRequest{
+			Task: &p.DLQTaskInfo{
+				Category:    p.DLQCategoryTransfer,
+				TaskID:      taskID,
+				Attempt:     10,
+				LastError:   "some error",
+				EnqueueTime: enqueueTime,
+				TransferTask: &p.TransferTaskInfo{
+					DomainID:   domainID,
+					WorkflowID: workflowID,
+					RunID:      runID,
+					TaskID:     taskID,
+					TaskType:   p.TransferTaskTypeActivityTask,
+					ScheduleID: 5,
+				},
+			},
+		})
+		s.NoError(err)
+	}
+	err := s.ExecutionManager.PutTaskToDLQ(&p.PutTaskToDLQRequest{
+		Task: &p.DLQTaskInfo{
+			Category:    p.DLQCategoryTimer,
+			TaskID:      4,
+			Attempt:     10,
+			LastError:   "some error",
+			EnqueueTime: enqueueTime,
+			TimerTask: &p.TimerTaskInfo{
+				DomainID:   domainID,
+				WorkflowID: workflowID,
+				RunID:      runID,
+				TaskID:     4,
+				TaskType:   p.TaskTypeUserTimer,
+				EventID:    7,
+			},
+		},
+	})
+	s.NoError(err)
+
+	var transferTasks []*p.DLQTaskInfo
+	var pageToken []byte
+	for {
+		response, err := s.ExecutionManager.GetTasksFromDLQ(&p.GetTasksFromDLQRequest{
+			Category:      p.DLQCategoryTransfer,
+			ReadLevel:     0,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     2,
+			NextPageToken: pageToken,
+		})
+		s.NoError(err)
+		s.True(len(response.Tasks) <= 2)
+		transferTasks = append(transferTasks, response.Tasks...)
+		pageToken = response.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(3, len(transferTasks))
+	for i, task := range transferTasks {
+		s.Equal(p.DLQCategoryTransfer, task.Category)
+		s.Equal(int64(i+1), task.TaskID)
+		s.Equal(10, task.Attempt)
+		s.Equal("some error", task.LastError)
+		s.True(enqueueTime.Equal(task.EnqueueTime))
+		s.Nil(task.TimerTask)
+		s.Equal(runID, task.TransferTask.RunID)
+		s.Equal(int64(5), task.TransferTask.ScheduleID)
+	}
+
+	response, err := s.ExecutionManager.GetTasksFromDLQ(&p.GetTasksFromDLQRequest{
+		Category:     p.DLQCategoryTimer,
+		ReadLevel:    3,
+		MaxReadLevel: 4,
+		BatchSize:    1,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Nil(response.Tasks[0].TransferTask)
+	s.Equal(int64(7), response.Tasks[0].TimerTask.EventID)
+
+	err = s.ExecutionManager.DeleteTaskFromDLQ(&p.DeleteTaskFromDLQRequest{
+		Category: p.DLQCategoryTransfer,
+		TaskID:   2,
+	})
+	s.NoError(err)
+	response, err = s.ExecutionManager.GetTasksFromDLQ(&p.GetTasksFromDLQRequest{
+		Category:     p.DLQCategoryTransfer,
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    10,
+	})
+	s.NoError(err)
+	s.Equal(2, len(response.Tasks))
+	s.Equal(int64(1), response.Tasks[0].TaskID)
+	s.Equal(int64(3), response.Tasks[1].TaskID)
+
+	err = s.ExecutionManager.RangeDeleteTasksFromDLQ(&p.RangeDeleteTasksFromDLQRequest{
+		Category:             p.DLQCategoryTransfer,
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   math.MaxInt64,
+	})
+	s.NoError(err)
+	response, err = s.ExecutionManager.GetTasksFromDLQ(&p.GetTasksFromDLQRequest{
+		Category:     p.DLQCategoryTransfer,
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    10,
+	})
+	s.NoError(err)
+	s.Empty(response.Tasks)
+
+	// tasks of the other category are kept
+	response, err = s.ExecutionManager.GetTasksFromDLQ(&p.GetTasksFromDLQRequest{
+		Category:     p.DLQCategoryTimer,
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+}
+
 // TestTransferTasksThroughUpdate test
 func (s *ExecutionManagerSuite) TestTransferTasksThroughUpdate() {
 	domainID := "b785a8ba-bd7d-4760-bb05-41b115f3e10a"
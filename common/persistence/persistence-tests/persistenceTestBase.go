@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
@@ -55,6 +56,7 @@ type (
 		DBPort          int              `yaml:"-"`
 		StoreType       string           `yaml:"-"`
 		SchemaDir       string           `yaml:"-"`
+		SQLDriverName   string           `yaml:"-"`
 		ClusterMetadata cluster.Metadata `yaml:"-"`
 	}
 
@@ -115,17 +117,28 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	if options.DBName == "" {
 		options.DBName = GenerateRandomDBName(10)
 	}
-	testCluster := sql.NewTestCluster(options.DBName, options.DBPort, options.SchemaDir)
+	testCluster := sql.NewTestCluster(options.SQLDriverName, options.DBName, options.DBPort, options.SchemaDir)
 	return newTestBase(options, testCluster)
 }
 
-// NewTestBase returns a persistence test base backed by either cassandra or sql
+// NewTestBaseWithMemory returns a new persistence test base backed by memory
+func NewTestBaseWithMemory(options *TestBaseOptions) TestBase {
+	if options.DBName == "" {
+		options.DBName = GenerateRandomDBName(10)
+	}
+	testCluster := memory.NewTestCluster(options.DBName)
+	return newTestBase(options, testCluster)
+}
+
+// NewTestBase returns a persistence test base backed by either cassandra, sql or memory
 func NewTestBase(options *TestBaseOptions) TestBase {
 	switch options.StoreType {
 	case config.StoreTypeSQL:
 		return NewTestBaseWithSQL(options)
 	case config.StoreTypeCassandra:
 		return NewTestBaseWithCassandra(options)
+	case config.StoreTypeMemory:
+		return NewTestBaseWithMemory(options)
 	default:
 		panic("invalid storeType " + options.StoreType)
 	}
@@ -174,7 +187,8 @@ func (s *TestBase) Setup() {
 	}
 
 	cfg := s.DefaultTestCluster.Config()
-	factory := pfactory.New(&cfg, clusterName, nil, s.logger)
+	factory, err := pfactory.New(&cfg, clusterName, nil, s.logger)
+	s.fatalOnError("NewFactory", err)
 
 	s.TaskMgr, err = factory.NewTaskManager()
 	s.fatalOnError("NewTaskManager", err)
@@ -195,7 +209,8 @@ func (s *TestBase) Setup() {
 	visibilityFactory := factory
 	if s.VisibilityTestCluster != s.DefaultTestCluster {
 		vCfg := s.VisibilityTestCluster.Config()
-		visibilityFactory = pfactory.New(&vCfg, clusterName, nil, s.logger)
+		visibilityFactory, err = pfactory.New(&vCfg, clusterName, nil, s.logger)
+		s.fatalOnError("NewFactory", err)
 	}
 	// SQL currently doesn't have support for visibility manager
 	s.VisibilityMgr, err = visibilityFactory.NewVisibilityManager()
@@ -0,0 +1,107 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	faultInjectionSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestFaultInjectionSuite(t *testing.T) {
+	s := new(faultInjectionSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *faultInjectionSuite) TestInject_NoFaults() {
+	injector := newFaultInjector(&config.FaultInjectionConfig{
+		TimeoutRate: dynamicconfig.GetFloatPropertyFn(0),
+	}, 1, loggerimpl.NewNopLogger())
+
+	for i := 0; i < 100; i++ {
+		s.NoError(injector.inject("UpdateWorkflowExecution"))
+	}
+}
+
+func (s *faultInjectionSuite) TestInject_PerOperation() {
+	injector := newFaultInjector(&config.FaultInjectionConfig{
+		ConditionFailedRate:    operationRates(map[string]float64{"UpdateWorkflowExecution": 1}),
+		ShardOwnershipLostRate: operationRates(map[string]float64{"GetWorkflowExecution": 1}),
+		TimeoutRate:            operationRates(map[string]float64{"": 1, "UpdateWorkflowExecution": 0}),
+	}, 5, loggerimpl.NewNopLogger())
+
+	err := injector.inject("GetWorkflowExecution")
+	s.IsType(&TimeoutError{}, err)
+
+	// operation without own rate falls back to the rate without filter
+	err = injector.inject("CreateWorkflowExecution")
+	s.IsType(&TimeoutError{}, err)
+
+	injector.config.TimeoutRate = operationRates(map[string]float64{"CreateWorkflowExecution": 1})
+	err = injector.inject("UpdateWorkflowExecution")
+	s.IsType(&ConditionFailedError{}, err)
+
+	err = injector.inject("GetWorkflowExecution")
+	s.IsType(&ShardOwnershipLostError{}, err)
+	s.Equal(5, err.(*ShardOwnershipLostError).ShardID)
+
+	s.NoError(injector.inject("GetShard"))
+}
+
+func (s *faultInjectionSuite) TestInject_Latency() {
+	injector := newFaultInjector(&config.FaultInjectionConfig{
+		LatencyRate: dynamicconfig.GetFloatPropertyFn(1),
+		Latency:     dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond),
+	}, 1, loggerimpl.NewNopLogger())
+
+	start := time.Now()
+	s.NoError(injector.inject("GetShard"))
+	s.True(time.Since(start) >= 10*time.Millisecond)
+}
+
+// operationRates returns a rate property keyed by operation name, "" is the rate without any filter
+func operationRates(rates map[string]float64) dynamicconfig.FloatPropertyFn {
+	return func(opts ...dynamicconfig.FilterOption) float64 {
+		filters := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filters)
+		}
+		operation, _ := filters[dynamicconfig.OperationName].(string)
+		return rates[operation]
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 )
 
 type (
@@ -68,6 +69,7 @@ type (
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
 
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -85,6 +87,12 @@ type (
 
 		// Remove corrupted task
 		DeleteTask(request *DeleteTaskRequest) error
+
+		// DLQ related methods
+		PutTaskToDLQ(request *InternalPutTaskToDLQRequest) error
+		GetTasksFromDLQ(request *GetTasksFromDLQRequest) (*InternalGetTasksFromDLQResponse, error)
+		DeleteTaskFromDLQ(request *DeleteTaskFromDLQRequest) error
+		RangeDeleteTasksFromDLQ(request *RangeDeleteTasksFromDLQRequest) error
 	}
 
 	// HistoryV2Store is to manager workflow history events
@@ -154,6 +162,8 @@ type (
 	DataBlob struct {
 		Encoding common.EncodingType
 		Data     []byte
+		// KeyID is the ID of the key used to encrypt Data, only set for EncodingTypeEncrypted
+		KeyID string
 	}
 
 	// InternalCreateWorkflowExecutionRequest is used to write a new workflow execution
@@ -225,6 +235,7 @@ type (
 		ExpirationSeconds  int32
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
+		Paused             bool
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -243,6 +254,7 @@ type (
 		SignalInfos         map[int64]*SignalInfo
 		SignalRequestedIDs  map[string]struct{}
 		BufferedEvents      []*DataBlob
+		Checksum            checksum.Checksum
 	}
 
 	// InternalActivityInfo details  for Persistence Interface
@@ -379,6 +391,7 @@ type (
 		ReplicationTasks []Task
 
 		Condition int64
+		Checksum  checksum.Checksum
 	}
 
 	// InternalWorkflowSnapshot is used as generic workflow execution state snapshot for Persistence Interface
@@ -401,6 +414,20 @@ type (
 		ReplicationTasks []Task
 
 		Condition int64
+		Checksum  checksum.Checksum
+	}
+
+	// InternalPutTaskToDLQRequest is used to put a serialized task into the shard DLQ for Persistence Interface
+	InternalPutTaskToDLQRequest struct {
+		Category int
+		TaskID   int64
+		Task     *DataBlob
+	}
+
+	// InternalGetTasksFromDLQResponse is the response to GetTasksFromDLQ for Persistence Interface
+	InternalGetTasksFromDLQResponse struct {
+		Tasks         []*DataBlob
+		NextPageToken []byte
 	}
 
 	// InternalAppendHistoryEventsRequest is used to append new events to workflow execution history  for Persistence Interface
@@ -513,6 +540,8 @@ type (
 		LastTransactionID int64
 		// Used in sharded data stores to identify which shard to use
 		ShardID int
+		// ReadFromPrimary skips the read replicas of the store
+		ReadFromPrimary bool
 	}
 
 	// InternalCompleteForkBranchRequest is used to update some tree/branch meta data for forking
@@ -549,6 +578,7 @@ type (
 		HistoryLength    int64
 		Memo             *DataBlob
 		SearchAttributes map[string]interface{}
+		Paused           bool
 	}
 
 	// InternalListWorkflowExecutionsResponse is response from ListWorkflowExecutions
@@ -607,6 +637,7 @@ type (
 		TaskID             int64
 		Memo               *DataBlob
 		SearchAttributes   map[string][]byte
+		Paused             bool
 	}
 
 	// InternalDomainConfig describes the domain configuration
@@ -668,7 +699,7 @@ func NewDataBlob(data []byte, encodingType common.EncodingType) *DataBlob {
 	if data == nil || len(data) == 0 {
 		return nil
 	}
-	if encodingType != "thriftrw" && data[0] == 'Y' {
+	if (encodingType == common.EncodingTypeJSON || encodingType == common.EncodingTypeEmpty) && data[0] == 'Y' {
 		panic(fmt.Sprintf("Invalid incoding: \"%v\"", encodingType))
 	}
 	return &DataBlob{
@@ -687,6 +718,9 @@ func FromDataBlob(blob *DataBlob) ([]byte, string) {
 
 // GetEncoding returns encoding type
 func (d *DataBlob) GetEncoding() common.EncodingType {
+	if d == nil {
+		return common.EncodingTypeEmpty
+	}
 	encodingStr := string(d.Encoding)
 
 	switch common.EncodingType(encodingStr) {
@@ -696,6 +730,12 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeThriftRWSnappy:
+		return common.EncodingTypeThriftRWSnappy
+	case common.EncodingTypeThriftRWZstd:
+		return common.EncodingTypeThriftRWZstd
+	case common.EncodingTypeEncrypted:
+		return common.EncodingTypeEncrypted
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
 	default:
@@ -716,6 +756,16 @@ func (d *DataBlob) ToThrift() *workflow.DataBlob {
 			EncodingType: workflow.EncodingTypeThriftRW.Ptr(),
 			Data:         d.Data,
 		}
+	case common.EncodingTypeThriftRWSnappy:
+		return &workflow.DataBlob{
+			EncodingType: workflow.EncodingTypeThriftRWSnappy.Ptr(),
+			Data:         d.Data,
+		}
+	case common.EncodingTypeThriftRWZstd:
+		return &workflow.DataBlob{
+			EncodingType: workflow.EncodingTypeThriftRWZstd.Ptr(),
+			Data:         d.Data,
+		}
 	default:
 		panic(fmt.Sprintf("DataBlob seeing unsupported enconding type: %v", d.Encoding))
 	}
@@ -734,6 +784,16 @@ func NewDataBlobFromThrift(blob *workflow.DataBlob) *DataBlob {
 			Encoding: common.EncodingTypeThriftRW,
 			Data:     blob.Data,
 		}
+	case workflow.EncodingTypeThriftRWSnappy:
+		return &DataBlob{
+			Encoding: common.EncodingTypeThriftRWSnappy,
+			Data:     blob.Data,
+		}
+	case workflow.EncodingTypeThriftRWZstd:
+		return &DataBlob{
+			Encoding: common.EncodingTypeThriftRWZstd,
+			Data:     blob.Data,
+		}
 	default:
 		panic(fmt.Sprintf("NewDataBlobFromThrift seeing unsupported enconding type: %v", blob.GetEncodingType()))
 	}
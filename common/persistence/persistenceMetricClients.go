@@ -330,6 +330,20 @@ func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCur
 	return response, err
 }
 
+func (p *workflowExecutionPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListConcreteExecutions(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListConcreteExecutionsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
@@ -455,6 +469,62 @@ func (p *workflowExecutionPersistenceClient) DeleteTask(request *DeleteTaskReque
 	return err
 }
 
+func (p *workflowExecutionPersistenceClient) PutTaskToDLQ(request *PutTaskToDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutTaskToDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutTaskToDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.PutTaskToDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutTaskToDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetTasksFromDLQ(request *GetTasksFromDLQRequest) (*GetTasksFromDLQResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetTasksFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetTasksFromDLQScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetTasksFromDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetTasksFromDLQScope, err)
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) DeleteTaskFromDLQ(request *DeleteTaskFromDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteTaskFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteTaskFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteTaskFromDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteTaskFromDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) RangeDeleteTasksFromDLQ(request *RangeDeleteTasksFromDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteTasksFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteTasksFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeDeleteTasksFromDLQ(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteTasksFromDLQScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *WorkflowExecutionAlreadyStartedError:
@@ -272,6 +272,15 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(requ
 	return response, err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListConcreteExecutions(request)
+	return response, err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -353,6 +362,42 @@ func (p *workflowExecutionRateLimitedPersistenceClient) DeleteTask(request *Dele
 	return err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) PutTaskToDLQ(request *PutTaskToDLQRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.PutTaskToDLQ(request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) GetTasksFromDLQ(request *GetTasksFromDLQRequest) (*GetTasksFromDLQResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetTasksFromDLQ(request)
+	return response, err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) DeleteTaskFromDLQ(request *DeleteTaskFromDLQRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.DeleteTaskFromDLQ(request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) RangeDeleteTasksFromDLQ(request *RangeDeleteTasksFromDLQRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RangeDeleteTasksFromDLQ(request)
+	return err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -24,9 +24,12 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/encryption"
 )
 
 type (
@@ -56,6 +59,18 @@ type (
 		// serialize/deserialize version histories
 		SerializeVersionHistories(histories *workflow.VersionHistories, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeVersionHistories(data *DataBlob) (*workflow.VersionHistories, error)
+
+		// serialize/deserialize transfer and timer tasks of the shard DLQ, always encoded as json
+		SerializeDLQTask(task *DLQTaskInfo) (*DataBlob, error)
+		DeserializeDLQTask(data *DataBlob) (*DLQTaskInfo, error)
+
+		// decrypt a blob written with an encrypted encoding, blobs with any other encoding are returned as is
+		DecryptBlob(data *DataBlob) (*DataBlob, error)
+
+		// encrypt/decrypt opaque payloads without an encoding of their own, e.g. activity heartbeat and failure
+		// details, which are encrypted iff the blob they are stored alongside has encoding EncodingTypeEncrypted
+		EncryptPayload(data []byte, alongside common.EncodingType) ([]byte, error)
+		DecryptPayload(data []byte, alongside common.EncodingType) ([]byte, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -75,6 +90,7 @@ type (
 
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
+		keyProvider     encryption.KeyProvider
 	}
 )
 
@@ -85,6 +101,15 @@ func NewPayloadSerializer() PayloadSerializer {
 	}
 }
 
+// NewPayloadSerializerWithEncryption returns a PayloadSerializer which is able to encrypt
+// and decrypt payloads with keys from given key provider, see EncryptedEncoding
+func NewPayloadSerializerWithEncryption(keyProvider encryption.KeyProvider) PayloadSerializer {
+	return &serializerImpl{
+		thriftrwEncoder: codec.NewThriftRWEncoder(),
+		keyProvider:     keyProvider,
+	}
+}
+
 func (t *serializerImpl) SerializeBatchEvents(events []*workflow.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
 	return t.serialize(events, encodingType)
 }
@@ -171,17 +196,82 @@ func (t *serializerImpl) DeserializeVersionHistories(data *DataBlob) (*workflow.
 	return &histories, err
 }
 
+func (t *serializerImpl) SerializeDLQTask(task *DLQTaskInfo) (*DataBlob, error) {
+	if task == nil {
+		return nil, nil
+	}
+	return t.serialize(task, common.EncodingTypeJSON)
+}
+
+func (t *serializerImpl) DeserializeDLQTask(data *DataBlob) (*DLQTaskInfo, error) {
+	var task DLQTaskInfo
+	err := t.deserialize(data, &task)
+	return &task, err
+}
+
+func (t *serializerImpl) DecryptBlob(data *DataBlob) (*DataBlob, error) {
+	if data == nil || data.GetEncoding() != common.EncodingTypeEncrypted {
+		return data, nil
+	}
+	blob, err := t.decrypt(data)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(err.Error())
+	}
+	return blob, nil
+}
+
+func (t *serializerImpl) EncryptPayload(data []byte, alongside common.EncodingType) ([]byte, error) {
+	if len(data) == 0 || alongside != common.EncodingTypeEncrypted {
+		return data, nil
+	}
+	blob, err := t.encrypt(&DataBlob{Data: data, Encoding: common.EncodingTypeEmpty})
+	if err != nil {
+		return nil, NewCadenceSerializationError(err.Error())
+	}
+	return blob.Data, nil
+}
+
+func (t *serializerImpl) DecryptPayload(data []byte, alongside common.EncodingType) ([]byte, error) {
+	if len(data) == 0 || alongside != common.EncodingTypeEncrypted {
+		return data, nil
+	}
+	blob, err := t.decrypt(&DataBlob{Data: data, Encoding: common.EncodingTypeEncrypted})
+	if err != nil {
+		return nil, NewCadenceDeserializationError(err.Error())
+	}
+	return blob.Data, nil
+}
+
 func (t *serializerImpl) serialize(input interface{}, encodingType common.EncodingType) (*DataBlob, error) {
 	if input == nil {
 		return nil, nil
 	}
 
+	if encoding, ok := parseEncryptedEncoding(encodingType); ok {
+		blob, err := t.serialize(input, encoding)
+		if err != nil || blob == nil {
+			return blob, err
+		}
+		if blob, err = t.encrypt(blob); err != nil {
+			return nil, NewCadenceSerializationError(err.Error())
+		}
+		return blob, nil
+	}
+
 	var data []byte
 	var err error
 
 	switch encodingType {
 	case common.EncodingTypeThriftRW:
 		data, err = t.thriftrwEncode(input)
+	case common.EncodingTypeThriftRWSnappy:
+		if data, err = t.thriftrwEncode(input); err == nil {
+			data = snappy.Encode(nil, data)
+		}
+	case common.EncodingTypeThriftRWZstd:
+		if data, err = t.thriftrwEncode(input); err == nil {
+			data, err = zstd.Compress(nil, data)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		encodingType = common.EncodingTypeJSON
 		data, err = json.Marshal(input)
@@ -224,8 +314,24 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 	var err error
 
 	switch data.GetEncoding() {
+	case common.EncodingTypeEncrypted:
+		blob, err := t.decrypt(data)
+		if err != nil {
+			return NewCadenceDeserializationError(err.Error())
+		}
+		return t.deserialize(blob, target)
 	case common.EncodingTypeThriftRW:
 		err = t.thriftrwDecode(data.Data, target)
+	case common.EncodingTypeThriftRWSnappy:
+		var decoded []byte
+		if decoded, err = snappy.Decode(nil, data.Data); err == nil {
+			err = t.thriftrwDecode(decoded, target)
+		}
+	case common.EncodingTypeThriftRWZstd:
+		var decoded []byte
+		if decoded, err = zstd.Decompress(nil, data.Data); err == nil {
+			err = t.thriftrwDecode(decoded, target)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		err = json.Unmarshal(data.Data, target)
 	default:
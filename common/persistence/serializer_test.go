@@ -30,6 +30,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 )
@@ -42,8 +43,25 @@ type (
 		*require.Assertions
 		logger log.Logger
 	}
+
+	testKeyProvider struct {
+		activeKeyID string
+		keys        map[string][]byte
+	}
 )
 
+func (p *testKeyProvider) ActiveKeyID() string {
+	return p.activeKeyID
+}
+
+func (p *testKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, encryption.ErrKeyNotFound
+	}
+	return key, nil
+}
+
 func TestCadenceSerializerSuite(t *testing.T) {
 	s := new(cadenceSerializerSuite)
 	suite.Run(t, s)
@@ -409,3 +427,147 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *cadenceSerializerSuite) TestSerializer_CompressedBatchEvents() {
+	serializer := NewPayloadSerializer()
+
+	var events []*workflow.HistoryEvent
+	for i := int64(1); i <= 20; i++ {
+		events = append(events, &workflow.HistoryEvent{
+			EventId:   common.Int64Ptr(i),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+			ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+				Result:           []byte("a repetitive activity result payload"),
+				ScheduledEventId: common.Int64Ptr(4),
+				StartedEventId:   common.Int64Ptr(5),
+				Identity:         common.StringPtr("worker-identity"),
+			},
+		})
+	}
+	history0 := &workflow.History{Events: events}
+
+	dsThrift, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.Nil(err)
+
+	for _, encoding := range []common.EncodingType{
+		common.EncodingTypeThriftRWSnappy,
+		common.EncodingTypeThriftRWZstd,
+	} {
+		blob, err := serializer.SerializeBatchEvents(events, encoding)
+		s.Nil(err)
+		s.Equal(encoding, blob.GetEncoding())
+		s.True(len(blob.Data) < len(dsThrift.Data))
+
+		deserialized, err := serializer.DeserializeBatchEvents(blob)
+		s.Nil(err)
+		s.True(history0.Equals(&workflow.History{Events: deserialized}))
+
+		// encoding must survive the thrift representation used by replication
+		replicated := NewDataBlobFromThrift(blob.ToThrift())
+		s.Equal(encoding, replicated.GetEncoding())
+		deserialized, err = serializer.DeserializeBatchEvents(replicated)
+		s.Nil(err)
+		s.True(history0.Equals(&workflow.History{Events: deserialized}))
+	}
+}
+
+func (s *cadenceSerializerSuite) TestSerializer_Encryption() {
+	keyProvider := &testKeyProvider{
+		activeKeyID: "key-1",
+		keys: map[string][]byte{
+			"key-1": []byte("0123456789abcdef0123456789abcdef"),
+			"key-2": []byte("fedcba9876543210fedcba9876543210"),
+		},
+	}
+	serializer := NewPayloadSerializerWithEncryption(keyProvider)
+
+	payload := []byte("sensitive activity result")
+	events := []*workflow.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+			ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+				Result:           payload,
+				ScheduledEventId: common.Int64Ptr(4),
+				StartedEventId:   common.Int64Ptr(5),
+			},
+		},
+	}
+	history0 := &workflow.History{Events: events}
+
+	for _, encoding := range []common.EncodingType{
+		common.EncodingTypeThriftRW,
+		common.EncodingTypeThriftRWSnappy,
+		common.EncodingTypeJSON,
+	} {
+		blob, err := serializer.SerializeBatchEvents(events, EncryptedEncoding(encoding))
+		s.Nil(err)
+		s.Equal(common.EncodingTypeEncrypted, blob.GetEncoding())
+		s.Equal("key-1", blob.KeyID)
+		s.NotContains(string(blob.Data), string(payload))
+
+		deserialized, err := serializer.DeserializeBatchEvents(blob)
+		s.Nil(err)
+		s.True(history0.Equals(&workflow.History{Events: deserialized}))
+
+		decrypted, err := serializer.DecryptBlob(blob)
+		s.Nil(err)
+		s.Equal(encoding, decrypted.GetEncoding())
+		deserialized, err = serializer.DeserializeBatchEvents(decrypted)
+		s.Nil(err)
+		s.True(history0.Equals(&workflow.History{Events: deserialized}))
+	}
+
+	// blobs encrypted with a rotated key stay readable
+	blob, err := serializer.SerializeBatchEvents(events, EncryptedEncoding(common.EncodingTypeThriftRW))
+	s.Nil(err)
+	keyProvider.activeKeyID = "key-2"
+	deserialized, err := serializer.DeserializeBatchEvents(blob)
+	s.Nil(err)
+	s.True(history0.Equals(&workflow.History{Events: deserialized}))
+	rotated, err := serializer.SerializeBatchEvents(events, EncryptedEncoding(common.EncodingTypeThriftRW))
+	s.Nil(err)
+	s.Equal("key-2", rotated.KeyID)
+
+	// unencrypted blobs stay readable and are not touched by DecryptBlob
+	plain, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.Nil(err)
+	decrypted, err := serializer.DecryptBlob(plain)
+	s.Nil(err)
+	s.Equal(plain, decrypted)
+
+	// opaque payloads are only encrypted alongside encrypted blobs
+	encryptedPayload, err := serializer.EncryptPayload(payload, blob.GetEncoding())
+	s.Nil(err)
+	s.NotContains(string(encryptedPayload), string(payload))
+	decryptedPayload, err := serializer.DecryptPayload(encryptedPayload, blob.GetEncoding())
+	s.Nil(err)
+	s.Equal(payload, decryptedPayload)
+	plainPayload, err := serializer.EncryptPayload(payload, plain.GetEncoding())
+	s.Nil(err)
+	s.Equal(payload, plainPayload)
+	decryptedPayload, err = serializer.DecryptPayload(plainPayload, plain.GetEncoding())
+	s.Nil(err)
+	s.Equal(payload, decryptedPayload)
+
+	// tampered or unknown key
+	tampered := &DataBlob{Encoding: blob.Encoding, Data: append([]byte(nil), blob.Data...)}
+	tampered.Data[len(tampered.Data)-1] ^= 0xff
+	_, err = serializer.DeserializeBatchEvents(tampered)
+	s.NotNil(err)
+	_, ok := err.(*CadenceDeserializationError)
+	s.True(ok)
+	delete(keyProvider.keys, "key-1")
+	_, err = serializer.DeserializeBatchEvents(blob)
+	s.NotNil(err)
+
+	// encryption requires a key provider
+	_, err = NewPayloadSerializer().SerializeBatchEvents(events, EncryptedEncoding(common.EncodingTypeThriftRW))
+	s.NotNil(err)
+	_, ok = err.(*CadenceSerializationError)
+	s.True(ok)
+	_, err = NewPayloadSerializer().DeserializeBatchEvents(rotated)
+	s.NotNil(err)
+}
@@ -29,15 +29,17 @@ import (
 	"github.com/uber/cadence/common/persistence/sql/storage"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	// Factory vends store objects backed by MySQL
 	Factory struct {
-		cfg         config.SQL
-		dbConn      dbConn
-		clusterName string
-		logger      log.Logger
+		cfg              config.SQL
+		dbConn           dbConn
+		clusterName      string
+		readFromReplicas dynamicconfig.BoolPropertyFn
+		logger           log.Logger
 	}
 
 	// dbConn represents a logical mysql connection - its a
@@ -52,13 +54,15 @@ type (
 )
 
 // NewFactory returns an instance of a factory object which can be used to create
-// datastores backed by any kind of SQL store
-func NewFactory(cfg config.SQL, clusterName string, logger log.Logger) *Factory {
+// datastores backed by any kind of SQL store. Visibility and history reads are routed
+// to the read replicas of the store when readFromReplicas is true
+func NewFactory(cfg config.SQL, clusterName string, readFromReplicas dynamicconfig.BoolPropertyFn, logger log.Logger) *Factory {
 	return &Factory{
-		cfg:         cfg,
-		clusterName: clusterName,
-		logger:      logger,
-		dbConn:      newRefCountedDBConn(&cfg),
+		cfg:              cfg,
+		clusterName:      clusterName,
+		readFromReplicas: readFromReplicas,
+		logger:           logger,
+		dbConn:           newRefCountedDBConn(&cfg),
 	}
 }
 
@@ -86,7 +90,7 @@ func (f *Factory) NewHistoryV2Store() (p.HistoryV2Store, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newHistoryV2Persistence(conn, f.logger)
+	return newHistoryV2Persistence(conn, newReadReplicas(&f.cfg, conn, f.readFromReplicas, f.logger), f.logger)
 }
 
 // NewMetadataStore returns a new metadata store
@@ -109,7 +113,7 @@ func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
 
 // NewVisibilityStore returns a visibility store
 func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
-	return NewSQLVisibilityStore(f.cfg, f.logger)
+	return NewSQLVisibilityStore(f.cfg, f.readFromReplicas, f.logger)
 }
 
 // NewQueue returns a new queue backed by sql
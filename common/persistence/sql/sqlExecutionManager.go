@@ -30,6 +30,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
@@ -281,6 +282,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
 		SearchAttributes:                   info.GetSearchAttributes(),
 		Memo:                               info.GetMemo(),
+		Paused:                             info.GetPaused(),
 	}
 
 	if info.LastWriteEventID != nil {
@@ -302,6 +304,14 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		)
 	}
 
+	if info.Checksum != nil {
+		state.Checksum = checksum.Checksum{
+			Version: int(info.GetChecksumVersion()),
+			Flavor:  checksum.Flavor(info.GetChecksumFlavor()),
+			Value:   info.GetChecksum(),
+		}
+	}
+
 	if info.ParentDomainID != nil {
 		state.ExecutionInfo.ParentDomainID = sqldb.UUID(info.ParentDomainID).String()
 		state.ExecutionInfo.ParentWorkflowID = info.GetParentWorkflowID()
@@ -835,6 +845,60 @@ func (m *sqlExecutionManager) GetCurrentExecution(
 	}, nil
 }
 
+type concreteExecutionPageToken struct {
+	DomainID   string
+	WorkflowID string
+	RunID      string
+}
+
+func (m *sqlExecutionManager) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.ListConcreteExecutionsResponse, error) {
+
+	filter := &sqldb.ExecutionsFilter{ShardID: m.shardID}
+	if len(request.PageToken) > 0 {
+		var token concreteExecutionPageToken
+		if err := json.Unmarshal(request.PageToken, &token); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing concreteExecutionPageToken: %v", err),
+			}
+		}
+		filter.DomainID = sqldb.MustParseUUID(token.DomainID)
+		filter.WorkflowID = token.WorkflowID
+		filter.RunID = sqldb.MustParseUUID(token.RunID)
+	}
+
+	rows, err := m.db.RangeSelectFromExecutions(filter, request.PageSize)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Select failed. Error: %v", err),
+		}
+	}
+
+	resp := &p.ListConcreteExecutionsResponse{Executions: make([]*p.ConcreteExecutionKey, len(rows))}
+	for i, row := range rows {
+		resp.Executions[i] = &p.ConcreteExecutionKey{
+			DomainID:   row.DomainID.String(),
+			WorkflowID: row.WorkflowID,
+			RunID:      row.RunID.String(),
+		}
+	}
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		last := resp.Executions[len(rows)-1]
+		resp.NextPageToken, err = json.Marshal(&concreteExecutionPageToken{
+			DomainID:   last.DomainID,
+			WorkflowID: last.WorkflowID,
+			RunID:      last.RunID,
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error serializing concreteExecutionPageToken: %v", err),
+			}
+		}
+	}
+	return resp, nil
+}
+
 func (m *sqlExecutionManager) GetTransferTasks(
 	request *p.GetTransferTasksRequest,
 ) (*p.GetTransferTasksResponse, error) {
@@ -1106,3 +1170,90 @@ func (m *sqlExecutionManager) RangeCompleteTimerTask(
 	}
 	return nil
 }
+
+func (m *sqlExecutionManager) PutTaskToDLQ(
+	request *p.InternalPutTaskToDLQRequest,
+) error {
+
+	if _, err := m.db.ReplaceIntoTaskDLQ(&sqldb.TaskDLQRow{
+		ShardID:      m.shardID,
+		Category:     request.Category,
+		TaskID:       request.TaskID,
+		Data:         request.Task.Data,
+		DataEncoding: string(request.Task.GetEncoding()),
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetTasksFromDLQ(
+	request *p.GetTasksFromDLQRequest,
+) (*p.InternalGetTasksFromDLQResponse, error) {
+
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := m.db.SelectFromTaskDLQ(&sqldb.TaskDLQFilter{
+		ShardID:   m.shardID,
+		Category:  request.Category,
+		MinTaskID: &readLevel,
+		MaxTaskID: &request.MaxReadLevel,
+		PageSize:  &request.BatchSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetTasksFromDLQ operation failed. Select failed: %v", err),
+		}
+	}
+
+	response := &p.InternalGetTasksFromDLQResponse{Tasks: make([]*p.DataBlob, len(rows))}
+	for i, row := range rows {
+		response.Tasks[i] = p.NewDataBlob(row.Data, common.EncodingType(row.DataEncoding))
+	}
+	if len(rows) > 0 && len(rows) == request.BatchSize {
+		response.NextPageToken = serializePageToken(rows[len(rows)-1].TaskID)
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionManager) DeleteTaskFromDLQ(
+	request *p.DeleteTaskFromDLQRequest,
+) error {
+
+	if _, err := m.db.DeleteFromTaskDLQ(&sqldb.TaskDLQFilter{
+		ShardID:  m.shardID,
+		Category: request.Category,
+		TaskID:   &request.TaskID,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) RangeDeleteTasksFromDLQ(
+	request *p.RangeDeleteTasksFromDLQRequest,
+) error {
+
+	if _, err := m.db.DeleteFromTaskDLQ(&sqldb.TaskDLQFilter{
+		ShardID:   m.shardID,
+		Category:  request.Category,
+		MinTaskID: &request.ExclusiveBeginTaskID,
+		MaxTaskID: &request.InclusiveEndTaskID,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteTasksFromDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
@@ -42,6 +43,7 @@ func applyWorkflowMutationTx(
 	executionInfo := workflowMutation.ExecutionInfo
 	replicationState := workflowMutation.ReplicationState
 	versionHistories := workflowMutation.VersionHistories
+	checksum := workflowMutation.Checksum
 	startVersion := workflowMutation.StartVersion
 	lastWriteVersion := workflowMutation.LastWriteVersion
 	domainID := sqldb.MustParseUUID(executionInfo.DomainID)
@@ -76,6 +78,7 @@ func applyWorkflowMutationTx(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		startVersion,
 		lastWriteVersion,
 		currentVersion,
@@ -202,6 +205,7 @@ func applyWorkflowSnapshotTxAsReset(
 	executionInfo := workflowSnapshot.ExecutionInfo
 	replicationState := workflowSnapshot.ReplicationState
 	versionHistories := workflowSnapshot.VersionHistories
+	checksum := workflowSnapshot.Checksum
 	startVersion := workflowSnapshot.StartVersion
 	lastWriteVersion := workflowSnapshot.LastWriteVersion
 	domainID := sqldb.MustParseUUID(executionInfo.DomainID)
@@ -236,6 +240,7 @@ func applyWorkflowSnapshotTxAsReset(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		startVersion,
 		lastWriteVersion,
 		currentVersion,
@@ -409,6 +414,7 @@ func applyWorkflowSnapshotTxAsNew(
 	executionInfo := workflowSnapshot.ExecutionInfo
 	replicationState := workflowSnapshot.ReplicationState
 	versionHistories := workflowSnapshot.VersionHistories
+	checksum := workflowSnapshot.Checksum
 	startVersion := workflowSnapshot.StartVersion
 	lastWriteVersion := workflowSnapshot.LastWriteVersion
 	domainID := sqldb.MustParseUUID(executionInfo.DomainID)
@@ -426,6 +432,7 @@ func applyWorkflowSnapshotTxAsNew(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		startVersion,
 		lastWriteVersion,
 		currentVersion,
@@ -1198,6 +1205,7 @@ func buildExecutionRow(
 	executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
 	versionHistories *p.DataBlob,
+	checksum checksum.Checksum,
 	startVersion int64,
 	lastWriteVersion int64,
 	currentVersion int64,
@@ -1250,6 +1258,10 @@ func buildExecutionRow(
 		AutoResetPointsEncoding:                 common.StringPtr(string(executionInfo.AutoResetPoints.GetEncoding())),
 		SearchAttributes:                        executionInfo.SearchAttributes,
 		Memo:                                    executionInfo.Memo,
+		Paused:                                  &executionInfo.Paused,
+		ChecksumVersion:                         common.Int32Ptr(int32(checksum.Version)),
+		ChecksumFlavor:                          common.Int32Ptr(int32(checksum.Flavor)),
+		Checksum:                                checksum.Value,
 	}
 
 	completionEvent := executionInfo.CompletionEvent
@@ -1312,6 +1324,7 @@ func createExecution(
 	executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
 	versionHistories *p.DataBlob,
+	checksum checksum.Checksum,
 	startVersion int64,
 	lastWriteVersion int64,
 	currentVersion int64,
@@ -1333,6 +1346,7 @@ func createExecution(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		startVersion,
 		lastWriteVersion,
 		currentVersion,
@@ -1367,6 +1381,7 @@ func updateExecution(
 	executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
 	versionHistories *p.DataBlob,
+	checksum checksum.Checksum,
 	startVersion int64,
 	lastWriteVersion int64,
 	currentVersion int64,
@@ -1387,6 +1402,7 @@ func updateExecution(
 		executionInfo,
 		replicationState,
 		versionHistories,
+		checksum,
 		startVersion,
 		lastWriteVersion,
 		currentVersion,
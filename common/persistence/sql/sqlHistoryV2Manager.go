@@ -26,7 +26,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
@@ -37,12 +36,14 @@ import (
 
 type sqlHistoryV2Manager struct {
 	sqlStore
-	shardID int
+	shardID  int
+	replicas *readReplicas
 }
 
 // newHistoryV2Persistence creates an instance of HistoryManager
 func newHistoryV2Persistence(
 	db sqldb.Interface,
+	replicas *readReplicas,
 	logger log.Logger,
 ) (p.HistoryV2Store, error) {
 
@@ -51,9 +52,15 @@ func newHistoryV2Persistence(
 			db:     db,
 			logger: logger,
 		},
+		replicas: replicas,
 	}, nil
 }
 
+func (m *sqlHistoryV2Manager) Close() {
+	m.replicas.close()
+	m.sqlStore.Close()
+}
+
 func (m *sqlHistoryV2Manager) serializeAncestors(
 	ans []*shared.HistoryBranchRange,
 ) ([]byte, error) {
@@ -156,7 +163,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(
 
 	_, err := m.db.InsertIntoHistoryNode(nodeRow)
 	if err != nil {
-		if sqlErr, ok := err.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+		if m.db.IsDupEntryError(err) {
 			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: %v", err)}
 		}
 		return &shared.InternalServiceError{Message: fmt.Sprintf("AppendHistoryEvents: %v", err)}
@@ -196,7 +203,16 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(
 		ShardID:   request.ShardID,
 	}
 
-	rows, err := m.db.SelectFromHistoryNode(filter)
+	read := m.replicas.read
+	if request.ReadFromPrimary {
+		read = m.replicas.readPrimary
+	}
+	var rows []sqldb.HistoryNodeRow
+	err := read(func(db sqldb.Interface) error {
+		var err error
+		rows, err = db.SelectFromHistoryNode(filter)
+		return err
+	})
 	if err == sql.ErrNoRows || (err == nil && len(rows) == 0) {
 		return &p.InternalReadHistoryBranchResponse{}, nil
 	}
@@ -23,6 +23,7 @@ package sql
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -34,9 +35,11 @@ import (
 )
 
 const (
-	testUser      = "uber"
-	testPassword  = "uber"
-	testSchemaDir = "schema/mysql/v57"
+	testUser              = "uber"
+	testPassword          = "uber"
+	testSchemaDir         = "schema/mysql/v57"
+	testPostgresSchemaDir = "schema/postgres"
+	testSQLiteSchemaDir   = "schema/sqlite"
 )
 
 // TestCluster allows executing cassandra operations in testing.
@@ -47,23 +50,44 @@ type TestCluster struct {
 	cfg       config.SQL
 }
 
-// NewTestCluster returns a new SQL test cluster
-func NewTestCluster(dbName string, port int, schemaDir string) *TestCluster {
+// NewTestCluster returns a new SQL test cluster. An empty driverName
+// defaults to mysql. For sqlite, the database is a file named after
+// dbName in the os temp directory
+func NewTestCluster(driverName string, dbName string, port int, schemaDir string) *TestCluster {
 	var result TestCluster
+	if driverName == "" {
+		driverName = defaultDriverName
+	}
+	if driverName == sqliteDriverName {
+		dbName = filepath.Join(os.TempDir(), dbName+".db")
+	}
 	result.dbName = dbName
+	address := environment.GetMySQLAddress()
+	if driverName == postgresDriverName {
+		address = environment.GetPostgresAddress()
+	}
 	if port == 0 {
 		port = environment.GetMySQLPort()
+		if driverName == postgresDriverName {
+			port = environment.GetPostgresPort()
+		}
 	}
 	if schemaDir == "" {
 		schemaDir = testSchemaDir
+		switch driverName {
+		case postgresDriverName:
+			schemaDir = testPostgresSchemaDir
+		case sqliteDriverName:
+			schemaDir = testSQLiteSchemaDir
+		}
 	}
 	result.schemaDir = schemaDir
 	result.cfg = config.SQL{
 		User:            testUser,
 		Password:        testPassword,
-		ConnectAddr:     fmt.Sprintf("%v:%v", environment.GetMySQLAddress(), port),
+		ConnectAddr:     fmt.Sprintf("%v:%v", address, port),
 		ConnectProtocol: "tcp",
-		DriverName:      defaultDriverName,
+		DriverName:      driverName,
 		DatabaseName:    dbName,
 		NumShards:       4,
 	}
@@ -130,6 +154,24 @@ func (s *TestCluster) CreateDatabase() {
 
 // DropDatabase from PersistenceTestCluster interface
 func (s *TestCluster) DropDatabase() {
+	if s.cfg.DriverName == sqliteDriverName {
+		s.db.Close()
+		if err := dropSQLiteDatabase(s.dbName); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if s.cfg.DriverName == postgresDriverName {
+		// postgres does not allow dropping the database the session is connected to
+		s.db.Close()
+		cfg := s.cfg
+		cfg.DatabaseName = postgresDefaultDatabase
+		db, err := newConnection(cfg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		s.db = db
+	}
 	err := dropDatabase(s.db, s.dbName)
 	if err != nil {
 		log.Fatal(err)
@@ -0,0 +1,186 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence/sql/storage"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// readReplicas routes read only queries to the healthy read replicas of a database in
+	// round robin order. Queries go to the primary when no replica is healthy, when a replica
+	// query fails or when the caller has not opted in to read from replicas
+	readReplicas struct {
+		cfg              *config.SQL
+		primary          sqldb.Interface
+		replicas         []*readReplica
+		readFromReplicas dynamicconfig.BoolPropertyFn
+		next             uint32
+		logger           log.Logger
+		stopC            chan struct{}
+		stopOnce         sync.Once
+	}
+
+	readReplica struct {
+		sync.Mutex
+		addr    string
+		db      sqldb.Interface
+		healthy int32
+	}
+)
+
+const (
+	defaultReplicaHealthCheckInterval = 10 * time.Second
+)
+
+// newReadReplicas connects to the read replicas in cfg and starts checking their health, replicas
+// which cannot be reached are skipped until a health check succeeds
+func newReadReplicas(
+	cfg *config.SQL,
+	primary sqldb.Interface,
+	readFromReplicas dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) *readReplicas {
+	r := &readReplicas{
+		cfg:              cfg,
+		primary:          primary,
+		readFromReplicas: readFromReplicas,
+		logger:           logger,
+		stopC:            make(chan struct{}),
+	}
+	if len(cfg.ReplicaAddrs) == 0 {
+		return r
+	}
+	for _, addr := range cfg.ReplicaAddrs {
+		replica := &readReplica{addr: addr}
+		r.checkHealth(replica)
+		r.replicas = append(r.replicas, replica)
+	}
+	go r.healthCheckLoop()
+	return r
+}
+
+// read runs op against a healthy replica, op is retried against the primary if it fails
+func (r *readReplicas) read(op func(db sqldb.Interface) error) error {
+	replica := r.pick()
+	if replica == nil {
+		return op(r.primary)
+	}
+	err := op(replica.db)
+	if err == nil || err == sql.ErrNoRows {
+		return err
+	}
+	r.logger.Warn("read from sql replica failed, retrying on primary", tag.Address(replica.addr), tag.Error(err))
+	atomic.StoreInt32(&replica.healthy, 0)
+	return op(r.primary)
+}
+
+// readPrimary runs op against the primary, for reads which have to observe the caller's own writes
+func (r *readReplicas) readPrimary(op func(db sqldb.Interface) error) error {
+	return op(r.primary)
+}
+
+// pick returns the next healthy replica or nil if reads should go to the primary
+func (r *readReplicas) pick() *readReplica {
+	if len(r.replicas) == 0 || r.readFromReplicas == nil || !r.readFromReplicas() {
+		return nil
+	}
+	start := atomic.AddUint32(&r.next, 1)
+	for i := 0; i < len(r.replicas); i++ {
+		replica := r.replicas[(int(start)+i)%len(r.replicas)]
+		if atomic.LoadInt32(&replica.healthy) == 1 {
+			return replica
+		}
+	}
+	return nil
+}
+
+func (r *readReplicas) healthCheckLoop() {
+	interval := r.cfg.ReplicaHealthCheckInterval
+	if interval <= 0 {
+		interval = defaultReplicaHealthCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, replica := range r.replicas {
+				r.checkHealth(replica)
+			}
+		case <-r.stopC:
+			return
+		}
+	}
+}
+
+func (r *readReplicas) checkHealth(replica *readReplica) {
+	replica.Lock()
+	defer replica.Unlock()
+
+	var err error
+	if replica.db == nil {
+		// replica.db is only set once, so reads never see it change
+		var db sqldb.Interface
+		if db, err = storage.NewSQLReplicaDB(r.cfg, replica.addr); err == nil {
+			replica.db = db
+		}
+	} else {
+		err = replica.db.Ping()
+	}
+
+	wasHealthy := atomic.LoadInt32(&replica.healthy) == 1
+	if err != nil {
+		atomic.StoreInt32(&replica.healthy, 0)
+		if wasHealthy || replica.db == nil {
+			r.logger.Warn("sql replica is unhealthy", tag.Address(replica.addr), tag.Error(err))
+		}
+		return
+	}
+	atomic.StoreInt32(&replica.healthy, 1)
+	if !wasHealthy {
+		r.logger.Info("sql replica is healthy", tag.Address(replica.addr))
+	}
+}
+
+// close stops the health checks and closes the connections to the replicas
+func (r *readReplicas) close() {
+	r.stopOnce.Do(func() {
+		close(r.stopC)
+		for _, replica := range r.replicas {
+			replica.Lock()
+			if replica.db != nil {
+				replica.db.Close()
+			}
+			replica.Unlock()
+		}
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	readReplicasSuite struct {
+		suite.Suite
+	}
+
+	testReplicaDB struct {
+		sqldb.Interface
+		name string
+	}
+)
+
+func TestReadReplicasSuite(t *testing.T) {
+	suite.Run(t, new(readReplicasSuite))
+}
+
+func (s *readReplicasSuite) newReadReplicas(readFromReplicas bool, replicas ...*readReplica) *readReplicas {
+	r := newReadReplicas(&config.SQL{}, &testReplicaDB{name: "primary"},
+		dynamicconfig.GetBoolPropertyFn(readFromReplicas), loggerimpl.NewNopLogger())
+	r.replicas = replicas
+	return r
+}
+
+func (s *readReplicasSuite) readFrom(r *readReplicas, fail map[string]bool) []string {
+	var visited []string
+	err := r.read(func(db sqldb.Interface) error {
+		name := db.(*testReplicaDB).name
+		visited = append(visited, name)
+		if fail[name] {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	s.NoError(err)
+	return visited
+}
+
+func (s *readReplicasSuite) TestRead_Disabled() {
+	r := s.newReadReplicas(false, &readReplica{addr: "r1", db: &testReplicaDB{name: "r1"}, healthy: 1})
+	s.Equal([]string{"primary"}, s.readFrom(r, nil))
+}
+
+func (s *readReplicasSuite) TestRead_NoReplicas() {
+	r := s.newReadReplicas(true)
+	s.Equal([]string{"primary"}, s.readFrom(r, nil))
+}
+
+func (s *readReplicasSuite) TestRead_RoundRobinOverHealthyReplicas() {
+	r := s.newReadReplicas(true,
+		&readReplica{addr: "r1", db: &testReplicaDB{name: "r1"}, healthy: 1},
+		&readReplica{addr: "r2", db: &testReplicaDB{name: "r2"}},
+		&readReplica{addr: "r3", db: &testReplicaDB{name: "r3"}, healthy: 1},
+	)
+	visited := map[string]int{}
+	for i := 0; i < 6; i++ {
+		visited[s.readFrom(r, nil)[0]]++
+	}
+	s.Len(visited, 2)
+	s.Contains(visited, "r1")
+	s.Contains(visited, "r3")
+}
+
+func (s *readReplicasSuite) TestRead_FallbackToPrimary() {
+	replica := &readReplica{addr: "r1", db: &testReplicaDB{name: "r1"}, healthy: 1}
+	r := s.newReadReplicas(true, replica)
+	s.Equal([]string{"r1", "primary"}, s.readFrom(r, map[string]bool{"r1": true}))
+	s.Equal(int32(0), replica.healthy)
+	s.Equal([]string{"primary"}, s.readFrom(r, nil))
+}
+
+func (s *readReplicasSuite) TestReadPrimary() {
+	r := s.newReadReplicas(true, &readReplica{addr: "r1", db: &testReplicaDB{name: "r1"}, healthy: 1})
+	var visited []string
+	err := r.readPrimary(func(db sqldb.Interface) error {
+		visited = append(visited, db.(*testReplicaDB).name)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]string{"primary"}, visited)
+}
@@ -0,0 +1,397 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/xwb1989/sqlparser"
+)
+
+type (
+	// visibilityQueryDialect renders the database specific parts of an advanced
+	// visibility query, it is implemented by sqldb.Interface
+	visibilityQueryDialect interface {
+		SearchAttributeExpr(key string) string
+		JSONValueExpr() string
+	}
+
+	// visibilityQuery is an advanced visibility query converted into
+	// fragments of SQL over the executions_visibility table
+	visibilityQuery struct {
+		Condition string
+		Args      []interface{}
+		OrderBy   string
+	}
+
+	visibilityQueryConverter struct {
+		dialect visibilityQueryDialect
+		args    []interface{}
+	}
+)
+
+const (
+	// visibilityQueryMissingValue is used in queries like `CloseTime = missing` to
+	// filter on the absence of a value
+	visibilityQueryMissingValue = "missing"
+)
+
+var (
+	visibilityQueryColumns = map[string]string{
+		definition.DomainID:      "domain_id",
+		definition.WorkflowID:    "workflow_id",
+		definition.RunID:         "run_id",
+		definition.WorkflowType:  "workflow_type_name",
+		definition.StartTime:     "start_time",
+		definition.ExecutionTime: "execution_time",
+		definition.CloseTime:     "close_time",
+		definition.CloseStatus:   "close_status",
+		definition.HistoryLength: "history_length",
+		definition.Paused:        "paused",
+	}
+
+	visibilityQueryOperators = map[string]bool{
+		sqlparser.EqualStr:        true,
+		sqlparser.NotEqualStr:     true,
+		sqlparser.LessThanStr:     true,
+		sqlparser.LessEqualStr:    true,
+		sqlparser.GreaterThanStr:  true,
+		sqlparser.GreaterEqualStr: true,
+		sqlparser.InStr:           true,
+		sqlparser.NotInStr:        true,
+	}
+
+	// reservedSearchAttributeKeys are used internally by visibility and cannot be custom search attributes
+	reservedSearchAttributeKeys = map[string]bool{
+		definition.Encoding: true,
+		definition.KafkaKey: true,
+		definition.Memo:     true,
+	}
+
+	searchAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// convertVisibilityQuery converts the where clause of ListWorkflowExecutions, ScanWorkflowExecutions and
+// CountWorkflowExecutions requests into a condition on executions_visibility. System search attributes
+// map to columns while custom search attributes are read from the search_attributes JSON column
+func convertVisibilityQuery(query string, dialect visibilityQueryDialect) (*visibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &visibilityQuery{}, nil
+	}
+
+	// IMPORTANT: this query is never executed, it is only used to parse the where clause
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("select * from dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("select * from dummy where %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, &workflow.BadRequestError{Message: "Invalid select query."}
+	}
+
+	c := &visibilityQueryConverter{dialect: dialect}
+	result := &visibilityQuery{}
+	if sel.Where != nil {
+		if result.Condition, err = c.convertExpr(sel.Where.Expr); err != nil {
+			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}
+		}
+	}
+	if len(sel.OrderBy) > 0 {
+		if result.OrderBy, err = c.convertOrderBy(sel.OrderBy); err != nil {
+			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}
+		}
+	}
+	result.Args = c.args
+	return result, nil
+}
+
+func (c *visibilityQueryConverter) convertExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinaryExpr("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return c.convertBinaryExpr("OR", expr.Left, expr.Right)
+	case *sqlparser.ParenExpr:
+		// and / or expressions are always rendered in parentheses
+		return c.convertExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return "", errors.New("invalid where clause")
+	}
+}
+
+func (c *visibilityQueryConverter) convertBinaryExpr(operator string, left, right sqlparser.Expr) (string, error) {
+	leftStr, err := c.convertExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightStr, err := c.convertExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftStr, operator, rightStr), nil
+}
+
+func (c *visibilityQueryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return "", errors.New("invalid comparison expression")
+	}
+	if !visibilityQueryOperators[expr.Operator] {
+		return "", fmt.Errorf("operator %v is not supported", expr.Operator)
+	}
+	key, isCustom, err := c.searchAttributeKey(colName)
+	if err != nil {
+		return "", err
+	}
+
+	if isMissingValue(expr.Right) {
+		column := c.column(key, isCustom)
+		if key == definition.CloseTime {
+			// close time of open workflows is not set, but close status is the indexed column
+			column = visibilityQueryColumns[definition.CloseStatus]
+		}
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return column + " IS NULL", nil
+		case sqlparser.NotEqualStr:
+			return column + " IS NOT NULL", nil
+		default:
+			return "", fmt.Errorf("operator %v is not supported for %v", expr.Operator, visibilityQueryMissingValue)
+		}
+	}
+
+	if expr.Operator == sqlparser.InStr || expr.Operator == sqlparser.NotInStr {
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return "", fmt.Errorf("invalid value list for %v", key)
+		}
+		values := make([]string, 0, len(tuple))
+		for _, valExpr := range tuple {
+			value, err := c.convertValue(key, isCustom, valExpr)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+		return fmt.Sprintf("%s %s (%s)", c.column(key, isCustom), strings.ToUpper(expr.Operator), strings.Join(values, ", ")), nil
+	}
+
+	value, err := c.convertValue(key, isCustom, expr.Right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", c.column(key, isCustom), expr.Operator, value), nil
+}
+
+func (c *visibilityQueryConverter) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return "", errors.New("invalid range expression")
+	}
+	key, isCustom, err := c.searchAttributeKey(colName)
+	if err != nil {
+		return "", err
+	}
+	from, err := c.convertValue(key, isCustom, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := c.convertValue(key, isCustom, expr.To)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s AND %s", c.column(key, isCustom), strings.ToUpper(expr.Operator), from, to), nil
+}
+
+func (c *visibilityQueryConverter) convertOrderBy(orderBy sqlparser.OrderBy) (string, error) {
+	terms := make([]string, 0, len(orderBy)+1)
+	sortedByRunID := false
+	for _, order := range orderBy {
+		colName, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return "", errors.New("invalid order by expression")
+		}
+		key, isCustom, err := c.searchAttributeKey(colName)
+		if err != nil {
+			return "", err
+		}
+		if key == definition.RunID && !isCustom {
+			sortedByRunID = true
+		}
+		direction := "ASC"
+		if order.Direction == sqlparser.DescScr {
+			direction = "DESC"
+		}
+		terms = append(terms, c.column(key, isCustom)+" "+direction)
+	}
+	if !sortedByRunID {
+		// run_id breaks ties so pages read with an offset are stable
+		terms = append(terms, visibilityQueryColumns[definition.RunID])
+	}
+	return strings.Join(terms, ", "), nil
+}
+
+// searchAttributeKey returns the search attribute referenced by colName and whether it is a custom one,
+// custom search attributes are prefixed with Attr by the frontend query validator
+func (c *visibilityQueryConverter) searchAttributeKey(colName *sqlparser.ColName) (string, bool, error) {
+	key := colName.Name.String()
+	isCustom := false
+	switch {
+	case colName.Qualifier.Name.String() == definition.Attr:
+		isCustom = true
+	case strings.HasPrefix(key, definition.Attr+"."):
+		key = key[len(definition.Attr)+1:]
+		isCustom = true
+	case !definition.IsSystemIndexedKey(key):
+		isCustom = true
+	}
+
+	if isCustom {
+		if !searchAttributeKeyRegex.MatchString(key) || reservedSearchAttributeKeys[key] {
+			return "", false, fmt.Errorf("invalid search attribute %v", key)
+		}
+		return key, true, nil
+	}
+	if _, ok := visibilityQueryColumns[key]; !ok {
+		return "", false, fmt.Errorf("search attribute %v is not supported", key)
+	}
+	return key, false, nil
+}
+
+func (c *visibilityQueryConverter) column(key string, isCustom bool) string {
+	if isCustom {
+		return c.dialect.SearchAttributeExpr(key)
+	}
+	return visibilityQueryColumns[key]
+}
+
+// convertValue adds the value of expr to the query args and returns its bind variable
+func (c *visibilityQueryConverter) convertValue(key string, isCustom bool, expr sqlparser.Expr) (string, error) {
+	literal, err := parseLiteral(expr)
+	if err != nil {
+		return "", fmt.Errorf("invalid value for %v: %v", key, err)
+	}
+
+	if isCustom {
+		// custom search attributes are compared as JSON values, so the type of the
+		// literal in the query decides how the comparison is done
+		c.args = append(c.args, literal.json)
+		return c.dialect.JSONValueExpr(), nil
+	}
+
+	var arg interface{}
+	switch key {
+	case definition.StartTime, definition.ExecutionTime, definition.CloseTime:
+		arg, err = parseTimeValue(literal.text)
+	case definition.CloseStatus:
+		arg, err = parseCloseStatusValue(literal.text)
+	case definition.HistoryLength:
+		arg, err = strconv.ParseInt(literal.text, 10, 64)
+	case definition.Paused:
+		arg, err = strconv.ParseBool(literal.text)
+	default:
+		arg = literal.text
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid value for %v: %v", key, err)
+	}
+	c.args = append(c.args, arg)
+	return "?", nil
+}
+
+type queryLiteral struct {
+	// text is the literal as it appears in the query, without quotes
+	text string
+	// json is the literal encoded as a JSON document
+	json string
+}
+
+func parseLiteral(expr sqlparser.Expr) (*queryLiteral, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			data, err := json.Marshal(string(expr.Val))
+			if err != nil {
+				return nil, err
+			}
+			return &queryLiteral{text: string(expr.Val), json: string(data)}, nil
+		case sqlparser.IntVal, sqlparser.FloatVal:
+			return &queryLiteral{text: string(expr.Val), json: string(expr.Val)}, nil
+		}
+	case sqlparser.BoolVal:
+		value := strconv.FormatBool(bool(expr))
+		return &queryLiteral{text: value, json: value}, nil
+	case *sqlparser.UnaryExpr:
+		if expr.Operator == sqlparser.UMinusStr {
+			if val, ok := expr.Expr.(*sqlparser.SQLVal); ok && (val.Type == sqlparser.IntVal || val.Type == sqlparser.FloatVal) {
+				value := "-" + string(val.Val)
+				return &queryLiteral{text: value, json: value}, nil
+			}
+		}
+	}
+	return nil, errors.New("unsupported value")
+}
+
+// parseTimeValue accepts time as unix nanos or in RFC3339 format, same as the elasticsearch visibility store
+func parseTimeValue(value string) (time.Time, error) {
+	if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, nanos), nil
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+// parseCloseStatusValue accepts close status as its enum value or name
+func parseCloseStatusValue(value string) (int32, error) {
+	if status, err := strconv.ParseInt(value, 10, 32); err == nil {
+		return int32(status), nil
+	}
+	var status workflow.WorkflowExecutionCloseStatus
+	if err := status.UnmarshalText([]byte(strings.ToUpper(value))); err != nil {
+		return 0, err
+	}
+	return int32(status), nil
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.String() == visibilityQueryMissingValue
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	visibilityQuerySuite struct {
+		suite.Suite
+	}
+
+	testVisibilityQueryDialect struct{}
+)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (d testVisibilityQueryDialect) SearchAttributeExpr(key string) string {
+	return fmt.Sprintf("attr(%v)", key)
+}
+
+func (d testVisibilityQueryDialect) JSONValueExpr() string {
+	return "json(?)"
+}
+
+func (s *visibilityQuerySuite) TestConvertVisibilityQuery() {
+	startTime, err := time.Parse(time.RFC3339, "2019-10-01T10:00:00Z")
+	s.NoError(err)
+
+	testCases := []struct {
+		query     string
+		condition string
+		args      []interface{}
+		orderBy   string
+	}{
+		{
+			query: "",
+		},
+		{
+			query:     "WorkflowID = 'wid'",
+			condition: "workflow_id = ?",
+			args:      []interface{}{"wid"},
+		},
+		{
+			query:     "WorkflowType = 'type' and (CloseStatus = 'completed' or CloseStatus = 3)",
+			condition: "(workflow_type_name = ? AND (close_status = ? OR close_status = ?))",
+			args:      []interface{}{"type", int32(0), int32(3)},
+		},
+		{
+			query:     "CloseTime = missing and StartTime > '2019-10-01T10:00:00Z'",
+			condition: "(close_status IS NULL AND start_time > ?)",
+			args:      []interface{}{startTime},
+		},
+		{
+			query:     fmt.Sprintf("StartTime between %v and '2019-10-01T10:00:00Z'", startTime.UnixNano()),
+			condition: "start_time BETWEEN ? AND ?",
+			args:      []interface{}{time.Unix(0, startTime.UnixNano()), startTime},
+		},
+		{
+			query:     "`Attr.CustomKeywordField` in ('a', 'b') and CustomIntField >= -5 and CustomBoolField = true",
+			condition: "((attr(CustomKeywordField) IN (json(?), json(?)) AND attr(CustomIntField) >= json(?)) AND attr(CustomBoolField) = json(?))",
+			args:      []interface{}{`"a"`, `"b"`, "-5", "true"},
+		},
+		{
+			query:     "CustomDoubleField != missing order by CustomDoubleField desc, StartTime",
+			condition: "attr(CustomDoubleField) IS NOT NULL",
+			orderBy:   "attr(CustomDoubleField) DESC, start_time ASC, run_id",
+		},
+		{
+			query:   "order by RunID desc",
+			orderBy: "run_id DESC",
+		},
+	}
+
+	for _, tc := range testCases {
+		query, err := convertVisibilityQuery(tc.query, testVisibilityQueryDialect{})
+		s.NoError(err, tc.query)
+		s.Equal(tc.condition, query.Condition, tc.query)
+		s.Equal(tc.args, query.Args, tc.query)
+		s.Equal(tc.orderBy, query.OrderBy, tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestConvertVisibilityQuery_Invalid() {
+	queries := []string{
+		"WorkflowID",
+		"WorkflowID like 'wid%'",
+		"Encoding = 'json'",
+		"HistoryLength = 'abc'",
+		"StartTime < 'yesterday'",
+		"CloseStatus = 'unknown'",
+		"StartTime > missing",
+		"WorkflowID = RunID",
+		"order by WorkflowID + 1",
+	}
+
+	for _, query := range queries {
+		_, err := convertVisibilityQuery(query, testVisibilityQueryDialect{})
+		s.Error(err, query)
+		s.IsType(&workflow.BadRequestError{}, err, query)
+	}
+}
@@ -29,25 +29,35 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/storage"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		replicas *readReplicas
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
+		// Offset is used to page through query results sorted by a custom order
+		Offset int `json:",omitempty"`
 	}
 )
 
-// NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+const (
+	defaultVisibilityQueryPageSize = 1000
+)
+
+// NewSQLVisibilityStore creates an instance of ExecutionStore, read only queries are routed
+// to the read replicas in cfg when readFromReplicas is true
+func NewSQLVisibilityStore(cfg config.SQL, readFromReplicas dynamicconfig.BoolPropertyFn, logger log.Logger) (p.VisibilityStore, error) {
 	db, err := storage.NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -57,11 +67,21 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 			db:     db,
 			logger: logger,
 		},
+		replicas: newReadReplicas(&cfg, db, readFromReplicas, logger),
 	}, nil
 }
 
+func (s *sqlVisibilityStore) Close() {
+	s.replicas.close()
+	s.sqlStore.Close()
+}
+
 func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
-	_, err := s.db.InsertIntoVisibility(&sqldb.VisibilityRow{
+	searchAttributes, err := encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(&sqldb.VisibilityRow{
 		DomainID:         request.DomainUUID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -70,12 +90,17 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 		WorkflowTypeName: request.WorkflowTypeName,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
 	})
 
 	return err
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	searchAttributes, err := encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
 	closeTime := time.Unix(0, request.CloseTimestamp)
 	result, err := s.db.ReplaceIntoVisibility(&sqldb.VisibilityRow{
 		DomainID:         request.DomainUUID,
@@ -89,6 +114,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return err
@@ -104,14 +130,35 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
-	return p.NewOperationNotSupportErrorForVis()
+	searchAttributes, err := encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpsertIntoVisibility(&sqldb.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        time.Unix(0, request.StartTimestamp),
+		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp),
+		WorkflowTypeName: request.WorkflowTypeName,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
+		Paused:           request.Paused,
+	})
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutions", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
-		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+		func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime)
-			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			return db.SelectFromVisibility(&sqldb.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &minStartTime,
 				MaxStartTime: &readLevel.Time,
@@ -123,9 +170,9 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowE
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutions", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
-		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+		func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime)
-			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			return db.SelectFromVisibility(&sqldb.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &minStartTime,
 				MaxStartTime: &readLevel.Time,
@@ -138,9 +185,9 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflo
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
-		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+		func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime)
-			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			return db.SelectFromVisibility(&sqldb.VisibilityFilter{
 				DomainID:         request.DomainUUID,
 				MinStartTime:     &minStartTime,
 				MaxStartTime:     &readLevel.Time,
@@ -153,9 +200,9 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWor
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
-		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+		func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime)
-			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			return db.SelectFromVisibility(&sqldb.VisibilityFilter{
 				DomainID:         request.DomainUUID,
 				MinStartTime:     &minStartTime,
 				MaxStartTime:     &readLevel.Time,
@@ -169,9 +216,9 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListW
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
-		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+		func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime)
-			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			return db.SelectFromVisibility(&sqldb.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &minStartTime,
 				MaxStartTime: &readLevel.Time,
@@ -184,9 +231,9 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.L
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
-		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+		func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime)
-			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			return db.SelectFromVisibility(&sqldb.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &minStartTime,
 				MaxStartTime: &readLevel.Time,
@@ -200,9 +247,9 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
-		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+		func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime)
-			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			return db.SelectFromVisibility(&sqldb.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &minStartTime,
 				MaxStartTime: &readLevel.Time,
@@ -216,10 +263,15 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.Lis
 
 func (s *sqlVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
-	rows, err := s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
-		DomainID: request.DomainUUID,
-		Closed:   true,
-		RunID:    execution.RunId,
+	var rows []sqldb.VisibilityRow
+	err := s.replicas.read(func(db sqldb.Interface) error {
+		var err error
+		rows, err = db.SelectFromVisibility(&sqldb.VisibilityFilter{
+			DomainID: request.DomainUUID,
+			Closed:   true,
+			RunID:    execution.RunId,
+		})
+		return err
 	})
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -250,15 +302,103 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := convertVisibilityQuery(request.Query, s.db)
+	if err != nil {
+		return nil, err
+	}
+	return s.listWorkflowExecutionsByQuery("ListWorkflowExecutions", request, query)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := convertVisibilityQuery(request.Query, s.db)
+	if err != nil {
+		return nil, err
+	}
+	// scan does not guarantee any order, so always page by start time which does not need an offset
+	query.OrderBy = ""
+	return s.listWorkflowExecutionsByQuery("ScanWorkflowExecutions", request, query)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := convertVisibilityQuery(request.Query, s.db)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	err = s.replicas.read(func(db sqldb.Interface) error {
+		var err error
+		count, err = db.CountFromVisibility(&sqldb.VisibilityQueryFilter{
+			DomainID:  request.DomainUUID,
+			Condition: query.Condition,
+			Args:      query.Args,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err),
+		}
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(opName string, request *p.ListWorkflowExecutionsRequestV2, query *visibilityQuery) (*p.InternalListWorkflowExecutionsResponse, error) {
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultVisibilityQueryPageSize
+	}
+	filter := &sqldb.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: query.Condition,
+		Args:      query.Args,
+		OrderBy:   query.OrderBy,
+		PageSize:  pageSize,
+	}
+	if len(request.NextPageToken) > 0 {
+		token, err := s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("%v: invalid next page token: %v", opName, err)}
+		}
+		if filter.OrderBy == "" {
+			filter.MaxStartTime = &token.Time
+			filter.RunID = &token.RunID
+		} else {
+			filter.Offset = token.Offset
+		}
+	}
+
+	var rows []sqldb.VisibilityRow
+	err := s.replicas.read(func(db sqldb.Interface) error {
+		var err error
+		rows, err = db.SelectFromVisibilityByQuery(filter)
+		return err
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Select failed: %v", opName, err),
+		}
+	}
+
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			Time:   lastRow.StartTime,
+			RunID:  lastRow.RunID,
+			Offset: filter.Offset + len(rows),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -272,6 +412,15 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWo
 		StartTime:     row.StartTime,
 		ExecutionTime: row.ExecutionTime,
 		Memo:          p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
+		Paused:        row.Paused,
+	}
+	if len(row.SearchAttributes) > 0 {
+		if err := json.Unmarshal(row.SearchAttributes, &info.SearchAttributes); err != nil {
+			s.logger.Error("failed to decode search attributes",
+				tag.WorkflowID(row.WorkflowID),
+				tag.WorkflowRunID(row.RunID),
+				tag.Error(err))
+		}
 	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
@@ -282,7 +431,7 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWo
 	return info
 }
 
-func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []byte, earliestTime int64, latestTime int64, selectOp func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error)) (*p.InternalListWorkflowExecutionsResponse, error) {
+func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []byte, earliestTime int64, latestTime int64, selectOp func(db sqldb.Interface, readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error)) (*p.InternalListWorkflowExecutionsResponse, error) {
 	var readLevel *visibilityPageToken
 	var err error
 	if len(pageToken) > 0 {
@@ -293,7 +442,12 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 	} else {
 		readLevel = &visibilityPageToken{Time: time.Unix(0, latestTime), RunID: ""}
 	}
-	rows, err := selectOp(readLevel)
+	var rows []sqldb.VisibilityRow
+	err = s.replicas.read(func(db sqldb.Interface) error {
+		var err error
+		rows, err = selectOp(db, readLevel)
+		return err
+	})
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("%v operation failed. Select failed: %v", opName, err),
@@ -335,3 +489,20 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+// encodeSearchAttributes merges the JSON encoded search attributes into a single
+// JSON object, which is stored in the search_attributes column
+func encodeSearchAttributes(searchAttributes map[string][]byte) ([]byte, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+	fields := make(map[string]json.RawMessage, len(searchAttributes))
+	for key, value := range searchAttributes {
+		fields[key] = value
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid search attributes: %v", err)}
+	}
+	return data, nil
+}
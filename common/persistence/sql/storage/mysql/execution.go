@@ -39,6 +39,13 @@ const (
 	getExecutionQry = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
 
+	listExecutionsFirstPageQry = `SELECT shard_id, domain_id, workflow_id, run_id FROM executions
+ WHERE shard_id = ? ORDER BY domain_id, workflow_id, run_id LIMIT ?`
+
+	listExecutionsQry = `SELECT shard_id, domain_id, workflow_id, run_id FROM executions
+ WHERE shard_id = ? AND (domain_id, workflow_id, run_id) > (?, ?, ?)
+ ORDER BY domain_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQry = `DELETE FROM executions 
  WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -111,6 +118,19 @@ ORDER BY task_id LIMIT ?`
 
 	deleteReplicationTaskQry = `DELETE FROM replication_tasks WHERE shard_id = ? AND task_id = ?`
 
+	replaceTaskDLQQry = `REPLACE INTO task_dlq (shard_id, category, task_id, data, data_encoding)
+  VALUES(:shard_id, :category, :task_id, :data, :data_encoding)`
+
+	getTaskDLQQry = `SELECT task_id, data, data_encoding FROM task_dlq WHERE 
+shard_id = ? AND
+category = ? AND
+task_id > ? AND
+task_id <= ? 
+ORDER BY task_id LIMIT ?`
+
+	deleteTaskDLQQry      = `DELETE FROM task_dlq WHERE shard_id = ? AND category = ? AND task_id = ?`
+	rangeDeleteTaskDLQQry = `DELETE FROM task_dlq WHERE shard_id = ? AND category = ? AND task_id > ? AND task_id <= ?`
+
 	bufferedEventsColumns    = `shard_id, domain_id, workflow_id, run_id, data, data_encoding`
 	createBufferedEventsQury = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
 VALUES (:shard_id, :domain_id, :workflow_id, :run_id, :data, :data_encoding)`
@@ -140,6 +160,18 @@ func (mdb *DB) SelectFromExecutions(filter *sqldb.ExecutionsFilter) (*sqldb.Exec
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads a page of execution keys of a shard from executions table
+func (mdb *DB) RangeSelectFromExecutions(filter *sqldb.ExecutionsFilter, pageSize int) ([]sqldb.ExecutionsRow, error) {
+	var rows []sqldb.ExecutionsRow
+	var err error
+	if filter.DomainID == nil {
+		err = mdb.conn.Select(&rows, listExecutionsFirstPageQry, filter.ShardID, pageSize)
+	} else {
+		err = mdb.conn.Select(&rows, listExecutionsQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, pageSize)
+	}
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *DB) DeleteFromExecutions(filter *sqldb.ExecutionsFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
@@ -293,3 +325,23 @@ func (mdb *DB) SelectFromReplicationTasks(filter *sqldb.ReplicationTasksFilter)
 func (mdb *DB) DeleteFromReplicationTasks(filter *sqldb.ReplicationTasksFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteReplicationTaskQry, filter.ShardID, *filter.TaskID)
 }
+
+// ReplaceIntoTaskDLQ inserts a row into task_dlq table, replacing the existing row with the same key
+func (mdb *DB) ReplaceIntoTaskDLQ(row *sqldb.TaskDLQRow) (sql.Result, error) {
+	return mdb.conn.NamedExec(replaceTaskDLQQry, row)
+}
+
+// SelectFromTaskDLQ reads one or more rows from task_dlq table
+func (mdb *DB) SelectFromTaskDLQ(filter *sqldb.TaskDLQFilter) ([]sqldb.TaskDLQRow, error) {
+	var rows []sqldb.TaskDLQRow
+	err := mdb.conn.Select(&rows, getTaskDLQQry, filter.ShardID, filter.Category, *filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromTaskDLQ deletes one or more rows from task_dlq table
+func (mdb *DB) DeleteFromTaskDLQ(filter *sqldb.TaskDLQFilter) (sql.Result, error) {
+	if filter.MinTaskID != nil {
+		return mdb.conn.Exec(rangeDeleteTaskDLQQry, filter.ShardID, filter.Category, *filter.MinTaskID, *filter.MaxTaskID)
+	}
+	return mdb.conn.Exec(deleteTaskDLQQry, filter.ShardID, filter.Category, *filter.TaskID)
+}
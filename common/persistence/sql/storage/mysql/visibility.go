@@ -24,18 +24,20 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -45,7 +47,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes, paused`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
@@ -65,12 +67,27 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, search_attributes, paused, close_time, workflow_type_name, close_status, history_length 
 		 FROM executions_visibility
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes, paused) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON DUPLICATE KEY UPDATE memo = VALUES(memo), encoding = VALUES(encoding), search_attributes = VALUES(search_attributes), paused = VALUES(paused)`
+
+	templateQuerySelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+		 FROM executions_visibility WHERE domain_id = ?`
+
+	templateQueryCount = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
+
+	// RunID condition is needed for correct pagination
+	templateQueryPageConditions = ` AND start_time <= ? AND (run_id > ? OR start_time < ?)`
+
+	templateQueryDefaultOrderBy = `start_time DESC, run_id`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -87,7 +104,8 @@ func (mdb *DB) InsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error
 		row.ExecutionTime,
 		row.WorkflowTypeName,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		searchAttributesValue(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -107,7 +125,8 @@ func (mdb *DB) ReplaceIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, erro
 			*row.CloseStatus,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			searchAttributesValue(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -202,3 +221,98 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 	}
 	return rows, err
 }
+
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// its memo and search attributes are updated
+func (mdb *DB) UpsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
+	return mdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		searchAttributesValue(row.SearchAttributes),
+		row.Paused)
+}
+
+// SelectFromVisibilityByQuery reads one page of rows from visibility table that match the query filter
+func (mdb *DB) SelectFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) ([]sqldb.VisibilityRow, error) {
+	query, args := mdb.visibilityQueryConditions(templateQuerySelect, filter)
+	if filter.OrderBy == "" {
+		if filter.MaxStartTime != nil && filter.RunID != nil {
+			maxStartTime := mdb.converter.ToMySQLDateTime(*filter.MaxStartTime)
+			query += templateQueryPageConditions
+			args = append(args, maxStartTime, *filter.RunID, maxStartTime)
+		}
+		query += " ORDER BY " + templateQueryDefaultOrderBy
+	} else {
+		query += " ORDER BY " + filter.OrderBy
+	}
+	query += " LIMIT ? OFFSET ?"
+	args = append(args, filter.PageSize, filter.Offset)
+
+	var rows []sqldb.VisibilityRow
+	if err := mdb.conn.Select(&rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibility returns the number of rows in visibility table that match the query filter
+func (mdb *DB) CountFromVisibility(filter *sqldb.VisibilityQueryFilter) (int64, error) {
+	query, args := mdb.visibilityQueryConditions(templateQueryCount, filter)
+	var count int64
+	err := mdb.conn.Get(&count, query, args...)
+	return count, err
+}
+
+// SearchAttributeExpr returns an expression that extracts the given search attribute
+// from the search_attributes column
+func (mdb *DB) SearchAttributeExpr(key string) string {
+	return fmt.Sprintf(`JSON_EXTRACT(search_attributes, '$."%s"')`, key)
+}
+
+// JSONValueExpr returns an expression that converts a JSON document bound to ?
+// into a value comparable with SearchAttributeExpr
+func (mdb *DB) JSONValueExpr() string {
+	return `CAST(? AS JSON)`
+}
+
+func (mdb *DB) visibilityQueryConditions(template string, filter *sqldb.VisibilityQueryFilter) (string, []interface{}) {
+	var query strings.Builder
+	query.WriteString(template)
+	args := []interface{}{filter.DomainID}
+	if filter.Condition != "" {
+		query.WriteString(" AND (")
+		query.WriteString(filter.Condition)
+		query.WriteString(")")
+		for _, arg := range filter.Args {
+			if t, ok := arg.(time.Time); ok {
+				arg = mdb.converter.ToMySQLDateTime(t)
+			}
+			args = append(args, arg)
+		}
+	}
+	return query.String(), args
+}
+
+// searchAttributesValue returns the value to bind to the search_attributes column, which
+// holds a JSON document and so cannot be written as binary data
+func searchAttributesValue(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+// DriverName refers to the name of the postgres driver
+const DriverName = "postgres"
+
+// ErrDupEntry indicates a duplicate primary key i.e. the row already exists,
+// check http://www.postgresql.org/docs/9.3/static/errcodes-appendix.html
+const ErrDupEntry = "23505"
+
+// DB represents a logical connection to postgres database
+type DB struct {
+	db        *sqlx.DB
+	tx        *sqlx.Tx
+	conn      sqldb.Conn
+	converter DataConverter
+}
+
+var _ sqldb.Tx = (*DB)(nil)
+var _ sqldb.Interface = (*DB)(nil)
+
+// NewDB returns an instance of DB, which is a logical
+// connection to the underlying postgres database
+func NewDB(xdb *sqlx.DB, tx *sqlx.Tx) *DB {
+	pdb := &DB{db: xdb, tx: tx}
+	pdb.conn = xdb
+	if tx != nil {
+		pdb.conn = tx
+	}
+	pdb.converter = &converter{}
+	return pdb
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (pdb *DB) BeginTx() (sqldb.Tx, error) {
+	xtx, err := pdb.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	return NewDB(pdb.db, xtx), nil
+}
+
+// Commit commits a previously started transaction
+func (pdb *DB) Commit() error {
+	return pdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (pdb *DB) Rollback() error {
+	return pdb.tx.Rollback()
+}
+
+// Close closes the connection to the postgres db
+func (pdb *DB) Close() error {
+	return pdb.db.Close()
+}
+
+// DriverName returns the name of the postgres driver
+func (pdb *DB) DriverName() string {
+	return pdb.db.DriverName()
+}
+
+// Ping verifies the connection to the postgres db is still alive
+func (pdb *DB) Ping() error {
+	return pdb.db.Ping()
+}
+
+// IsDupEntryError returns true if the given error is a unique constraint violation
+func (pdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(*pq.Error)
+	return ok && sqlErr.Code == ErrDupEntry
+}
@@ -0,0 +1,349 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	executionsColumns = `shard_id, domain_id, workflow_id, run_id, next_event_id, last_write_version, data, data_encoding`
+
+	createExecutionQry = `INSERT INTO executions(` + executionsColumns + `)
+ VALUES(:shard_id, :domain_id, :workflow_id, :run_id, :next_event_id, :last_write_version, :data, :data_encoding)`
+
+	updateExecutionQry = `UPDATE executions SET
+ next_event_id = :next_event_id, last_write_version = :last_write_version, data = :data, data_encoding = :data_encoding
+ WHERE shard_id = :shard_id AND domain_id = :domain_id AND workflow_id = :workflow_id AND run_id = :run_id`
+
+	getExecutionQry = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND domain_id = $2 AND workflow_id = $3 AND run_id = $4`
+
+	listExecutionsFirstPageQry = `SELECT shard_id, domain_id, workflow_id, run_id FROM executions
+ WHERE shard_id = $1 ORDER BY domain_id, workflow_id, run_id LIMIT $2`
+
+	listExecutionsQry = `SELECT shard_id, domain_id, workflow_id, run_id FROM executions
+ WHERE shard_id = $1 AND (domain_id, workflow_id, run_id) > ($2, $3, $4)
+ ORDER BY domain_id, workflow_id, run_id LIMIT $5`
+
+	deleteExecutionQry = `DELETE FROM executions 
+ WHERE shard_id = $1 AND domain_id = $2 AND workflow_id = $3 AND run_id = $4`
+
+	lockExecutionQryBase = `SELECT next_event_id FROM executions 
+ WHERE shard_id = $1 AND domain_id = $2 AND workflow_id = $3 AND run_id = $4`
+
+	writeLockExecutionQry = lockExecutionQryBase + ` FOR UPDATE`
+	readLockExecutionQry  = lockExecutionQryBase + ` FOR SHARE`
+
+	createCurrentExecutionQry = `INSERT INTO current_executions
+(shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status, start_version, last_write_version) VALUES
+(:shard_id, :domain_id, :workflow_id, :run_id, :create_request_id, :state, :close_status, :start_version, :last_write_version)`
+
+	deleteCurrentExecutionQry = "DELETE FROM current_executions WHERE shard_id=$1 AND domain_id=$2 AND workflow_id=$3 AND run_id=$4"
+
+	getCurrentExecutionQry = `SELECT
+shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status, start_version, last_write_version
+FROM current_executions WHERE shard_id = $1 AND domain_id = $2 AND workflow_id = $3`
+
+	lockCurrentExecutionJoinExecutionsQry = `SELECT
+ce.shard_id, ce.domain_id, ce.workflow_id, ce.run_id, ce.create_request_id, ce.state, ce.close_status, ce.start_version, e.last_write_version
+FROM current_executions ce
+INNER JOIN executions e ON e.shard_id = ce.shard_id AND e.domain_id = ce.domain_id AND e.workflow_id = ce.workflow_id AND e.run_id = ce.run_id
+WHERE ce.shard_id = $1 AND ce.domain_id = $2 AND ce.workflow_id = $3 FOR UPDATE`
+
+	lockCurrentExecutionQry = getCurrentExecutionQry + ` FOR UPDATE`
+
+	updateCurrentExecutionsQry = `UPDATE current_executions SET
+run_id = :run_id,
+create_request_id = :create_request_id,
+state = :state,
+close_status = :close_status,
+start_version = :start_version,
+last_write_version = :last_write_version
+WHERE
+shard_id = :shard_id AND
+domain_id = :domain_id AND
+workflow_id = :workflow_id
+`
+
+	getTransferTasksQry = `SELECT task_id, data, data_encoding 
+ FROM transfer_tasks WHERE shard_id = $1 AND task_id > $2 AND task_id <= $3 ORDER BY task_id`
+
+	createTransferTasksQry = `INSERT INTO transfer_tasks(shard_id, task_id, data, data_encoding) 
+ VALUES(:shard_id, :task_id, :data, :data_encoding)`
+
+	deleteTransferTaskQry      = `DELETE FROM transfer_tasks WHERE shard_id = $1 AND task_id = $2`
+	rangeDeleteTransferTaskQry = `DELETE FROM transfer_tasks WHERE shard_id = $1 AND task_id > $2 AND task_id <= $3`
+
+	createTimerTasksQry = `INSERT INTO timer_tasks (shard_id, visibility_timestamp, task_id, data, data_encoding)
+  VALUES (:shard_id, :visibility_timestamp, :task_id, :data, :data_encoding)`
+
+	getTimerTasksQry = `SELECT visibility_timestamp, task_id, data, data_encoding FROM timer_tasks 
+  WHERE shard_id = $1 
+  AND ((visibility_timestamp >= $2 AND task_id >= $3) OR visibility_timestamp > $4) 
+  AND visibility_timestamp < $5
+  ORDER BY visibility_timestamp,task_id LIMIT $6`
+
+	deleteTimerTaskQry      = `DELETE FROM timer_tasks WHERE shard_id = $1 AND visibility_timestamp = $2 AND task_id = $3`
+	rangeDeleteTimerTaskQry = `DELETE FROM timer_tasks WHERE shard_id = $1 AND visibility_timestamp >= $2 AND visibility_timestamp < $3`
+
+	createReplicationTasksQry = `INSERT INTO replication_tasks (shard_id, task_id, data, data_encoding) 
+  VALUES(:shard_id, :task_id, :data, :data_encoding)`
+
+	getReplicationTasksQry = `SELECT task_id, data, data_encoding FROM replication_tasks WHERE 
+shard_id = $1 AND
+task_id > $2 AND
+task_id <= $3 
+ORDER BY task_id LIMIT $4`
+
+	deleteReplicationTaskQry = `DELETE FROM replication_tasks WHERE shard_id = $1 AND task_id = $2`
+
+	replaceTaskDLQQry = `INSERT INTO task_dlq (shard_id, category, task_id, data, data_encoding)
+  VALUES(:shard_id, :category, :task_id, :data, :data_encoding)
+  ON CONFLICT (shard_id, category, task_id) DO UPDATE
+  SET data = excluded.data, data_encoding = excluded.data_encoding`
+
+	getTaskDLQQry = `SELECT task_id, data, data_encoding FROM task_dlq WHERE 
+shard_id = $1 AND
+category = $2 AND
+task_id > $3 AND
+task_id <= $4 
+ORDER BY task_id LIMIT $5`
+
+	deleteTaskDLQQry      = `DELETE FROM task_dlq WHERE shard_id = $1 AND category = $2 AND task_id = $3`
+	rangeDeleteTaskDLQQry = `DELETE FROM task_dlq WHERE shard_id = $1 AND category = $2 AND task_id > $3 AND task_id <= $4`
+
+	bufferedEventsColumns    = `shard_id, domain_id, workflow_id, run_id, data, data_encoding`
+	createBufferedEventsQury = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
+VALUES (:shard_id, :domain_id, :workflow_id, :run_id, :data, :data_encoding)`
+
+	deleteBufferedEventsQury = `DELETE FROM buffered_events WHERE shard_id=$1 AND domain_id=$2 AND workflow_id=$3 AND run_id=$4`
+	getBufferedEventsQury    = `SELECT data, data_encoding FROM buffered_events WHERE
+shard_id=$1 AND domain_id=$2 AND workflow_id=$3 AND run_id=$4 ORDER BY id`
+)
+
+// InsertIntoExecutions inserts a row into executions table
+func (pdb *DB) InsertIntoExecutions(row *sqldb.ExecutionsRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(createExecutionQry, row)
+}
+
+// UpdateExecutions updates a single row in executions table
+func (pdb *DB) UpdateExecutions(row *sqldb.ExecutionsRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(updateExecutionQry, row)
+}
+
+// SelectFromExecutions reads a single row from executions table
+func (pdb *DB) SelectFromExecutions(filter *sqldb.ExecutionsFilter) (*sqldb.ExecutionsRow, error) {
+	var row sqldb.ExecutionsRow
+	err := pdb.conn.Get(&row, getExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	if err != nil {
+		return nil, err
+	}
+	return &row, err
+}
+
+// RangeSelectFromExecutions reads a page of execution keys of a shard from executions table
+func (pdb *DB) RangeSelectFromExecutions(filter *sqldb.ExecutionsFilter, pageSize int) ([]sqldb.ExecutionsRow, error) {
+	var rows []sqldb.ExecutionsRow
+	var err error
+	if filter.DomainID == nil {
+		err = pdb.conn.Select(&rows, listExecutionsFirstPageQry, filter.ShardID, pageSize)
+	} else {
+		err = pdb.conn.Select(&rows, listExecutionsQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, pageSize)
+	}
+	return rows, err
+}
+
+// DeleteFromExecutions deletes a single row from executions table
+func (pdb *DB) DeleteFromExecutions(filter *sqldb.ExecutionsFilter) (sql.Result, error) {
+	return pdb.conn.Exec(deleteExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+// ReadLockExecutions acquires a write lock on a single row in executions table
+func (pdb *DB) ReadLockExecutions(filter *sqldb.ExecutionsFilter) (int, error) {
+	var nextEventID int
+	err := pdb.conn.Get(&nextEventID, readLockExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	return nextEventID, err
+}
+
+// WriteLockExecutions acquires a write lock on a single row in executions table
+func (pdb *DB) WriteLockExecutions(filter *sqldb.ExecutionsFilter) (int, error) {
+	var nextEventID int
+	err := pdb.conn.Get(&nextEventID, writeLockExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	return nextEventID, err
+}
+
+// InsertIntoCurrentExecutions inserts a single row into current_executions table
+func (pdb *DB) InsertIntoCurrentExecutions(row *sqldb.CurrentExecutionsRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(createCurrentExecutionQry, row)
+}
+
+// UpdateCurrentExecutions updates a single row in current_executions table
+func (pdb *DB) UpdateCurrentExecutions(row *sqldb.CurrentExecutionsRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(updateCurrentExecutionsQry, row)
+}
+
+// SelectFromCurrentExecutions reads one or more rows from current_executions table
+func (pdb *DB) SelectFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (*sqldb.CurrentExecutionsRow, error) {
+	var row sqldb.CurrentExecutionsRow
+	err := pdb.conn.Get(&row, getCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
+	return &row, err
+}
+
+// DeleteFromCurrentExecutions deletes a single row in current_executions table
+func (pdb *DB) DeleteFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (sql.Result, error) {
+	return pdb.conn.Exec(deleteCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+// LockCurrentExecutions acquires a write lock on a single row in current_executions table
+func (pdb *DB) LockCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (*sqldb.CurrentExecutionsRow, error) {
+	var row sqldb.CurrentExecutionsRow
+	err := pdb.conn.Get(&row, lockCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
+	return &row, err
+}
+
+// LockCurrentExecutionsJoinExecutions joins a row in current_executions with executions table and acquires a
+// write lock on the result
+func (pdb *DB) LockCurrentExecutionsJoinExecutions(filter *sqldb.CurrentExecutionsFilter) ([]sqldb.CurrentExecutionsRow, error) {
+	var rows []sqldb.CurrentExecutionsRow
+	err := pdb.conn.Select(&rows, lockCurrentExecutionJoinExecutionsQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
+	return rows, err
+}
+
+// InsertIntoTransferTasks inserts one or more rows into transfer_tasks table
+func (pdb *DB) InsertIntoTransferTasks(rows []sqldb.TransferTasksRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(createTransferTasksQry, rows)
+}
+
+// SelectFromTransferTasks reads one or more rows from transfer_tasks table
+func (pdb *DB) SelectFromTransferTasks(filter *sqldb.TransferTasksFilter) ([]sqldb.TransferTasksRow, error) {
+	var rows []sqldb.TransferTasksRow
+	err := pdb.conn.Select(&rows, getTransferTasksQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
+	if err != nil {
+		return nil, err
+	}
+	return rows, err
+}
+
+// DeleteFromTransferTasks deletes one or more rows from transfer_tasks table
+func (pdb *DB) DeleteFromTransferTasks(filter *sqldb.TransferTasksFilter) (sql.Result, error) {
+	if filter.MinTaskID != nil {
+		return pdb.conn.Exec(rangeDeleteTransferTaskQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
+	}
+	return pdb.conn.Exec(deleteTransferTaskQry, filter.ShardID, *filter.TaskID)
+}
+
+// InsertIntoTimerTasks inserts one or more rows into timer_tasks table
+func (pdb *DB) InsertIntoTimerTasks(rows []sqldb.TimerTasksRow) (sql.Result, error) {
+	for i := range rows {
+		rows[i].VisibilityTimestamp = pdb.converter.ToPostgresDateTime(rows[i].VisibilityTimestamp)
+	}
+	return pdb.conn.NamedExec(createTimerTasksQry, rows)
+}
+
+// SelectFromTimerTasks reads one or more rows from timer_tasks table
+func (pdb *DB) SelectFromTimerTasks(filter *sqldb.TimerTasksFilter) ([]sqldb.TimerTasksRow, error) {
+	var rows []sqldb.TimerTasksRow
+	*filter.MinVisibilityTimestamp = pdb.converter.ToPostgresDateTime(*filter.MinVisibilityTimestamp)
+	*filter.MaxVisibilityTimestamp = pdb.converter.ToPostgresDateTime(*filter.MaxVisibilityTimestamp)
+	err := pdb.conn.Select(&rows, getTimerTasksQry, filter.ShardID, *filter.MinVisibilityTimestamp,
+		filter.TaskID, *filter.MinVisibilityTimestamp, *filter.MaxVisibilityTimestamp, *filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = pdb.converter.FromPostgresDateTime(rows[i].VisibilityTimestamp)
+	}
+	return rows, err
+}
+
+// DeleteFromTimerTasks deletes one or more rows from timer_tasks table
+func (pdb *DB) DeleteFromTimerTasks(filter *sqldb.TimerTasksFilter) (sql.Result, error) {
+	if filter.MinVisibilityTimestamp != nil {
+		*filter.MinVisibilityTimestamp = pdb.converter.ToPostgresDateTime(*filter.MinVisibilityTimestamp)
+		*filter.MaxVisibilityTimestamp = pdb.converter.ToPostgresDateTime(*filter.MaxVisibilityTimestamp)
+		return pdb.conn.Exec(rangeDeleteTimerTaskQry, filter.ShardID, *filter.MinVisibilityTimestamp, *filter.MaxVisibilityTimestamp)
+	}
+	*filter.VisibilityTimestamp = pdb.converter.ToPostgresDateTime(*filter.VisibilityTimestamp)
+	return pdb.conn.Exec(deleteTimerTaskQry, filter.ShardID, *filter.VisibilityTimestamp, filter.TaskID)
+}
+
+// InsertIntoBufferedEvents inserts one or more rows into buffered_events table
+func (pdb *DB) InsertIntoBufferedEvents(rows []sqldb.BufferedEventsRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(createBufferedEventsQury, rows)
+}
+
+// SelectFromBufferedEvents reads one or more rows from buffered_events table
+func (pdb *DB) SelectFromBufferedEvents(filter *sqldb.BufferedEventsFilter) ([]sqldb.BufferedEventsRow, error) {
+	var rows []sqldb.BufferedEventsRow
+	err := pdb.conn.Select(&rows, getBufferedEventsQury, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+		rows[i].ShardID = filter.ShardID
+	}
+	return rows, err
+}
+
+// DeleteFromBufferedEvents deletes one or more rows from buffered_events table
+func (pdb *DB) DeleteFromBufferedEvents(filter *sqldb.BufferedEventsFilter) (sql.Result, error) {
+	return pdb.conn.Exec(deleteBufferedEventsQury, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+// InsertIntoReplicationTasks inserts one or more rows into replication_tasks table
+func (pdb *DB) InsertIntoReplicationTasks(rows []sqldb.ReplicationTasksRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(createReplicationTasksQry, rows)
+}
+
+// SelectFromReplicationTasks reads one or more rows from replication_tasks table
+func (pdb *DB) SelectFromReplicationTasks(filter *sqldb.ReplicationTasksFilter) ([]sqldb.ReplicationTasksRow, error) {
+	var rows []sqldb.ReplicationTasksRow
+	err := pdb.conn.Select(&rows, getReplicationTasksQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromReplicationTasks deletes one or more rows from replication_tasks table
+func (pdb *DB) DeleteFromReplicationTasks(filter *sqldb.ReplicationTasksFilter) (sql.Result, error) {
+	return pdb.conn.Exec(deleteReplicationTaskQry, filter.ShardID, *filter.TaskID)
+}
+
+// ReplaceIntoTaskDLQ inserts a row into task_dlq table, replacing the existing row with the same key
+func (pdb *DB) ReplaceIntoTaskDLQ(row *sqldb.TaskDLQRow) (sql.Result, error) {
+	return pdb.conn.NamedExec(replaceTaskDLQQry, row)
+}
+
+// SelectFromTaskDLQ reads one or more rows from task_dlq table
+func (pdb *DB) SelectFromTaskDLQ(filter *sqldb.TaskDLQFilter) ([]sqldb.TaskDLQRow, error) {
+	var rows []sqldb.TaskDLQRow
+	err := pdb.conn.Select(&rows, getTaskDLQQry, filter.ShardID, filter.Category, *filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromTaskDLQ deletes one or more rows from task_dlq table
+func (pdb *DB) DeleteFromTaskDLQ(filter *sqldb.TaskDLQFilter) (sql.Result, error) {
+	if filter.MinTaskID != nil {
+		return pdb.conn.Exec(rangeDeleteTaskDLQQry, filter.ShardID, filter.Category, *filter.MinTaskID, *filter.MaxTaskID)
+	}
+	return pdb.conn.Exec(deleteTaskDLQQry, filter.ShardID, filter.Category, *filter.TaskID)
+}
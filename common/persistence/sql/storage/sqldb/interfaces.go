@@ -215,6 +215,26 @@ type (
 		PageSize  *int
 	}
 
+	// TaskDLQRow represents a row in task_dlq table
+	TaskDLQRow struct {
+		ShardID      int
+		Category     int
+		TaskID       int64
+		Data         []byte
+		DataEncoding string
+	}
+
+	// TaskDLQFilter contains the column names within task_dlq table that
+	// can be used to filter results through a WHERE clause
+	TaskDLQFilter struct {
+		ShardID   int
+		Category  int
+		TaskID    *int64
+		MinTaskID *int64
+		MaxTaskID *int64
+		PageSize  *int
+	}
+
 	// TimerTasksRow represents a row in timer_tasks table
 	TimerTasksRow struct {
 		ShardID             int
@@ -441,6 +461,8 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		SearchAttributes []byte
+		Paused           bool
 	}
 
 	// VisibilityFilter contains the column names within domain table that
@@ -457,6 +479,22 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the parameters of an advanced visibility query.
+	// Condition and OrderBy are fragments of SQL that use ? bind variables for Args,
+	// along with the expressions returned by SearchAttributeExpr and JSONValueExpr
+	VisibilityQueryFilter struct {
+		DomainID  string
+		Condition string
+		Args      []interface{}
+		// OrderBy when empty, rows are sorted by start_time DESC, run_id and
+		// MaxStartTime / RunID can be used to read the next page
+		OrderBy      string
+		MaxStartTime *time.Time
+		RunID        *string
+		Offset       int
+		PageSize     int
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      common.QueueType
@@ -527,6 +565,10 @@ type (
 		InsertIntoExecutions(row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(filter *ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns the keys of up to pageSize rows of the shard
+		// that sort after {domainID, workflowID, runID}, ordered by the primary key.
+		// Required params - {shardID}; a nil domainID reads from the beginning of the shard
+		RangeSelectFromExecutions(filter *ExecutionsFilter, pageSize int) ([]ExecutionsRow, error)
 		DeleteFromExecutions(filter *ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(filter *ExecutionsFilter) (int, error)
 		WriteLockExecutions(filter *ExecutionsFilter) (int, error)
@@ -577,6 +619,16 @@ type (
 		// Required filter params - {shardID, taskID}
 		DeleteFromReplicationTasks(filter *ReplicationTasksFilter) (sql.Result, error)
 
+		// ReplaceIntoTaskDLQ inserts a row into task_dlq table, replacing the existing row with the same key
+		ReplaceIntoTaskDLQ(row *TaskDLQRow) (sql.Result, error)
+		// SelectFromTaskDLQ returns one or more rows from task_dlq table
+		// Required filter params - {shardID, category, minTaskID, maxTaskID, pageSize}
+		SelectFromTaskDLQ(filter *TaskDLQFilter) ([]TaskDLQRow, error)
+		// DeleteFromTaskDLQ deletes one or more rows from task_dlq table
+		// Filter params - shardID and category are required. If TaskID is not nil, a single row is deleted.
+		// When MinTaskID and MaxTaskID are not-nil, a range of rows are deleted.
+		DeleteFromTaskDLQ(filter *TaskDLQFilter) (sql.Result, error)
+
 		ReplaceIntoActivityInfoMaps(rows []ActivityInfoMapsRow) (sql.Result, error)
 		// SelectFromActivityInfoMaps returns one or more rows from activity_info_maps
 		// Required filter params - {shardID, domainID, workflowID, runID}
@@ -652,6 +704,15 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+		// only its memo and search attributes are updated
+		UpsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns one page of rows from visibility table that match
+		// the given query filter. Required filter params - {domainID, pageSize}
+		SelectFromVisibilityByQuery(filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibility returns the number of rows in visibility table that match the
+		// given query filter. Required filter params - {domainID}
+		CountFromVisibility(filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(queueType common.QueueType) (int, error)
@@ -667,6 +728,7 @@ type (
 		tableCRUD
 		Commit() error
 		Rollback() error
+		IsDupEntryError(err error) bool
 	}
 
 	// Interface defines the API for a SQL database
@@ -674,7 +736,18 @@ type (
 		tableCRUD
 		BeginTx() (Tx, error)
 		DriverName() string
+		// Ping verifies the connection to the database is still alive
+		Ping() error
+		// SearchAttributeExpr returns an expression that extracts the JSON value of the
+		// given custom search attribute from the search_attributes column
+		SearchAttributeExpr(key string) string
+		// JSONValueExpr returns an expression that turns a single ? bind variable holding
+		// a JSON document into a value comparable with SearchAttributeExpr
+		JSONValueExpr() string
 		Close() error
+		// IsDupEntryError returns true if the error returned by the driver
+		// indicates a duplicate primary key / unique constraint violation
+		IsDupEntryError(err error) bool
 	}
 
 	// Conn defines the API for a single database connection
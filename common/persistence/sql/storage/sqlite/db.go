@@ -0,0 +1,103 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // needed to load the sqlite driver
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+// DriverName refers to the name of the sqlite driver
+const DriverName = "sqlite3"
+
+// MemoryDatabaseName is the database name that refers to a private,
+// in-memory sqlite database that goes away when the connection is closed
+const MemoryDatabaseName = ":memory:"
+
+// ErrDupEntry is the message prefix of both primary key and unique constraint
+// violations. The error is matched by message because the typed sqlite3.Error
+// is only available in cgo builds
+const ErrDupEntry = "UNIQUE constraint failed"
+
+// DB represents a logical connection to sqlite database
+type DB struct {
+	db        *sqlx.DB
+	tx        *sqlx.Tx
+	conn      sqldb.Conn
+	converter DataConverter
+}
+
+var _ sqldb.Tx = (*DB)(nil)
+var _ sqldb.Interface = (*DB)(nil)
+
+// NewDB returns an instance of DB, which is a logical
+// connection to the underlying sqlite database
+func NewDB(xdb *sqlx.DB, tx *sqlx.Tx) *DB {
+	sdb := &DB{db: xdb, tx: tx}
+	sdb.conn = xdb
+	if tx != nil {
+		sdb.conn = tx
+	}
+	sdb.converter = &converter{}
+	return sdb
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (sdb *DB) BeginTx() (sqldb.Tx, error) {
+	xtx, err := sdb.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	return NewDB(sdb.db, xtx), nil
+}
+
+// Commit commits a previously started transaction
+func (sdb *DB) Commit() error {
+	return sdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (sdb *DB) Rollback() error {
+	return sdb.tx.Rollback()
+}
+
+// Close closes the connection to the sqlite db
+func (sdb *DB) Close() error {
+	return sdb.db.Close()
+}
+
+// DriverName returns the name of the sqlite driver
+func (sdb *DB) DriverName() string {
+	return sdb.db.DriverName()
+}
+
+// Ping verifies the connection to the sqlite db is still alive
+func (sdb *DB) Ping() error {
+	return sdb.db.Ping()
+}
+
+// IsDupEntryError returns true if the given error is a primary key or unique constraint violation
+func (sdb *DB) IsDupEntryError(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), ErrDupEntry)
+}
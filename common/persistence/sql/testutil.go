@@ -23,6 +23,7 @@ package sql
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
@@ -31,13 +32,19 @@ import (
 )
 
 const (
-	defaultDriverName = "mysql"
-	dataSourceName    = "%s:%s@%v(%v)/%s?multiStatements=true&transaction_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
+	defaultDriverName      = "mysql"
+	dataSourceName         = "%s:%s@%v(%v)/%s?multiStatements=true&transaction_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
+	postgresDriverName     = "postgres"
+	postgresDataSourceName = "postgres://%s:%s@%v/%s?sslmode=disable"
+	// postgres always requires a database to connect to
+	postgresDefaultDatabase = "postgres"
+	sqliteDriverName        = "sqlite3"
+	sqliteDataSourceName    = "%s?_busy_timeout=10000"
 )
 
 func newConnection(cfg config.SQL) (*sqlx.DB, error) {
 	var db, err = sqlx.Connect(cfg.DriverName,
-		fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
+		buildDataSourceName(cfg.DriverName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +54,18 @@ func newConnection(cfg config.SQL) (*sqlx.DB, error) {
 }
 
 func createDatabase(driver string, addr string, username, password, dbName string, overwrite bool) error {
-	var db, err = sqlx.Connect(driver, fmt.Sprintf(dataSourceName, username, password, "tcp", addr, ""))
+	if driver == sqliteDriverName {
+		// sqlite creates the database file on first connect
+		if overwrite {
+			dropSQLiteDatabase(dbName)
+		}
+		return nil
+	}
+	var db, err = sqlx.Connect(driver, buildDataSourceName(driver, username, password, "tcp", addr, ""))
 	if err != nil {
-		return fmt.Errorf("failure connecting to mysql database: %v", err)
+		return fmt.Errorf("failure connecting to %v database: %v", driver, err)
 	}
+	defer db.Close()
 
 	if overwrite {
 		dropDatabase(db, dbName)
@@ -63,6 +78,19 @@ func createDatabase(driver string, addr string, username, password, dbName strin
 	return nil
 }
 
+func buildDataSourceName(driver string, username, password, protocol, addr, dbName string) string {
+	if driver == sqliteDriverName {
+		return fmt.Sprintf(sqliteDataSourceName, dbName)
+	}
+	if driver == postgresDriverName {
+		if dbName == "" {
+			dbName = postgresDefaultDatabase
+		}
+		return fmt.Sprintf(postgresDataSourceName, username, password, addr, dbName)
+	}
+	return fmt.Sprintf(dataSourceName, username, password, protocol, addr, dbName)
+}
+
 // DropCassandraKeyspace drops the given keyspace, if it exists
 func dropDatabase(db *sqlx.DB, dbName string) (err error) {
 	_, err = db.Exec("DROP DATABASE " + dbName)
@@ -73,6 +101,16 @@ func dropDatabase(db *sqlx.DB, dbName string) (err error) {
 	return nil
 }
 
+// dropSQLiteDatabase removes the given sqlite database file, if it exists
+func dropSQLiteDatabase(dbName string) error {
+	err := os.Remove(dbName)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	log.WithField(`database-name`, dbName).Info(`dropped database`)
+	return nil
+}
+
 // loadDatabaseSchema loads the schema from the given .sql files on this database
 func loadDatabaseSchema(dir string, fileNames []string, db *sqlx.DB, override bool) (err error) {
 
@@ -76,6 +76,7 @@ type (
 		TaskID             int64 // not persisted, used as condition update version for ES
 		Memo               *s.Memo
 		SearchAttributes   map[string][]byte
+		Paused             bool
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -22,6 +22,7 @@ package persistence
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -105,6 +106,7 @@ func (v *visibilityManagerImpl) UpsertWorkflowExecution(request *UpsertWorkflowE
 		TaskID:             request.TaskID,
 		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
 		SearchAttributes:   request.SearchAttributes,
+		Paused:             request.Paused,
 	}
 	return v.persistence.UpsertWorkflowExecution(req)
 }
@@ -282,6 +284,12 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 		convertedExecution.CloseTime = common.Int64Ptr(execution.CloseTime.UnixNano())
 		convertedExecution.CloseStatus = execution.Status
 		convertedExecution.HistoryLength = common.Int64Ptr(execution.HistoryLength)
+	} else if execution.ExecutionTime.After(time.Now()) {
+		// open record whose first decision is still waiting for the start delay or cron schedule
+		convertedExecution.WaitingToStart = common.BoolPtr(true)
+	}
+	if execution.Paused {
+		convertedExecution.Paused = common.BoolPtr(true)
 	}
 
 	return convertedExecution
@@ -135,6 +135,20 @@ type (
 		VisibilityConfig *VisibilityConfig
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn
+		// Encryption is the config for encrypting payloads at rest
+		Encryption *Encryption `yaml:"encryption"`
+		// FaultInjection is config for injecting persistence failures, only meant for chaos testing
+		FaultInjection *FaultInjectionConfig
+		// ReadFromReplicas decides whether visibility and history reads are routed to
+		// the sql read replicas, callers that need to read their own writes leave it unset
+		ReadFromReplicas dynamicconfig.BoolPropertyFn
+	}
+
+	// Encryption is the configuration for payload encryption at rest,
+	// whether a domain opts in is controlled by dynamic config
+	Encryption struct {
+		// KeyFile is the path to the file containing the encryption keys
+		KeyFile string `yaml:"keyFile" validate:"nonzero"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -143,6 +157,8 @@ type (
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
 		SQL *SQL `yaml:"sql"`
+		// Memory contains the config for an in-memory datastore
+		Memory *Memory `yaml:"memory"`
 		// ElasticSearch contains the config for a ElasticSearch datastore
 		ElasticSearch *elasticsearch.Config `yaml:"elasticsearch"`
 	}
@@ -167,6 +183,21 @@ type (
 		ValidSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
+	// FaultInjectionConfig is config for persistence fault injection, rates are within [0, 1] and can
+	// be set per persistence operation with the operation name filter
+	FaultInjectionConfig struct {
+		// TimeoutRate is the rate of operations failing with a timeout error
+		TimeoutRate dynamicconfig.FloatPropertyFn
+		// ConditionFailedRate is the rate of operations failing with a condition failed error
+		ConditionFailedRate dynamicconfig.FloatPropertyFn
+		// ShardOwnershipLostRate is the rate of operations failing with a shard ownership lost error
+		ShardOwnershipLostRate dynamicconfig.FloatPropertyFn
+		// LatencyRate is the rate of operations being delayed by Latency
+		LatencyRate dynamicconfig.FloatPropertyFn
+		// Latency is the latency added to delayed operations
+		Latency dynamicconfig.DurationPropertyFn
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -197,12 +228,14 @@ type (
 		Password string `yaml:"password"`
 		// DriverName is the name of SQL driver
 		DriverName string `yaml:"driverName" validate:"nonzero"`
-		// DatabaseName is the name of SQL database to connect to
+		// DatabaseName is the name of SQL database to connect to. For sqlite3, this
+		// is the path of the database file or :memory: for a private in-memory database
 		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
-		// ConnectAddr is the remote addr of the database
-		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
-		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix
-		ConnectProtocol string `yaml:"connectProtocol" validate:"nonzero"`
+		// ConnectAddr is the remote addr of the database, required by mysql and postgres and unused by sqlite3
+		ConnectAddr string `yaml:"connectAddr"`
+		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix, required by mysql
+		// and postgres and unused by sqlite3
+		ConnectProtocol string `yaml:"connectProtocol"`
 		// ConnectAttributes is a set of key-value attributes to be sent as part of connect data_source_name url
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 		// MaxQPS the max request rate on this datastore
@@ -216,6 +249,22 @@ type (
 		// NumShards is the number of storage shards to use for tables
 		// in a sharded sql database. The default value for this param is 1
 		NumShards int `yaml:"nShards"`
+		// ReplicaAddrs is the list of remote addrs of read replicas of the database, they share
+		// the credentials of the primary. Unused by sqlite3
+		ReplicaAddrs []string `yaml:"replicaAddrs"`
+		// ReplicaHealthCheckInterval is how often read replicas are checked for health
+		ReplicaHealthCheckInterval time.Duration `yaml:"replicaHealthCheckInterval"`
+	}
+
+	// Memory is the configuration for a datastore that keeps all of its state
+	// in the memory of the current process. Nothing survives a restart, so this
+	// is only meant for tests and single binary development setups
+	Memory struct {
+		// DatabaseName is the name of the in-memory database. Stores created within
+		// the same process using the same name share their state
+		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
+		// MaxQPS the max request rate on this datastore
+		MaxQPS int `yaml:"maxQPS"`
 	}
 
 	// Replicator describes the configuration of replicator
@@ -27,6 +27,8 @@ const (
 	StoreTypeSQL = "sql"
 	// StoreTypeCassandra refers to cassandra as persistence store
 	StoreTypeCassandra = "cassandra"
+	// StoreTypeMemory refers to an in-memory persistence store
+	StoreTypeMemory = "memory"
 )
 
 // SetMaxQPS sets the MaxQPS value for the given datastore
@@ -39,6 +41,10 @@ func (c *Persistence) SetMaxQPS(key string, qps int) {
 		ds.Cassandra.MaxQPS = qps
 		return
 	}
+	if ds.Memory != nil {
+		ds.Memory.MaxQPS = qps
+		return
+	}
 	ds.SQL.MaxQPS = qps
 }
 
@@ -47,6 +53,9 @@ func (c *Persistence) DefaultStoreType() string {
 	if c.DataStores[c.DefaultStore].SQL != nil {
 		return StoreTypeSQL
 	}
+	if c.DataStores[c.DefaultStore].Memory != nil {
+		return StoreTypeMemory
+	}
 	return StoreTypeCassandra
 }
 
@@ -58,19 +67,58 @@ func (c *Persistence) Validate() error {
 		if !ok {
 			return fmt.Errorf("persistence config: missing config for datastore %v", st)
 		}
-		if ds.SQL == nil && ds.Cassandra == nil {
-			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra or sql stores", st)
+		numStores := ds.numStores()
+		if numStores == 0 {
+			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra, sql or memory stores", st)
+		}
+		if numStores > 1 {
+			return fmt.Errorf("persistence config: datastore %v: only one of SQL, cassandra or memory can be specified", st)
 		}
-		if ds.SQL != nil && ds.Cassandra != nil {
-			return fmt.Errorf("persistence config: datastore %v: only one of SQL or cassandra can be specified", st)
+		if ds.SQL != nil {
+			if ds.SQL.NumShards == 0 {
+				ds.SQL.NumShards = 1
+			}
+			if err := ds.SQL.validateConnect(); err != nil {
+				return fmt.Errorf("persistence config: datastore %v: %v", st, err)
+			}
+		}
+	}
+	if c.Encryption != nil && len(c.Encryption.KeyFile) == 0 {
+		return fmt.Errorf("persistence config: encryption: keyFile must be specified")
+	}
+	return nil
+}
+
+// validateConnect checks the connect addr and protocol are set for the drivers
+// which connect to a database server, sqlite3 opens a local file instead
+func (c *SQL) validateConnect() error {
+	switch c.DriverName {
+	case "mysql", "postgres":
+		if len(c.ConnectAddr) == 0 {
+			return fmt.Errorf("connectAddr must be specified for driver %v", c.DriverName)
 		}
-		if ds.SQL != nil && ds.SQL.NumShards == 0 {
-			ds.SQL.NumShards = 1
+		if len(c.ConnectProtocol) == 0 {
+			return fmt.Errorf("connectProtocol must be specified for driver %v", c.DriverName)
 		}
 	}
 	return nil
 }
 
+// numStores returns the number of store configs set on the datastore
+func (ds DataStore) numStores() int {
+	n := 0
+	if ds.Cassandra != nil {
+		n++
+	}
+	if ds.SQL != nil {
+		n++
+	}
+	if ds.Memory != nil {
+		n++
+	}
+	return n
+}
+
 // IsAdvancedVisibilityConfigExist returns whether user specified advancedVisibilityStore in config
 func (c *Persistence) IsAdvancedVisibilityConfigExist() bool {
 	return len(c.AdvancedVisibilityStore) != 0
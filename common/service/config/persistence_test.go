@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistenceValidate_SQLConnect(t *testing.T) {
+	tests := []struct {
+		msg     string
+		sql     SQL
+		wantErr bool
+	}{
+		{
+			msg: "mysql with connect addr",
+			sql: SQL{DriverName: "mysql", ConnectAddr: "127.0.0.1:3306", ConnectProtocol: "tcp"},
+		},
+		{
+			msg:     "mysql without connect addr",
+			sql:     SQL{DriverName: "mysql", ConnectProtocol: "tcp"},
+			wantErr: true,
+		},
+		{
+			msg:     "postgres without connect protocol",
+			sql:     SQL{DriverName: "postgres", ConnectAddr: "127.0.0.1:5432"},
+			wantErr: true,
+		},
+		{
+			msg: "sqlite3 without connect addr",
+			sql: SQL{DriverName: "sqlite3"},
+		},
+	}
+
+	for _, tt := range tests {
+		sql := tt.sql
+		cfg := &Persistence{
+			DefaultStore:    "default",
+			VisibilityStore: "default",
+			DataStores: map[string]DataStore{
+				"default": {SQL: &sql},
+			},
+		}
+		err := cfg.Validate()
+		if tt.wantErr {
+			assert.Error(t, err, tt.msg)
+		} else {
+			assert.NoError(t, err, tt.msg)
+		}
+	}
+}
@@ -67,6 +67,15 @@ var keys = map[Key]string{
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
 	EnableBatcher:                       "worker.enableBatcher",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
+	EnableScheduler:                     "worker.enableScheduler",
+
+	// persistence fault injection settings
+	EnablePersistenceFaultInjection:                 "system.enablePersistenceFaultInjection",
+	PersistenceFaultInjectionTimeoutRate:            "system.persistenceFaultInjectionTimeoutRate",
+	PersistenceFaultInjectionConditionFailedRate:    "system.persistenceFaultInjectionConditionFailedRate",
+	PersistenceFaultInjectionShardOwnershipLostRate: "system.persistenceFaultInjectionShardOwnershipLostRate",
+	PersistenceFaultInjectionLatencyRate:            "system.persistenceFaultInjectionLatencyRate",
+	PersistenceFaultInjectionLatency:                "system.persistenceFaultInjectionLatency",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -88,6 +97,7 @@ var keys = map[Key]string{
 	FrontendRPS:                       "frontend.rps",
 	FrontendDomainRPS:                 "frontend.domainrps",
 	FrontendHistoryMgrNumConns:        "frontend.historyMgrNumConns",
+	FrontendReadFromSQLReplicas:       "frontend.readFromSQLReplicas",
 	DisableListVisibilityByFilter:     "frontend.disableListVisibilityByFilter",
 	FrontendThrottledLogRPS:           "frontend.throttledLogRPS",
 	EnableClientVersionCheck:          "frontend.enableClientVersionCheck",
@@ -126,9 +136,11 @@ var keys = map[Key]string{
 	HistoryCacheInitialSize:                               "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                             "history.historyMaxAutoResetPoints",
 	HistoryCacheMaxSize:                                   "history.cacheMaxSize",
+	HistoryCacheMaxSizeInBytes:                            "history.cacheMaxSizeInBytes",
 	HistoryCacheTTL:                                       "history.cacheTTL",
 	EventsCacheInitialSize:                                "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                    "history.eventsCacheMaxSize",
+	EventsCacheMaxSizeInBytes:                             "history.eventsCacheMaxSizeInBytes",
 	EventsCacheTTL:                                        "history.eventsCacheTTL",
 	AcquireShardInterval:                                  "history.acquireShardInterval",
 	StandbyClusterDelay:                                   "history.standbyClusterDelay",
@@ -161,6 +173,8 @@ var keys = map[Key]string{
 	TransferProcessorUpdateAckIntervalJitterCoefficient:   "history.transferProcessorUpdateAckIntervalJitterCoefficient",
 	TransferProcessorCompleteTransferInterval:             "history.transferProcessorCompleteTransferInterval",
 	TransferProcessorVisibilityArchivalTimeLimit:          "history.transferProcessorVisibilityArchivalTimeLimit",
+	TaskSchedulerDomainWeight:                             "history.taskSchedulerDomainWeight",
+	TaskMaxAttemptsBeforeDLQ:                              "history.taskMaxAttemptsBeforeDLQ",
 	ReplicatorTaskBatchSize:                               "history.replicatorTaskBatchSize",
 	ReplicatorTaskWorkerCount:                             "history.replicatorTaskWorkerCount",
 	ReplicatorTaskMaxRetryCount:                           "history.replicatorTaskMaxRetryCount",
@@ -177,6 +191,8 @@ var keys = map[Key]string{
 	ShardUpdateMinInterval:                                "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                  "history.shardSyncMinInterval",
 	DefaultEventEncoding:                                  "history.defaultEventEncoding",
+	EventBatchEncoding:                                    "history.eventBatchEncoding",
+	EnablePayloadEncryption:                               "history.enablePayloadEncryption",
 	EnableAdminProtection:                                 "history.enableAdminProtection",
 	AdminOperationToken:                                   "history.adminOperationToken",
 	EnableParentClosePolicy:                               "history.enableParentClosePolicy",
@@ -188,6 +204,9 @@ var keys = map[Key]string{
 	DecisionHeartbeatTimeout:                              "history.decisionHeartbeatTimeout",
 	ParentClosePolicyThreshold:                            "history.parentClosePolicyThreshold",
 	NumParentClosePolicySystemWorkflows:                   "history.numParentClosePolicySystemWorkflows",
+	MutableStateChecksumGenProbability:                    "history.mutableStateChecksumGenProbability",
+	MutableStateChecksumVerifyProbability:                 "history.mutableStateChecksumVerifyProbability",
+	MutableStateChecksumMismatchAction:                    "history.mutableStateChecksumMismatchAction",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
 	WorkerReplicatorMetaTaskConcurrency:             "worker.replicatorMetaTaskConcurrency",
@@ -262,6 +281,19 @@ const (
 	EnableDomainNotActiveAutoForwarding
 	// TransactionSizeLimit is the largest allowed transaction size to persistence
 	TransactionSizeLimit
+	// EnablePersistenceFaultInjection is whether persistence managers are wrapped with fault injection,
+	// only read on service start up
+	EnablePersistenceFaultInjection
+	// PersistenceFaultInjectionTimeoutRate is the rate of persistence operations failing with a timeout, filtered by operation
+	PersistenceFaultInjectionTimeoutRate
+	// PersistenceFaultInjectionConditionFailedRate is the rate of persistence operations failing with a condition failure, filtered by operation
+	PersistenceFaultInjectionConditionFailedRate
+	// PersistenceFaultInjectionShardOwnershipLostRate is the rate of persistence operations failing with a shard ownership lost, filtered by operation
+	PersistenceFaultInjectionShardOwnershipLostRate
+	// PersistenceFaultInjectionLatencyRate is the rate of persistence operations being delayed, filtered by operation
+	PersistenceFaultInjectionLatencyRate
+	// PersistenceFaultInjectionLatency is the latency added to delayed persistence operations, filtered by operation
+	PersistenceFaultInjectionLatency
 	// MinRetentionDays is the minimal allowed retention days for domain
 	MinRetentionDays
 	// MaxDecisionStartToCloseSeconds is the minimal allowed decision start to close timeout in seconds
@@ -304,6 +336,9 @@ const (
 	FrontendDomainRPS
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
+	// FrontendReadFromSQLReplicas routes frontend visibility and history reads to the sql read replicas,
+	// decision task polls and long polls of workflow history still read history from the primary
+	FrontendReadFromSQLReplicas
 	// FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	FrontendThrottledLogRPS
 	// EnableClientVersionCheck enables client version check for frontend
@@ -376,12 +411,16 @@ const (
 	HistoryCacheInitialSize
 	// HistoryCacheMaxSize is max size of history cache
 	HistoryCacheMaxSize
+	// HistoryCacheMaxSizeInBytes is max estimated size in bytes of history cache, 0 means no size bound
+	HistoryCacheMaxSizeInBytes
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
 	// EventsCacheInitialSize is initial size of events cache
 	EventsCacheInitialSize
 	// EventsCacheMaxSize is max size of events cache
 	EventsCacheMaxSize
+	// EventsCacheMaxSizeInBytes is max estimated size in bytes of events cache, 0 means no size bound
+	EventsCacheMaxSizeInBytes
 	// EventsCacheTTL is TTL of events cache
 	EventsCacheTTL
 	// AcquireShardInterval is interval that timer used to acquire shard
@@ -446,6 +485,10 @@ const (
 	TransferProcessorCompleteTransferInterval
 	// TransferProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records
 	TransferProcessorVisibilityArchivalTimeLimit
+	// TaskSchedulerDomainWeight is the weight of a domain when transfer and timer tasks are scheduled across domains
+	TaskSchedulerDomainWeight
+	// TaskMaxAttemptsBeforeDLQ is the number of failed attempts after which a transfer or timer task is moved to the shard DLQ, 0 disables the DLQ
+	TaskMaxAttemptsBeforeDLQ
 	// ReplicatorTaskBatchSize is batch size for ReplicatorProcessor
 	ReplicatorTaskBatchSize
 	// ReplicatorTaskWorkerCount is number of worker for ReplicatorProcessor
@@ -478,6 +521,12 @@ const (
 	ShardSyncMinInterval
 	// DefaultEventEncoding is the encoding type for history events
 	DefaultEventEncoding
+	// EventBatchEncoding is the encoding type for newly written history event batches, e.g. thriftrw+snappy or thriftrw+zstd,
+	// DefaultEventEncoding is used if empty
+	EventBatchEncoding
+	// EnablePayloadEncryption is whether history events and mutable state of a domain are encrypted at rest,
+	// requires the persistence encryption key file to be configured
+	EnablePayloadEncryption
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
 	// ArchiveRequestRPS is the rate limit on the number of archive request per second
@@ -504,6 +553,13 @@ const (
 	StickyTTL
 	// DecisionHeartbeatTimeout for decision heartbeat
 	DecisionHeartbeatTimeout
+	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
+	MutableStateChecksumGenProbability
+	// MutableStateChecksumVerifyProbability is the probability [0-100] that checksum will be verified for mutable state
+	MutableStateChecksumVerifyProbability
+	// MutableStateChecksumMismatchAction is the action to take when the checksum of a loaded mutable state
+	// does not match, one of "metric", "log" or "rebuild"
+	MutableStateChecksumMismatchAction
 
 	// key for worker
 
@@ -557,6 +613,8 @@ const (
 	EnableBatcher
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
+	// EnableScheduler decides whether start the workers of the schedules in our worker
+	EnableScheduler
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
@@ -566,7 +624,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f > OperationName {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -577,6 +635,7 @@ var filters = []string{
 	"domainName",
 	"taskListName",
 	"taskType",
+	"operationName",
 }
 
 const (
@@ -587,6 +646,8 @@ const (
 	TaskListName
 	// TaskType is the task type (0:Decision, 1:Activity)
 	TaskType
+	// OperationName is the persistence operation name, e.g. UpdateWorkflowExecution
+	OperationName
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -615,3 +676,10 @@ func TaskTypeFilter(taskType int) FilterOption {
 		filterMap[TaskType] = taskType
 	}
 }
+
+// OperationFilter filters by persistence operation name
+func OperationFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[OperationName] = name
+	}
+}
@@ -306,6 +306,7 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *h.RecordDecision
 		BranchToken:               historyResponse.BranchToken,
 		ScheduledTimestamp:        historyResponse.ScheduledTimestamp,
 		StartedTimestamp:          historyResponse.StartedTimestamp,
+		Updates:                   historyResponse.Updates,
 	}
 	if historyResponse.GetPreviousStartedEventId() != EmptyEventID {
 		matchingResp.PreviousStartedEventId = historyResponse.PreviousStartedEventId
@@ -393,12 +394,18 @@ func CreateHistoryStartWorkflowRequest(
 		DomainUUID:   StringPtr(domainID),
 		StartRequest: startRequest,
 	}
+	// the workflow is created right away, but its first decision is scheduled after the start delay,
+	// retry expiration and cron schedule are both counted from the delayed start
+	delayStartSeconds := startRequest.GetDelayStartSeconds()
+	delayedStart := now.Add(time.Second * time.Duration(delayStartSeconds))
 	if startRequest.RetryPolicy != nil && startRequest.RetryPolicy.GetExpirationIntervalInSeconds() > 0 {
 		expirationInSeconds := startRequest.RetryPolicy.GetExpirationIntervalInSeconds()
-		deadline := now.Add(time.Second * time.Duration(expirationInSeconds))
+		deadline := delayedStart.Add(time.Second * time.Duration(expirationInSeconds))
 		histRequest.ExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
 	}
-	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), now, now))
+	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(
+		delayStartSeconds + backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), delayedStart, delayedStart),
+	)
 	return histRequest
 }
 
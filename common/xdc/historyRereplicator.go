@@ -468,11 +468,8 @@ func (c *historyRereplicationContext) deserializeBlob(blob *shared.DataBlob) ([]
 	var historyEvents []*shared.HistoryEvent
 
 	switch blob.GetEncodingType() {
-	case shared.EncodingTypeThriftRW:
-		historyEvents, err = c.rereplicator.serializer.DeserializeBatchEvents(&persistence.DataBlob{
-			Encoding: common.EncodingTypeThriftRW,
-			Data:     blob.Data,
-		})
+	case shared.EncodingTypeThriftRW, shared.EncodingTypeThriftRWSnappy, shared.EncodingTypeThriftRWZstd:
+		historyEvents, err = c.rereplicator.serializer.DeserializeBatchEvents(persistence.NewDataBlobFromThrift(blob))
 		if err != nil {
 			return nil, err
 		}
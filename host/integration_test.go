@@ -1377,6 +1377,10 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	s.True(nil == dweResponse.WorkflowExecutionInfo.CloseTime)
 	s.Equal(int64(2), *dweResponse.WorkflowExecutionInfo.HistoryLength) // WorkflowStarted, DecisionScheduled
 	s.Equal(dweResponse.WorkflowExecutionInfo.GetStartTime(), dweResponse.WorkflowExecutionInfo.GetExecutionTime())
+	s.NotNil(dweResponse.PendingDecision)
+	s.Equal(workflow.PendingDecisionStateScheduled, dweResponse.PendingDecision.GetState())
+	s.Equal(int64(0), dweResponse.PendingDecision.GetAttempt())
+	s.Equal(0, len(dweResponse.PendingTimers))
 
 	// decider logic
 	workflowComplete := false
@@ -1439,6 +1443,7 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	s.Equal(1, len(dweResponse.PendingActivities))
 	s.Equal("test-activity-type", dweResponse.PendingActivities[0].ActivityType.GetName())
 	s.Equal(int64(0), dweResponse.PendingActivities[0].GetLastHeartbeatTimestamp())
+	s.Nil(dweResponse.PendingDecision)
 
 	// process activity task
 	err = poller.PollAndProcessActivityTask(false)
@@ -1448,6 +1453,8 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	s.True(nil == dweResponse.WorkflowExecutionInfo.CloseStatus)
 	s.Equal(int64(8), *dweResponse.WorkflowExecutionInfo.HistoryLength) // ActivityTaskStarted, ActivityTaskCompleted, DecisionTaskScheduled
 	s.Equal(0, len(dweResponse.PendingActivities))
+	s.NotNil(dweResponse.PendingDecision)
+	s.Equal(workflow.PendingDecisionStateScheduled, dweResponse.PendingDecision.GetState())
 
 	// Process signal in decider
 	_, err = poller.PollAndProcessDecisionTask(true, false)
@@ -1458,6 +1465,7 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	s.Nil(err)
 	s.Equal(workflow.WorkflowExecutionCloseStatusCompleted, *dweResponse.WorkflowExecutionInfo.CloseStatus)
 	s.Equal(int64(11), *dweResponse.WorkflowExecutionInfo.HistoryLength) // DecisionStarted, DecisionCompleted, WorkflowCompleted
+	s.Nil(dweResponse.PendingDecision)
 }
 
 func (s *integrationSuite) TestVisibility() {
@@ -97,6 +97,50 @@ service AdminService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * RefreshWorkflowTasks regenerates the transfer and timer tasks of a workflow execution from its mutable state.
+  **/
+  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.DomainNotActiveError domainNotActiveError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ReadTaskDLQ returns the transfer or timer tasks of a shard which were moved to DLQ after exhausting their attempts.
+  **/
+  shared.ReadTaskDLQResponse ReadTaskDLQ(1: shared.ReadTaskDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ReplayTaskDLQ reprocesses the tasks stored in DLQ entries and removes the entries.
+  **/
+  shared.ReplayTaskDLQResponse ReplayTaskDLQ(1: shared.ReplayTaskDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * PurgeTaskDLQ removes DLQ entries of a shard without processing them.
+  **/
+  void PurgeTaskDLQ(1: shared.PurgeTaskDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
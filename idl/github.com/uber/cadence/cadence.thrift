@@ -381,6 +381,22 @@ service WorkflowService {
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * UpdateWorkflowExecution delivers an update to a running workflow execution and blocks until the worker
+  * completes or rejects it. A completed update results in WorkflowExecutionUpdateAccepted and
+  * WorkflowExecutionUpdateCompleted events recorded in the history, a rejected update leaves the history untouched.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: shared.UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
   * If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history
@@ -430,6 +446,66 @@ service WorkflowService {
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+  * in the history. Decision and activity tasks are not dispatched and timers are held until the execution is unpaused.
+  **/
+  void PauseWorkflowExecution(1: shared.PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+  * in the history and dispatching the tasks which were held while the execution was paused.
+  **/
+  void UnpauseWorkflowExecution(1: shared.UnpauseWorkflowExecutionRequest unpauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * DeleteWorkflowExecution terminates the workflow execution if it is still running, then deletes its mutable state,
+  * history and visibility records.
+  **/
+  void DeleteWorkflowExecution(1: shared.DeleteWorkflowExecutionRequest deleteRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * UpsertWorkflowExecutionAttributes merges search attributes and memo fields into a running workflow execution
+  * by recording WorkflowExecutionAttributesUpserted event in the history.
+  **/
+  void UpsertWorkflowExecutionAttributes(1: shared.UpsertWorkflowExecutionAttributesRequest upsertRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
   **/
@@ -590,6 +666,105 @@ service WorkflowService {
       6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * CreateSchedule creates a schedule which starts workflow executions of the target domain
+  * at the times given by its spec.
+  **/
+  void CreateSchedule(1: shared.CreateScheduleRequest createRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * DescribeSchedule returns the spec, action, policies and the recent actions of a schedule.
+  **/
+  shared.DescribeScheduleResponse DescribeSchedule(1: shared.DescribeScheduleRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * UpdateSchedule replaces the spec, action or policies of a schedule.
+  **/
+  void UpdateSchedule(1: shared.UpdateScheduleRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * PauseSchedule pauses or unpauses a schedule, no action is taken while the schedule is paused.
+  **/
+  void PauseSchedule(1: shared.PauseScheduleRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * TriggerSchedule takes the action of a schedule immediately.
+  **/
+  void TriggerSchedule(1: shared.TriggerScheduleRequest triggerRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * BackfillSchedule takes the actions a schedule would have taken during the given time range.
+  **/
+  void BackfillSchedule(1: shared.BackfillScheduleRequest backfillRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * DeleteSchedule deletes a schedule, the workflow executions started by it are not affected.
+  **/
+  void DeleteSchedule(1: shared.DeleteScheduleRequest deleteRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
     throws (
       1: shared.BadRequestError badRequestError,
@@ -209,6 +209,7 @@ struct RecordDecisionTaskStartedResponse {
   110: optional binary branchToken
   120:  optional i64 (js.type = "Long") scheduledTimestamp
   130:  optional i64 (js.type = "Long") startedTimestamp
+  140:  optional list<shared.WorkflowUpdate> updates
 }
 
 struct SignalWorkflowExecutionRequest {
@@ -234,6 +235,31 @@ struct TerminateWorkflowExecutionRequest {
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseWorkflowExecutionRequest pauseRequest
+}
+
+struct UnpauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.UnpauseWorkflowExecutionRequest unpauseRequest
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.DeleteWorkflowExecutionRequest deleteRequest
+}
+
+struct UpsertWorkflowExecutionAttributesRequest {
+  10: optional string domainUUID
+  20: optional shared.UpsertWorkflowExecutionAttributesRequest upsertRequest
+}
+
+struct RefreshWorkflowTasksRequest {
+  10: optional string domainUUID
+  20: optional shared.RefreshWorkflowTasksRequest request
+}
+
 struct ResetWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.ResetWorkflowExecutionRequest resetRequest
@@ -343,11 +369,45 @@ struct QueryWorkflowResponse {
   10: optional binary queryResult
 }
 
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateWorkflowExecutionRequest updateRequest
+}
+
 struct ReapplyEventsRequest {
   10: optional string domainUUID
   20: optional shared.ReapplyEventsRequest request
 }
 
+struct MutableStateChecksumPayload {
+  10: optional bool cancelRequested
+  15: optional i32 state
+  16: optional i32 closeStatus
+
+  21: optional i64 (js.type = "Long") lastWriteVersion
+  22: optional i64 (js.type = "Long") lastWriteEventID
+  23: optional i64 (js.type = "Long") lastFirstEventID
+  24: optional i64 (js.type = "Long") nextEventID
+  25: optional i64 (js.type = "Long") lastProcessedEventID
+  26: optional i64 (js.type = "Long") signalCount
+
+  35: optional i32 decisionAttempt
+  36: optional i64 (js.type = "Long") decisionVersion
+  37: optional i64 (js.type = "Long") decisionScheduledID
+  38: optional i64 (js.type = "Long") decisionStartedID
+
+  45: optional list<i64> pendingTimerStartedIDs
+  46: optional list<i64> pendingActivityScheduledIDs
+  47: optional list<i64> pendingSignalInitiatedIDs
+  48: optional list<i64> pendingReqCancelInitiatedIDs
+  49: optional list<i64> pendingChildInitiatedIDs
+  50: optional list<i64> pendingActivityStartedIDs
+  51: optional list<i64> pendingActivityVersions
+
+  55: optional string stickyTaskListName
+  56: optional shared.VersionHistories versionHistories
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -625,6 +685,66 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+  * in the history. Decision and activity tasks are not dispatched and timers are held until the execution is unpaused.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+  * in the history and dispatching the tasks which were held while the execution was paused.
+  **/
+  void UnpauseWorkflowExecution(1: UnpauseWorkflowExecutionRequest unpauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DeleteWorkflowExecution terminates the workflow execution if it is still running, then deletes its mutable state,
+  * current execution, history and visibility records through a timer task.
+  **/
+  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest deleteRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UpsertWorkflowExecutionAttributes records WorkflowExecutionAttributesUpserted event, which merges search
+  * attributes and memo fields into a running workflow execution.
+  **/
+  void UpsertWorkflowExecutionAttributes(1: UpsertWorkflowExecutionAttributesRequest upsertRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * ResetWorkflowExecution reset an existing workflow execution by a firstEventID of a existing event batch
   * in the history and immediately terminating the current execution instance.
@@ -841,4 +961,68 @@ service HistoryService {
       6: ShardOwnershipLostError shardOwnershipLostError,
       7: shared.EntityNotExistsError entityNotExistError,
     )
+
+  /**
+  * RefreshWorkflowTasks regenerates the transfer and timer tasks of a workflow execution from its mutable state.
+  **/
+  void RefreshWorkflowTasks(1: RefreshWorkflowTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.DomainNotActiveError domainNotActiveError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ReadTaskDLQ returns the transfer or timer tasks of a shard which were moved to DLQ after exhausting their attempts.
+  **/
+  shared.ReadTaskDLQResponse ReadTaskDLQ(1: shared.ReadTaskDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ReplayTaskDLQ reprocesses the tasks stored in DLQ entries and removes the entries.
+  **/
+  shared.ReplayTaskDLQResponse ReplayTaskDLQ(1: shared.ReplayTaskDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * PurgeTaskDLQ removes DLQ entries of a shard without processing them.
+  **/
+  void PurgeTaskDLQ(1: shared.PurgeTaskDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UpdateWorkflowExecution delivers an update to a running workflow execution and blocks until the worker
+  * completes or rejects it. A completed update records WorkflowExecutionUpdateAccepted and
+  * WorkflowExecutionUpdateCompleted events in the history.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
 }
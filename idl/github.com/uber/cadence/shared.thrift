@@ -108,6 +108,30 @@ enum WorkflowIdReusePolicy {
    * do not allow start a workflow execution using the same workflow ID at all
    */
   RejectDuplicate,
+  /*
+   * if a workflow execution with the same workflow ID is running, terminate it and
+   * start the new workflow execution in the same transaction, otherwise same as AllowDuplicate.
+   */
+  TerminateIfRunning,
+}
+
+enum ScheduleOverlapPolicy {
+  /*
+   * skip the action when the workflow started by the previous action is still running
+   */
+  SKIP,
+  /*
+   * buffer at most one action and start it once the previous workflow closes
+   */
+  BUFFER_ONE,
+  /*
+   * request cancellation of the previous workflow and start the action once it closes
+   */
+  CANCEL_PREVIOUS,
+  /*
+   * start the action regardless of the previous workflows
+   */
+  ALLOW_ALL,
 }
 
 enum DomainStatus {
@@ -148,6 +172,7 @@ enum DecisionType {
   StartChildWorkflowExecution,
   SignalExternalWorkflowExecution,
   UpsertWorkflowSearchAttributes,
+  UpsertWorkflowMemo,
 }
 
 enum EventType {
@@ -193,6 +218,12 @@ enum EventType {
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
   UpsertWorkflowSearchAttributes,
+  WorkflowExecutionUpdateAccepted,
+  WorkflowExecutionUpdateCompleted,
+  WorkflowExecutionPaused,
+  WorkflowExecutionUnpaused,
+  UpsertWorkflowMemo,
+  WorkflowExecutionAttributesUpserted,
 }
 
 enum DecisionTaskFailedCause {
@@ -219,6 +250,7 @@ enum DecisionTaskFailedCause {
   BAD_BINARY,
   SCHEDULE_ACTIVITY_DUPLICATE_ID,
   BAD_SEARCH_ATTRIBUTES,
+  BAD_MEMO,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -255,12 +287,22 @@ enum QueryResultType {
   FAILED,
 }
 
+enum WorkflowUpdateResultType {
+  COMPLETED,
+  REJECTED,
+}
+
 enum PendingActivityState {
   SCHEDULED,
   STARTED,
   CANCEL_REQUESTED,
 }
 
+enum PendingDecisionState {
+  SCHEDULED,
+  STARTED,
+}
+
 enum HistoryEventFilterType {
   ALL_EVENT,
   CLOSE_EVENT,
@@ -305,6 +347,8 @@ struct TaskList {
 enum EncodingType {
   ThriftRW,
   JSON,
+  ThriftRWSnappy,
+  ThriftRWZstd,
 }
 
 enum QueryRejectCondition {
@@ -314,6 +358,24 @@ enum QueryRejectCondition {
   NOT_COMPLETED_CLEANLY
 }
 
+enum ResetType {
+  // FIRST_DECISION_COMPLETED resets to the first DecisionTaskCompleted event of the run
+  FIRST_DECISION_COMPLETED
+  // LAST_DECISION_COMPLETED resets to the last DecisionTaskCompleted event of the run
+  LAST_DECISION_COMPLETED
+  // LAST_CONTINUED_AS_NEW resets to the last DecisionTaskCompleted event of the run this run continued from
+  LAST_CONTINUED_AS_NEW
+  // BAD_BINARY resets to the first DecisionTaskCompleted event made by the binary of resetBinaryChecksum
+  BAD_BINARY
+}
+
+enum ResetReapplyType {
+  // SIGNAL reapplies the signals received after the reset point, this is the default
+  SIGNAL
+  // NONE does not reapply any events received after the reset point
+  NONE
+}
+
 struct DataBlob {
   10: optional EncodingType EncodingType
   20: optional binary Data
@@ -354,6 +416,8 @@ struct WorkflowExecutionInfo {
   100: optional Memo memo
   101: optional SearchAttributes searchAttributes
   110: optional ResetPoints autoResetPoints
+  120: optional bool paused
+  130: optional bool waitingToStart
 }
 
 struct WorkflowExecutionConfiguration {
@@ -429,6 +493,10 @@ struct UpsertWorkflowSearchAttributesDecisionAttributes {
   10: optional SearchAttributes searchAttributes
 }
 
+struct UpsertWorkflowMemoDecisionAttributes {
+  10: optional Memo memo
+}
+
 struct RecordMarkerDecisionAttributes {
   10: optional string markerName
   20: optional binary details
@@ -487,6 +555,7 @@ struct Decision {
   100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes
   110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes
   120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes
+  130: optional UpsertWorkflowMemoDecisionAttributes upsertWorkflowMemoDecisionAttributes
 }
 
 struct WorkflowExecutionStartedEventAttributes {
@@ -722,10 +791,45 @@ struct WorkflowExecutionSignaledEventAttributes {
   30: optional string identity
 }
 
+struct WorkflowExecutionUpdateAcceptedEventAttributes {
+  10: optional string updateId
+  20: optional string updateName
+  30: optional binary input
+  40: optional string identity
+  50: optional string requestId
+  60: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+}
+
+struct WorkflowExecutionUpdateCompletedEventAttributes {
+  10: optional string updateId
+  20: optional i64 (js.type = "Long") acceptedEventId
+  30: optional binary result
+  40: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+}
+
 struct WorkflowExecutionTerminatedEventAttributes {
   10: optional string reason
   20: optional binary details
   30: optional string identity
+  // newExecutionRunId is the run started in place of this run by the TerminateIfRunning reuse policy
+  40: optional string newExecutionRunId
+}
+
+struct WorkflowExecutionPausedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
+struct WorkflowExecutionUnpausedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
+struct WorkflowExecutionAttributesUpsertedEventAttributes {
+  10: optional SearchAttributes searchAttributes
+  20: optional Memo memo
+  30: optional string reason
+  40: optional string identity
 }
 
 struct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
@@ -782,6 +886,11 @@ struct UpsertWorkflowSearchAttributesEventAttributes {
   20: optional SearchAttributes searchAttributes
 }
 
+struct UpsertWorkflowMemoEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional Memo memo
+}
+
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
   10:  optional string domain
   20:  optional string workflowId
@@ -913,6 +1022,12 @@ struct HistoryEvent {
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
   450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
+  460: optional WorkflowExecutionUpdateAcceptedEventAttributes workflowExecutionUpdateAcceptedEventAttributes
+  470: optional WorkflowExecutionUpdateCompletedEventAttributes workflowExecutionUpdateCompletedEventAttributes
+  480: optional WorkflowExecutionPausedEventAttributes workflowExecutionPausedEventAttributes
+  490: optional WorkflowExecutionUnpausedEventAttributes workflowExecutionUnpausedEventAttributes
+  500: optional UpsertWorkflowMemoEventAttributes upsertWorkflowMemoEventAttributes
+  510: optional WorkflowExecutionAttributesUpsertedEventAttributes workflowExecutionAttributesUpsertedEventAttributes
 }
 
 struct History {
@@ -1059,6 +1174,7 @@ struct StartWorkflowExecutionRequest {
   140: optional Memo memo
   141: optional SearchAttributes searchAttributes
   150: optional Header header
+  160: optional i32 delayStartSeconds
 }
 
 struct StartWorkflowExecutionResponse {
@@ -1087,6 +1203,7 @@ struct PollForDecisionTaskResponse {
   100:  optional i64 (js.type = "Long") scheduledTimestamp
   110:  optional i64 (js.type = "Long") startedTimestamp
   120:  optional list<WorkflowQuery> queries
+  130:  optional list<WorkflowUpdate> updates
 }
 
 struct StickyExecutionAttributes {
@@ -1104,6 +1221,7 @@ struct RespondDecisionTaskCompletedRequest {
   70: optional bool forceCreateNewDecisionTask
   80: optional string binaryChecksum
   90: optional list<WorkflowQueryResult> queryResults
+  100: optional list<WorkflowUpdateResult> updateResults
 }
 
 struct RespondDecisionTaskCompletedResponse {
@@ -1241,6 +1359,27 @@ struct SignalWorkflowExecutionRequest {
   70: optional binary control
 }
 
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string updateName
+  40: optional binary input
+  50: optional string identity
+  60: optional string requestId
+  70: optional i32 timeoutSeconds
+}
+
+struct WorkflowUpdateRejected {
+  10: optional string reason
+  20: optional binary details
+}
+
+struct UpdateWorkflowExecutionResponse {
+  10: optional string updateId
+  20: optional binary result
+  30: optional WorkflowUpdateRejected updateRejected
+}
+
 struct SignalWithStartWorkflowExecutionRequest {
   10: optional string domain
   20: optional string workflowId
@@ -1260,6 +1399,7 @@ struct SignalWithStartWorkflowExecutionRequest {
   160: optional Memo memo
   161: optional SearchAttributes searchAttributes
   170: optional Header header
+  180: optional i32 delayStartSeconds
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1270,12 +1410,152 @@ struct TerminateWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct UnpauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct UpsertWorkflowExecutionAttributesRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  // searchAttributes are merged into the search attributes of the workflow execution
+  30: optional SearchAttributes searchAttributes
+  // memo fields are merged into the memo of the workflow execution
+  40: optional Memo memo
+  50: optional string reason
+  60: optional string identity
+}
+
+struct ScheduleSpec {
+  10: optional string cronExpression
+  20: optional string timezone
+  30: optional i32 jitterSeconds
+  40: optional i64 (js.type = "Long") startTime
+  50: optional i64 (js.type = "Long") endTime
+}
+
+struct ScheduleAction {
+  10: optional string workflowIdPrefix
+  20: optional WorkflowType workflowType
+  30: optional TaskList taskList
+  40: optional binary input
+  50: optional i32 executionStartToCloseTimeoutSeconds
+  60: optional i32 taskStartToCloseTimeoutSeconds
+  70: optional RetryPolicy retryPolicy
+  80: optional Memo memo
+  90: optional SearchAttributes searchAttributes
+  100: optional Header header
+}
+
+struct SchedulePolicies {
+  10: optional ScheduleOverlapPolicy overlapPolicy
+  20: optional i32 catchUpWindowSeconds
+}
+
+struct ScheduleActionResult {
+  10: optional i64 (js.type = "Long") scheduledTime
+  20: optional i64 (js.type = "Long") actualTime
+  30: optional WorkflowExecution startedWorkflow
+  40: optional string skippedReason
+}
+
+struct CreateScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional ScheduleSpec spec
+  40: optional ScheduleAction action
+  50: optional SchedulePolicies policies
+  60: optional bool paused
+  70: optional string identity
+  80: optional string requestId
+}
+
+struct DescribeScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+}
+
+struct DescribeScheduleResponse {
+  10: optional ScheduleSpec spec
+  20: optional ScheduleAction action
+  30: optional SchedulePolicies policies
+  40: optional bool paused
+  50: optional string notes
+  60: optional i64 (js.type = "Long") createTime
+  70: optional i64 (js.type = "Long") updateTime
+  80: optional i64 (js.type = "Long") actionCount
+  90: optional i64 (js.type = "Long") missedCatchUpWindow
+  100: optional i64 (js.type = "Long") overlapSkipped
+  110: optional list<WorkflowExecution> runningWorkflows
+  120: optional list<ScheduleActionResult> recentActions
+  130: optional list<i64> nextActionTimes
+}
+
+struct UpdateScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional ScheduleSpec spec
+  40: optional ScheduleAction action
+  50: optional SchedulePolicies policies
+  60: optional string identity
+}
+
+struct PauseScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional bool unpause
+  40: optional string reason
+  50: optional string identity
+}
+
+struct TriggerScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional ScheduleOverlapPolicy overlapPolicy
+  40: optional string identity
+}
+
+struct BackfillScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional i64 (js.type = "Long") startTime
+  40: optional i64 (js.type = "Long") endTime
+  50: optional ScheduleOverlapPolicy overlapPolicy
+  60: optional string identity
+}
+
+struct DeleteScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional string identity
+}
+
 struct ResetWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
   30: optional string reason
   40: optional i64 (js.type = "Long") decisionFinishEventId
   50: optional string requestId
+  // resetType resolves the reset point in the server, it cannot be used together with decisionFinishEventId
+  60: optional ResetType resetType
+  70: optional string resetBinaryChecksum
+  80: optional ResetReapplyType resetReapplyType
 }
 
 struct ResetWorkflowExecutionResponse {
@@ -1370,6 +1650,20 @@ struct WorkflowQuery {
   20: optional binary queryArgs
 }
 
+struct WorkflowUpdate {
+  10: optional string updateId
+  20: optional string updateName
+  30: optional binary input
+}
+
+struct WorkflowUpdateResult {
+  10: optional string updateId
+  20: optional WorkflowUpdateResultType resultType
+  30: optional binary result
+  40: optional string rejectionReason
+  50: optional binary rejectionDetails
+}
+
 struct ResetStickyTaskListRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1423,11 +1717,27 @@ struct PendingChildExecutionInfo {
   50: optional ParentClosePolicy parentClosePolicy
 }
 
+struct PendingTimerInfo {
+  10: optional string timerID
+  20: optional i64 (js.type = "Long") startedEventID
+  30: optional i64 (js.type = "Long") expiryTimestamp
+}
+
+struct PendingDecisionInfo {
+  10: optional PendingDecisionState state
+  20: optional i64 (js.type = "Long") scheduledTimestamp
+  30: optional i64 (js.type = "Long") startedTimestamp
+  40: optional i64 attempt
+  50: optional i64 (js.type = "Long") originalScheduledTimestamp
+}
+
 struct DescribeWorkflowExecutionResponse {
   10: optional WorkflowExecutionConfiguration executionConfiguration
   20: optional WorkflowExecutionInfo workflowExecutionInfo
   30: optional list<PendingActivityInfo> pendingActivities
   40: optional list<PendingChildExecutionInfo> pendingChildren
+  50: optional list<PendingTimerInfo> pendingTimers
+  60: optional PendingDecisionInfo pendingDecision
 }
 
 struct DescribeTaskListRequest {
@@ -1472,6 +1782,69 @@ struct CloseShardRequest {
   10: optional i32               shardID
 }
 
+struct RefreshWorkflowTasksRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+}
+
+enum TaskDLQCategory {
+  TRANSFER,
+  TIMER,
+}
+
+struct TaskDLQEntry {
+  10: optional TaskDLQCategory category
+  20: optional i64 (js.type = "Long") taskID
+  30: optional i32 taskType
+  40: optional string domainID
+  50: optional string workflowID
+  60: optional string runID
+  70: optional i64 (js.type = "Long") visibilityTimestamp
+  80: optional i64 (js.type = "Long") version
+  90: optional i32 attempt
+  100: optional string lastError
+  110: optional i64 (js.type = "Long") enqueueTimestamp
+  // json encoded task as persisted in the DLQ
+  120: optional string details
+}
+
+struct ReadTaskDLQRequest {
+  10: optional i32 shardID
+  20: optional TaskDLQCategory category
+  // only the entry with this task ID is returned when set
+  30: optional i64 (js.type = "Long") taskID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+}
+
+struct ReadTaskDLQResponse {
+  10: optional list<TaskDLQEntry> entries
+  20: optional binary nextPageToken
+}
+
+struct ReplayTaskDLQRequest {
+  10: optional i32 shardID
+  20: optional TaskDLQCategory category
+  // all entries of the category are replayed page by page when not set
+  30: optional i64 (js.type = "Long") taskID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+}
+
+struct ReplayTaskDLQResponse {
+  10: optional i32 replayedCount
+  20: optional i32 failedCount
+  // the entries after this page are replayed by passing the token with the next request
+  30: optional binary nextPageToken
+}
+
+struct PurgeTaskDLQRequest {
+  10: optional i32 shardID
+  20: optional TaskDLQCategory category
+  // all entries of the category are purged when not set
+  30: optional i64 (js.type = "Long") taskID
+}
+
 struct DescribeHistoryHostResponse{
   10: optional i32                  numberOfShards
   20: optional list<i32>            shardIDs
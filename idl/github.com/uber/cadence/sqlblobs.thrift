@@ -131,6 +131,10 @@ struct WorkflowExecutionInfo {
   120: optional map<string, binary> memo
   122: optional binary versionHistories
   124: optional string versionHistoriesEncoding
+  126: optional bool paused
+  128: optional i32 checksumVersion
+  130: optional i32 checksumFlavor
+  132: optional binary checksum
 }
 
 struct ActivityInfo {
@@ -0,0 +1,9 @@
+CREATE TABLE task_dlq(
+  shard_id INT NOT NULL,
+  category INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, category, task_id)
+);
@@ -10,6 +10,8 @@ CREATE TABLE executions_visibility (
   history_length       BIGINT,
   memo                 BLOB,
   encoding             VARCHAR(64) NOT NULL,
+  search_attributes    JSON,
+  paused               BOOLEAN NOT NULL DEFAULT FALSE,
 
   PRIMARY KEY  (domain_id, run_id)
 );
@@ -0,0 +1 @@
+ALTER TABLE executions_visibility ADD paused BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+ALTER TABLE executions_visibility ADD COLUMN search_attributes JSONB;
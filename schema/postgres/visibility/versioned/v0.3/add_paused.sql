@@ -0,0 +1 @@
+ALTER TABLE executions_visibility ADD COLUMN paused BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,9 @@
+CREATE TABLE task_dlq(
+  shard_id INTEGER NOT NULL,
+  category INTEGER NOT NULL,
+  task_id INTEGER NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, category, task_id)
+);
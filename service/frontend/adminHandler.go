@@ -242,6 +242,97 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *gen.CloseShard
 	return err
 }
 
+// RefreshWorkflowTasks regenerates the transfer and timer tasks of a workflow execution from its mutable state
+func (adh *AdminHandler) RefreshWorkflowTasks(ctx context.Context, request *gen.RefreshWorkflowTasksRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRefreshWorkflowTasksScope
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.RefreshWorkflowTasks(ctx, &h.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ReadTaskDLQ returns the transfer or timer tasks of a shard which were moved to DLQ after exhausting their attempts
+func (adh *AdminHandler) ReadTaskDLQ(ctx context.Context, request *gen.ReadTaskDLQRequest) (resp *gen.ReadTaskDLQResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminReadTaskDLQScope
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.ShardID == nil {
+		return nil, adh.error(errShardIDNotSet, scope)
+	}
+	if request.Category == nil {
+		return nil, adh.error(errDLQCategoryNotSet, scope)
+	}
+
+	resp, err := adh.history.ReadTaskDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ReplayTaskDLQ reprocesses the tasks stored in DLQ entries and removes the entries
+func (adh *AdminHandler) ReplayTaskDLQ(ctx context.Context, request *gen.ReplayTaskDLQRequest) (resp *gen.ReplayTaskDLQResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminReplayTaskDLQScope
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.ShardID == nil {
+		return nil, adh.error(errShardIDNotSet, scope)
+	}
+	if request.Category == nil {
+		return nil, adh.error(errDLQCategoryNotSet, scope)
+	}
+
+	resp, err := adh.history.ReplayTaskDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PurgeTaskDLQ removes DLQ entries of a shard without processing them
+func (adh *AdminHandler) PurgeTaskDLQ(ctx context.Context, request *gen.PurgeTaskDLQRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminPurgeTaskDLQScope
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.ShardID == nil {
+		return adh.error(errShardIDNotSet, scope)
+	}
+	if request.Category == nil {
+		return adh.error(errDLQCategoryNotSet, scope)
+	}
+
+	if err := adh.history.PurgeTaskDLQ(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.DescribeHistoryHostRequest) (resp *gen.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -713,6 +804,8 @@ func (adh *AdminHandler) error(err error, scope int) error {
 		return err
 	case *gen.EntityNotExistsError:
 		return err
+	case *gen.DomainNotActiveError:
+		return err
 	default:
 		adh.Service.GetLogger().Error("Uncategorized error", tag.Error(err))
 		return &gen.InternalServiceError{Message: err.Error()}
@@ -261,6 +261,94 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2() {
 	s.NoError(err)
 }
 
+func (s *adminHandlerSuite) Test_RefreshWorkflowTasks_FailedOnDomainNotSet() {
+	ctx := context.Background()
+	err := s.handler.RefreshWorkflowTasks(ctx,
+		&shared.RefreshWorkflowTasksRequest{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr("workflowID"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+		})
+	s.Equal(errDomainNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_RefreshWorkflowTasks_FailedOnInvalidRunID() {
+	ctx := context.Background()
+	err := s.handler.RefreshWorkflowTasks(ctx,
+		&shared.RefreshWorkflowTasksRequest{
+			Domain: common.StringPtr(s.domainName),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr("workflowID"),
+				RunId:      common.StringPtr("runID"),
+			},
+		})
+	s.Equal(errInvalidRunID, err)
+}
+
+func (s *adminHandlerSuite) Test_RefreshWorkflowTasks() {
+	ctx := context.Background()
+	s.domainCache.On("GetDomainID", s.domainName).Return(s.domainID, nil)
+	request := &shared.RefreshWorkflowTasksRequest{
+		Domain: common.StringPtr(s.domainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflowID"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+	}
+	s.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &history.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(s.domainID),
+		Request:    request,
+	}).Return(nil).Times(1)
+
+	err := s.handler.RefreshWorkflowTasks(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_ReadTaskDLQ_FailedOnShardIDNotSet() {
+	ctx := context.Background()
+	_, err := s.handler.ReadTaskDLQ(ctx, &shared.ReadTaskDLQRequest{
+		Category: shared.TaskDLQCategoryTransfer.Ptr(),
+	})
+	s.Equal(errShardIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_ReadTaskDLQ() {
+	ctx := context.Background()
+	request := &shared.ReadTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: shared.TaskDLQCategoryTransfer.Ptr(),
+	}
+	response := &shared.ReadTaskDLQResponse{
+		Entries: []*shared.TaskDLQEntry{{TaskID: common.Int64Ptr(123)}},
+	}
+	s.historyClient.EXPECT().ReadTaskDLQ(gomock.Any(), request).Return(response, nil).Times(1)
+
+	resp, err := s.handler.ReadTaskDLQ(ctx, request)
+	s.NoError(err)
+	s.Equal(response, resp)
+}
+
+func (s *adminHandlerSuite) Test_ReplayTaskDLQ_FailedOnCategoryNotSet() {
+	ctx := context.Background()
+	_, err := s.handler.ReplayTaskDLQ(ctx, &shared.ReplayTaskDLQRequest{
+		ShardID: common.Int32Ptr(1),
+	})
+	s.Equal(errDLQCategoryNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_PurgeTaskDLQ() {
+	ctx := context.Background()
+	request := &shared.PurgeTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: shared.TaskDLQCategoryTimer.Ptr(),
+	}
+	s.historyClient.EXPECT().PurgeTaskDLQ(gomock.Any(), request).Return(nil).Times(1)
+
+	err := s.handler.PurgeTaskDLQ(ctx, request)
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) Test_GetEventRange_DefinedStartAndEnd() {
 	inputStartEventID := int64(1)
 	inputStartVersion := int64(10)
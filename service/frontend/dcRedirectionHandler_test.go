@@ -818,3 +818,259 @@ func (s *dcRedirectionHandlerSuite) TestTerminateWorkflowExecution() {
 	err = callFn(s.alternativeClusterName)
 	s.Nil(err)
 }
+
+func (s *dcRedirectionHandlerSuite) TestPauseWorkflowExecution() {
+	apiName := "PauseWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.PauseWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().PauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUnpauseWorkflowExecution() {
+	apiName := "UnpauseWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.UnpauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.UnpauseWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UnpauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestCreateSchedule() {
+	apiName := "CreateSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.CreateScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.CreateSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().CreateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().CreateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDescribeSchedule() {
+	apiName := "DescribeSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.DescribeScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	resp, err := s.handler.DescribeSchedule(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().DescribeSchedule(gomock.Any(), req).Return(&shared.DescribeScheduleResponse{}, nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), req).Return(&shared.DescribeScheduleResponse{}, nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUpdateSchedule() {
+	apiName := "UpdateSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.UpdateScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.UpdateSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UpdateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UpdateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestPauseSchedule() {
+	apiName := "PauseSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.PauseScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.PauseSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().PauseSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().PauseSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestTriggerSchedule() {
+	apiName := "TriggerSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.TriggerScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.TriggerSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().TriggerSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().TriggerSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestBackfillSchedule() {
+	apiName := "BackfillSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.BackfillScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.BackfillSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().BackfillSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().BackfillSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDeleteSchedule() {
+	apiName := "DeleteSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.DeleteScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.DeleteSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().DeleteSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().DeleteSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDeleteWorkflowExecution() {
+	apiName := "DeleteWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.DeleteWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.DeleteWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().DeleteWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUpsertWorkflowExecutionAttributes() {
+	apiName := "UpsertWorkflowExecutionAttributes"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.UpsertWorkflowExecutionAttributesRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.UpsertWorkflowExecutionAttributes(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UpsertWorkflowExecutionAttributes(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UpsertWorkflowExecutionAttributes(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUpdateWorkflowExecution() {
+	apiName := "UpdateWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &shared.UpdateWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	resp, err := s.handler.UpdateWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UpdateWorkflowExecution(gomock.Any(), req).Return(&shared.UpdateWorkflowExecutionResponse{}, nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), req).Return(&shared.UpdateWorkflowExecutionResponse{}, nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
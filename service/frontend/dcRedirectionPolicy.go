@@ -43,6 +43,18 @@ const (
 	// 3. SignalWorkflowExecution
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
+	// 6. UpdateWorkflowExecution
+	// 7. PauseWorkflowExecution
+	// 8. UnpauseWorkflowExecution
+	// 9. CreateSchedule
+	// 10. DescribeSchedule
+	// 11. UpdateSchedule
+	// 12. PauseSchedule
+	// 13. TriggerSchedule
+	// 14. BackfillSchedule
+	// 15. DeleteSchedule
+	// 16. DeleteWorkflowExecution
+	// 17. UpsertWorkflowExecutionAttributes
 	// please also reference selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 )
@@ -70,11 +82,23 @@ type (
 
 // selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs contains a list of APIs which can be redirected
 var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}{
-	"StartWorkflowExecution":           {},
-	"SignalWithStartWorkflowExecution": {},
-	"SignalWorkflowExecution":          {},
-	"RequestCancelWorkflowExecution":   {},
-	"TerminateWorkflowExecution":       {},
+	"StartWorkflowExecution":            {},
+	"SignalWithStartWorkflowExecution":  {},
+	"SignalWorkflowExecution":           {},
+	"RequestCancelWorkflowExecution":    {},
+	"TerminateWorkflowExecution":        {},
+	"UpdateWorkflowExecution":           {},
+	"PauseWorkflowExecution":            {},
+	"UnpauseWorkflowExecution":          {},
+	"CreateSchedule":                    {},
+	"DescribeSchedule":                  {},
+	"UpdateSchedule":                    {},
+	"PauseSchedule":                     {},
+	"TriggerSchedule":                   {},
+	"BackfillSchedule":                  {},
+	"DeleteSchedule":                    {},
+	"DeleteWorkflowExecution":           {},
+	"UpsertWorkflowExecutionAttributes": {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
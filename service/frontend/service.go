@@ -57,7 +57,8 @@ type Config struct {
 	MinRetentionDays                dynamicconfig.IntPropertyFn
 
 	// Persistence settings
-	HistoryMgrNumConns dynamicconfig.IntPropertyFn
+	HistoryMgrNumConns  dynamicconfig.IntPropertyFn
+	ReadFromSQLReplicas dynamicconfig.BoolPropertyFn
 
 	MaxBadBinaries dynamicconfig.IntPropertyFnWithDomainFilter
 
@@ -99,6 +100,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		DomainRPS:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainRPS, 1200),
 		MaxIDLengthLimit:                    dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		HistoryMgrNumConns:                  dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
+		ReadFromSQLReplicas:                 dc.GetBoolProperty(dynamicconfig.FrontendReadFromSQLReplicas, false),
 		MaxBadBinaries:                      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries, domain.MaxBadBinaries),
 		EnableAdminProtection:               dc.GetBoolProperty(dynamicconfig.EnableAdminProtection, false),
 		AdminOperationToken:                 dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
@@ -149,12 +151,16 @@ func (s *Service) Start() {
 	pConfig := params.PersistenceConfig
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.ReadFromReplicas = s.config.ReadFromSQLReplicas
 	pConfig.VisibilityConfig = &config.VisibilityConfig{
 		VisibilityListMaxQPS:            s.config.VisibilityListMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pFactory, err := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	if err != nil {
+		log.Fatal("failed to create persistence factory", tag.Error(err))
+	}
 
 	metadata, err := pFactory.NewMetadataManager()
 	if err != nil {
@@ -57,6 +57,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 const (
@@ -86,6 +87,7 @@ type (
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		domainReplicationQueue    persistence.DomainReplicationQueue
+		scheduleClient            scheduler.Client
 		service.Service
 	}
 
@@ -127,7 +129,17 @@ var (
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidDelayStartSeconds                   = &gen.BadRequestError{Message: "DelayStartSeconds cannot be negative."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
+	errScheduleIDNotSet                           = &gen.BadRequestError{Message: "ScheduleId is not set on request."}
+	errScheduleSpecNotSet                         = &gen.BadRequestError{Message: "Spec is not set on request."}
+	errScheduleActionNotSet                       = &gen.BadRequestError{Message: "Action is not set on request."}
+	errInvalidBackfillTimeRange                   = &gen.BadRequestError{Message: "EndTime cannot be before StartTime."}
+	errResetTypeWithDecisionFinishEventID         = &gen.BadRequestError{Message: "ResetType and DecisionFinishEventId cannot be both set on request."}
+	errResetBinaryChecksumNotSet                  = &gen.BadRequestError{Message: "ResetBinaryChecksum is not set on request."}
+	errShardIDNotSet                              = &gen.BadRequestError{Message: "ShardID is not set on request."}
+	errDLQCategoryNotSet                          = &gen.BadRequestError{Message: "DLQ category is not set on request."}
+	errSearchAttributesAndMemoNotSet              = &gen.BadRequestError{Message: "SearchAttributes or Memo is not set on request."}
 
 	// err for archival
 	errHistoryHasPassedRetentionPeriod = &gen.BadRequestError{Message: "Requested workflow history has passed retention period."}
@@ -139,9 +151,11 @@ var (
 	errWorkflowTypeTooLong = &gen.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	errWorkflowIDTooLong   = &gen.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	errSignalNameTooLong   = &gen.BadRequestError{Message: "SignalName length exceeds limit."}
+	errUpdateNameTooLong   = &gen.BadRequestError{Message: "UpdateName length exceeds limit."}
 	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
+	errScheduleIDTooLong   = &gen.BadRequestError{Message: "ScheduleId length exceeds limit."}
 
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
@@ -219,6 +233,7 @@ func (wh *WorkflowHandler) Start() error {
 	wh.matchingRawClient = matchingRawClient
 	wh.matching = matching.NewRetryableClient(wh.matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
+	wh.scheduleClient = scheduler.NewClient(wh.GetClientBean().GetFrontendClient())
 	wh.startWG.Done()
 	return nil
 }
@@ -1648,6 +1663,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
 	}
 
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if startRequest.GetRequestId() == "" {
 		return nil, wh.error(errRequestIDNotSet, scope)
 	}
@@ -1854,6 +1873,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 				nil,
 				token.TransientDecision,
 				token.BranchToken,
+				isLongPoll,
 			)
 			if err != nil {
 				return nil, wh.error(err, scope)
@@ -1886,6 +1906,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 				token.PersistenceToken,
 				token.TransientDecision,
 				token.BranchToken,
+				isLongPoll,
 			)
 			if err != nil {
 				return nil, wh.error(err, scope)
@@ -1989,6 +2010,94 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution is used to send an update to a running workflow execution and wait for its result.
+// The update is delivered to the workflow with the next decision task, accepted updates result in
+// WorkflowExecutionUpdateAccepted and WorkflowExecutionUpdateCompleted events recorded in the history.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *gen.UpdateWorkflowExecutionRequest,
+) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, updateRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if updateRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if len(updateRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errDomainTooLong, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(updateRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(&gen.BadRequestError{Message: "UpdateName is not set on request."}, scope)
+	}
+
+	if len(updateRequest.GetUpdateName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errUpdateNameTooLong, scope)
+	}
+
+	if len(updateRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errRequestIDTooLong, scope)
+	}
+
+	if updateRequest.GetTimeoutSeconds() < 0 {
+		return nil, wh.error(&gen.BadRequestError{Message: "A valid TimeoutSeconds is not set on request."}, scope)
+	}
+
+	// the request id deduplicates the update inside history, assign one so retries of this call are safe
+	if updateRequest.GetRequestId() == "" {
+		updateRequest.RequestId = common.StringPtr(uuid.New())
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(updateRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(updateRequest.GetDomain())
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(updateRequest.GetDomain())
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		updateRequest.GetWorkflowExecution().GetWorkflowId(),
+		updateRequest.GetWorkflowExecution().GetRunId(),
+		scope,
+		wh.GetThrottledLogger(),
+	); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err = wh.history.UpdateWorkflowExecution(ctx, &h.UpdateWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -2066,6 +2175,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2175,6 +2288,232 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history, no task of the execution is dispatched until it is unpaused.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *gen.PauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(pauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.PauseWorkflowExecution(ctx, &h.PauseWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+// in the history and dispatching again the tasks held back while it was paused.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *gen.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, unpauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if unpauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(unpauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(unpauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.UnpauseWorkflowExecution(ctx, &h.UnpauseWorkflowExecutionRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// DeleteWorkflowExecution terminates the workflow execution if it is still running, then deletes its
+// mutable state, history and visibility records
+func (wh *WorkflowHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	deleteRequest *gen.DeleteWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDeleteWorkflowExecutionScope, deleteRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if deleteRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(deleteRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if deleteRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(deleteRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if len(deleteRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(deleteRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	wh.GetLogger().Info("Delete workflow execution requested.",
+		tag.WorkflowDomainName(deleteRequest.GetDomain()),
+		tag.WorkflowID(deleteRequest.WorkflowExecution.GetWorkflowId()),
+		tag.WorkflowRunID(deleteRequest.WorkflowExecution.GetRunId()),
+		tag.Value(deleteRequest.GetIdentity()))
+
+	err = wh.history.DeleteWorkflowExecution(ctx, &h.DeleteWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		DeleteRequest: deleteRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// UpsertWorkflowExecutionAttributes merges search attributes and memo into a running workflow execution
+// without involving the workflow's decider.
+func (wh *WorkflowHandler) UpsertWorkflowExecutionAttributes(
+	ctx context.Context,
+	upsertRequest *gen.UpsertWorkflowExecutionAttributesRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpsertWorkflowExecutionAttributesScope, upsertRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if upsertRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(upsertRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	domainName := upsertRequest.GetDomain()
+	if domainName == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(upsertRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if len(upsertRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	if len(upsertRequest.SearchAttributes.GetIndexedFields()) == 0 && len(upsertRequest.Memo.GetFields()) == 0 {
+		return wh.error(errSearchAttributesAndMemoNotSet, scope)
+	}
+
+	if err := wh.searchAttributesValidator.ValidateSearchAttributes(upsertRequest.SearchAttributes, domainName); err != nil {
+		return wh.error(err, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(domainName)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
+	if err := common.CheckEventBlobSizeLimit(
+		common.GetSizeOfMapStringToByteArray(upsertRequest.Memo.GetFields()),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		upsertRequest.WorkflowExecution.GetWorkflowId(),
+		upsertRequest.WorkflowExecution.GetRunId(),
+		scope,
+		wh.GetThrottledLogger(),
+	); err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.UpsertWorkflowExecutionAttributes(ctx, &h.UpsertWorkflowExecutionAttributesRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpsertRequest: upsertRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -2206,6 +2545,15 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 		return nil, err
 	}
 
+	if resetRequest.IsSetResetType() {
+		if resetRequest.IsSetDecisionFinishEventId() {
+			return nil, wh.error(errResetTypeWithDecisionFinishEventID, scope)
+		}
+		if resetRequest.GetResetType() == gen.ResetTypeBadBinary && resetRequest.GetResetBinaryChecksum() == "" {
+			return nil, wh.error(errResetBinaryChecksumNotSet, scope)
+		}
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(resetRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -3092,6 +3440,7 @@ func (wh *WorkflowHandler) getHistory(
 	nextPageToken []byte,
 	transientDecision *gen.TransientDecisionInfo,
 	branchToken []byte,
+	readFromPrimary bool,
 ) (*gen.History, []byte, error) {
 
 	historyEvents := []*gen.HistoryEvent{}
@@ -3100,12 +3449,13 @@ func (wh *WorkflowHandler) getHistory(
 	shardID := common.WorkflowIDToHistoryShard(*execution.WorkflowId, wh.config.NumHistoryShards)
 	var err error
 	historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(wh.historyV2Mgr, &persistence.ReadHistoryBranchRequest{
-		BranchToken:   branchToken,
-		MinEventID:    firstEventID,
-		MaxEventID:    nextEventID,
-		PageSize:      int(pageSize),
-		NextPageToken: nextPageToken,
-		ShardID:       common.IntPtr(shardID),
+		BranchToken:     branchToken,
+		MinEventID:      firstEventID,
+		MaxEventID:      nextEventID,
+		PageSize:        int(pageSize),
+		NextPageToken:   nextPageToken,
+		ShardID:         common.IntPtr(shardID),
+		ReadFromPrimary: readFromPrimary,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -3241,6 +3591,30 @@ func (wh *WorkflowHandler) validateExecutionAndEmitMetrics(w *gen.WorkflowExecut
 	return nil
 }
 
+func (wh *WorkflowHandler) validateScheduleAndEmitMetrics(
+	domain string,
+	scheduleID string,
+	scope metrics.Scope,
+) error {
+	if domain == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+	if len(domain) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errDomainTooLong, scope)
+	}
+	if scheduleID == "" {
+		return wh.error(errScheduleIDNotSet, scope)
+	}
+	if len(scheduleID) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errScheduleIDTooLong, scope)
+	}
+	// schedules can only be managed for domains that exist
+	if _, err := wh.domainCache.GetDomainID(domain); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
 func validateExecution(w *gen.WorkflowExecution) error {
 	if w == nil {
 		return errExecutionNotSet
@@ -3307,6 +3681,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 			nil,
 			matchingResp.DecisionInfo,
 			branchToken,
+			true,
 		)
 		if err != nil {
 			return nil, err
@@ -3341,6 +3716,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		WorkflowExecutionTaskList: matchingResp.WorkflowExecutionTaskList,
 		ScheduledTimestamp:        matchingResp.ScheduledTimestamp,
 		StartedTimestamp:          matchingResp.StartedTimestamp,
+		Updates:                   matchingResp.Updates,
 	}
 
 	return resp, nil
@@ -3460,6 +3836,314 @@ func (wh *WorkflowHandler) allow(d domainGetter) bool {
 	return wh.rateLimiter.Allow(quotas.Info{Domain: domain})
 }
 
+// CreateSchedule creates a schedule which starts a workflow in the domain at the times matched by its spec.
+// The schedule is run by a system workflow of the worker service.
+func (wh *WorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	createRequest *gen.CreateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendCreateScheduleScope, createRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if createRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(createRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleAndEmitMetrics(createRequest.GetDomain(), createRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if len(createRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	if createRequest.Spec == nil {
+		return wh.error(errScheduleSpecNotSet, scope)
+	}
+
+	if createRequest.Action == nil {
+		return wh.error(errScheduleActionNotSet, scope)
+	}
+
+	if len(createRequest.Action.GetWorkflowIdPrefix()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errWorkflowIDTooLong, scope)
+	}
+
+	if len(createRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if err := scheduler.ValidateSchedule(createRequest.Spec, createRequest.Action, createRequest.Policies); err != nil {
+		return wh.error(err, scope)
+	}
+
+	err := wh.scheduleClient.CreateSchedule(ctx, createRequest)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// DescribeSchedule returns the spec, action and policies of a schedule along with the info about the actions it
+// has taken and the times of its next actions.
+func (wh *WorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	describeRequest *gen.DescribeScheduleRequest,
+) (resp *gen.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeScheduleScope, describeRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if describeRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(describeRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleAndEmitMetrics(describeRequest.GetDomain(), describeRequest.GetScheduleId(), scope); err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.scheduleClient.DescribeSchedule(ctx, describeRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
+// UpdateSchedule replaces the spec, action and policies of a schedule, the parts not set on the request are kept.
+func (wh *WorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	updateRequest *gen.UpdateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpdateScheduleScope, updateRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleAndEmitMetrics(updateRequest.GetDomain(), updateRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if len(updateRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	if len(updateRequest.GetAction().GetWorkflowIdPrefix()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errWorkflowIDTooLong, scope)
+	}
+
+	if err := scheduler.ValidateSchedule(updateRequest.Spec, updateRequest.Action, updateRequest.Policies); err != nil {
+		return wh.error(err, scope)
+	}
+
+	err := wh.scheduleClient.UpdateSchedule(ctx, updateRequest)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// PauseSchedule pauses or unpauses a schedule. The actions due while a schedule is paused are not taken.
+func (wh *WorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	pauseRequest *gen.PauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendPauseScheduleScope, pauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleAndEmitMetrics(pauseRequest.GetDomain(), pauseRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if len(pauseRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	err := wh.scheduleClient.PauseSchedule(ctx, pauseRequest)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// TriggerSchedule takes an action of a schedule immediately, even if the schedule is paused.
+func (wh *WorkflowHandler) TriggerSchedule(
+	ctx context.Context,
+	triggerRequest *gen.TriggerScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendTriggerScheduleScope, triggerRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if triggerRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(triggerRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleAndEmitMetrics(triggerRequest.GetDomain(), triggerRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if len(triggerRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	if triggerRequest.OverlapPolicy != nil {
+		if err := scheduler.ValidateSchedule(nil, nil, &gen.SchedulePolicies{OverlapPolicy: triggerRequest.OverlapPolicy}); err != nil {
+			return wh.error(err, scope)
+		}
+	}
+
+	err := wh.scheduleClient.TriggerSchedule(ctx, triggerRequest)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// BackfillSchedule takes the actions of a schedule for all the times matched by its spec within a time range.
+func (wh *WorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	backfillRequest *gen.BackfillScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendBackfillScheduleScope, backfillRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if backfillRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(backfillRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleAndEmitMetrics(backfillRequest.GetDomain(), backfillRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if len(backfillRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	if backfillRequest.GetEndTime() < backfillRequest.GetStartTime() {
+		return wh.error(errInvalidBackfillTimeRange, scope)
+	}
+
+	if backfillRequest.OverlapPolicy != nil {
+		if err := scheduler.ValidateSchedule(nil, nil, &gen.SchedulePolicies{OverlapPolicy: backfillRequest.OverlapPolicy}); err != nil {
+			return wh.error(err, scope)
+		}
+	}
+
+	err := wh.scheduleClient.BackfillSchedule(ctx, backfillRequest)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// DeleteSchedule deletes a schedule. Workflows already started by the schedule are not affected.
+func (wh *WorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	deleteRequest *gen.DeleteScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDeleteScheduleScope, deleteRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if deleteRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(deleteRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleAndEmitMetrics(deleteRequest.GetDomain(), deleteRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if len(deleteRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errIdentityTooLong, scope)
+	}
+
+	err := wh.scheduleClient.DeleteSchedule(ctx, deleteRequest)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // GetReplicationMessages returns new replication tasks since the read level provided in the token.
 func (wh *WorkflowHandler) GetReplicationMessages(
 	ctx context.Context,
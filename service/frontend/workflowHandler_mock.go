@@ -59,6 +59,20 @@ func (m *MockWorkflowHandler) EXPECT() *MockWorkflowHandlerMockRecorder {
 	return m.recorder
 }
 
+// BackfillSchedule mocks base method
+func (m *MockWorkflowHandler) BackfillSchedule(ctx context.Context, BackfillRequest *shared.BackfillScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillSchedule", ctx, BackfillRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillSchedule indicates an expected call of BackfillSchedule
+func (mr *MockWorkflowHandlerMockRecorder) BackfillSchedule(ctx, BackfillRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).BackfillSchedule), ctx, BackfillRequest)
+}
+
 // CountWorkflowExecutions mocks base method
 func (m *MockWorkflowHandler) CountWorkflowExecutions(ctx context.Context, CountRequest *shared.CountWorkflowExecutionsRequest) (*shared.CountWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -74,6 +88,48 @@ func (mr *MockWorkflowHandlerMockRecorder) CountWorkflowExecutions(ctx, CountReq
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutions", reflect.TypeOf((*MockWorkflowHandler)(nil).CountWorkflowExecutions), ctx, CountRequest)
 }
 
+// CreateSchedule mocks base method
+func (m *MockWorkflowHandler) CreateSchedule(ctx context.Context, CreateRequest *shared.CreateScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, CreateRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule
+func (mr *MockWorkflowHandlerMockRecorder) CreateSchedule(ctx, CreateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).CreateSchedule), ctx, CreateRequest)
+}
+
+// DeleteSchedule mocks base method
+func (m *MockWorkflowHandler) DeleteSchedule(ctx context.Context, DeleteRequest *shared.DeleteScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, DeleteRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule
+func (mr *MockWorkflowHandlerMockRecorder) DeleteSchedule(ctx, DeleteRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).DeleteSchedule), ctx, DeleteRequest)
+}
+
+// DeleteWorkflowExecution mocks base method
+func (m *MockWorkflowHandler) DeleteWorkflowExecution(ctx context.Context, DeleteRequest *shared.DeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", ctx, DeleteRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution
+func (mr *MockWorkflowHandlerMockRecorder) DeleteWorkflowExecution(ctx, DeleteRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).DeleteWorkflowExecution), ctx, DeleteRequest)
+}
+
 // DeprecateDomain mocks base method
 func (m *MockWorkflowHandler) DeprecateDomain(ctx context.Context, DeprecateRequest *shared.DeprecateDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -103,6 +159,21 @@ func (mr *MockWorkflowHandlerMockRecorder) DescribeDomain(ctx, DescribeRequest i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomain", reflect.TypeOf((*MockWorkflowHandler)(nil).DescribeDomain), ctx, DescribeRequest)
 }
 
+// DescribeSchedule mocks base method
+func (m *MockWorkflowHandler) DescribeSchedule(ctx context.Context, DescribeRequest *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", ctx, DescribeRequest)
+	ret0, _ := ret[0].(*shared.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule
+func (mr *MockWorkflowHandlerMockRecorder) DescribeSchedule(ctx, DescribeRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).DescribeSchedule), ctx, DescribeRequest)
+}
+
 // DescribeTaskList mocks base method
 func (m *MockWorkflowHandler) DescribeTaskList(ctx context.Context, Request *shared.DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +339,34 @@ func (mr *MockWorkflowHandlerMockRecorder) ListWorkflowExecutions(ctx, ListReque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutions", reflect.TypeOf((*MockWorkflowHandler)(nil).ListWorkflowExecutions), ctx, ListRequest)
 }
 
+// PauseSchedule mocks base method
+func (m *MockWorkflowHandler) PauseSchedule(ctx context.Context, PauseRequest *shared.PauseScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSchedule", ctx, PauseRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule
+func (mr *MockWorkflowHandlerMockRecorder) PauseSchedule(ctx, PauseRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).PauseSchedule), ctx, PauseRequest)
+}
+
+// PauseWorkflowExecution mocks base method
+func (m *MockWorkflowHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, PauseRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockWorkflowHandlerMockRecorder) PauseWorkflowExecution(ctx, PauseRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).PauseWorkflowExecution), ctx, PauseRequest)
+}
+
 // PollForActivityTask mocks base method
 func (m *MockWorkflowHandler) PollForActivityTask(ctx context.Context, PollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +700,34 @@ func (mr *MockWorkflowHandlerMockRecorder) TerminateWorkflowExecution(ctx, Termi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).TerminateWorkflowExecution), ctx, TerminateRequest)
 }
 
+// TriggerSchedule mocks base method
+func (m *MockWorkflowHandler) TriggerSchedule(ctx context.Context, TriggerRequest *shared.TriggerScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", ctx, TriggerRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule
+func (mr *MockWorkflowHandlerMockRecorder) TriggerSchedule(ctx, TriggerRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).TriggerSchedule), ctx, TriggerRequest)
+}
+
+// UnpauseWorkflowExecution mocks base method
+func (m *MockWorkflowHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *shared.UnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, UnpauseRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution
+func (mr *MockWorkflowHandlerMockRecorder) UnpauseWorkflowExecution(ctx, UnpauseRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).UnpauseWorkflowExecution), ctx, UnpauseRequest)
+}
+
 // UpdateDomain mocks base method
 func (m *MockWorkflowHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -616,6 +743,49 @@ func (mr *MockWorkflowHandlerMockRecorder) UpdateDomain(ctx, UpdateRequest inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockWorkflowHandler)(nil).UpdateDomain), ctx, UpdateRequest)
 }
 
+// UpdateSchedule mocks base method
+func (m *MockWorkflowHandler) UpdateSchedule(ctx context.Context, UpdateRequest *shared.UpdateScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, UpdateRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule
+func (mr *MockWorkflowHandlerMockRecorder) UpdateSchedule(ctx, UpdateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).UpdateSchedule), ctx, UpdateRequest)
+}
+
+// UpdateWorkflowExecution mocks base method
+func (m *MockWorkflowHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", ctx, UpdateRequest)
+	ret0, _ := ret[0].(*shared.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockWorkflowHandlerMockRecorder) UpdateWorkflowExecution(ctx, UpdateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).UpdateWorkflowExecution), ctx, UpdateRequest)
+}
+
+// UpsertWorkflowExecutionAttributes mocks base method
+func (m *MockWorkflowHandler) UpsertWorkflowExecutionAttributes(ctx context.Context, UpsertRequest *shared.UpsertWorkflowExecutionAttributesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionAttributes", ctx, UpsertRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowExecutionAttributes indicates an expected call of UpsertWorkflowExecutionAttributes
+func (mr *MockWorkflowHandlerMockRecorder) UpsertWorkflowExecutionAttributes(ctx, UpsertRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionAttributes", reflect.TypeOf((*MockWorkflowHandler)(nil).UpsertWorkflowExecutionAttributes), ctx, UpsertRequest)
+}
+
 // UpdateDomain mocks base method
 func (m *MockWorkflowHandler) ReapplyEvents(ctx context.Context, ReapplyEventsRequest *shared.ReapplyEventsRequest) error {
 	m.ctrl.T.Helper()
@@ -489,6 +489,133 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	assert.Equal(s.T(), errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidDelayStartSeconds() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		DelayStartSeconds:                   common.Int32Ptr(-1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidDelayStartSeconds, err)
+}
+
+func (s *workflowHandlerSuite) TestResetWorkflowExecution_Failed_ResetTypeWithDecisionFinishEventID() {
+	wh := s.getWorkflowHandlerHelper()
+
+	_, err := wh.ResetWorkflowExecution(context.Background(), &shared.ResetWorkflowExecutionRequest{
+		Domain: common.StringPtr("test-domain"),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow-id"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		DecisionFinishEventId: common.Int64Ptr(4),
+		ResetType:             shared.ResetTypeLastDecisionCompleted.Ptr(),
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errResetTypeWithDecisionFinishEventID, err)
+}
+
+func (s *workflowHandlerSuite) TestResetWorkflowExecution_Failed_ResetBinaryChecksumNotSet() {
+	wh := s.getWorkflowHandlerHelper()
+
+	_, err := wh.ResetWorkflowExecution(context.Background(), &shared.ResetWorkflowExecutionRequest{
+		Domain: common.StringPtr("test-domain"),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow-id"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		ResetType: shared.ResetTypeBadBinary.Ptr(),
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errResetBinaryChecksumNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution_Failed_DomainNotSet() {
+	wh := s.getWorkflowHandlerHelper()
+
+	err := wh.DeleteWorkflowExecution(context.Background(), &shared.DeleteWorkflowExecutionRequest{
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow-id"),
+		},
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errDomainNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution_Failed_WorkflowIDNotSet() {
+	wh := s.getWorkflowHandlerHelper()
+
+	err := wh.DeleteWorkflowExecution(context.Background(), &shared.DeleteWorkflowExecutionRequest{
+		Domain:            common.StringPtr("test-domain"),
+		WorkflowExecution: &shared.WorkflowExecution{},
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errWorkflowIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_ScheduleIDNotSet() {
+	wh := s.getWorkflowHandlerHelper()
+
+	err := wh.CreateSchedule(context.Background(), &shared.CreateScheduleRequest{
+		Domain: common.StringPtr("test-domain"),
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errScheduleIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_InvalidSpec() {
+	wh := s.getWorkflowHandlerHelper()
+	s.mockDomainCache.On("GetDomainID", "test-domain").Return(uuid.New(), nil)
+
+	err := wh.CreateSchedule(context.Background(), &shared.CreateScheduleRequest{
+		Domain:     common.StringPtr("test-domain"),
+		ScheduleId: common.StringPtr("schedule-id"),
+		Spec: &shared.ScheduleSpec{
+			CronExpression: common.StringPtr("0 9 * * *"),
+			Timezone:       common.StringPtr("invalid-timezone"),
+		},
+		Action: &shared.ScheduleAction{
+			WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflow-type")},
+			TaskList:                            &shared.TaskList{Name: common.StringPtr("task-list")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		},
+	})
+	assert.Error(s.T(), err)
+	_, ok := err.(*shared.BadRequestError)
+	assert.True(s.T(), ok)
+}
+
+func (s *workflowHandlerSuite) TestBackfillSchedule_Failed_InvalidTimeRange() {
+	wh := s.getWorkflowHandlerHelper()
+	s.mockDomainCache.On("GetDomainID", "test-domain").Return(uuid.New(), nil)
+
+	err := wh.BackfillSchedule(context.Background(), &shared.BackfillScheduleRequest{
+		Domain:     common.StringPtr("test-domain"),
+		ScheduleId: common.StringPtr("schedule-id"),
+		StartTime:  common.Int64Ptr(time.Now().UnixNano()),
+		EndTime:    common.Int64Ptr(time.Now().Add(-time.Hour).UnixNano()),
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidBackfillTimeRange, err)
+}
+
 func (s *workflowHandlerSuite) getWorkflowHandlerWithParams(mService cs.Service, config *Config,
 	mMetadataManager persistence.MetadataManager, mockDomainCache *cache.DomainCacheMock) *WorkflowHandler {
 	return NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryV2Mgr,
@@ -1178,7 +1305,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, nil)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	scope := wh.metricsClient.Scope(0)
-	history, token, err := wh.getHistory(scope, domainID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
+	history, token, err := wh.getHistory(scope, domainID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken, false)
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
 	s.NoError(err)
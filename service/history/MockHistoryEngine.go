@@ -93,6 +93,29 @@ func (_m *MockHistoryEngine) DescribeMutableState(ctx context.Context, request *
 	return r0, r1
 }
 
+// UpdateWorkflowExecution is mock implementation for UpdateWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) UpdateWorkflowExecution(ctx context.Context, request *gohistory.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // QueryWorkflow is mock implementation for QueryWorkflow of HistoryEngine
 func (_m *MockHistoryEngine) QueryWorkflow(ctx context.Context, request *gohistory.QueryWorkflowRequest) (*gohistory.QueryWorkflowResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -436,6 +459,62 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(ctx context.Context, req
 	return r0
 }
 
+// PauseWorkflowExecution is mock implementation for PauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) PauseWorkflowExecution(ctx context.Context, request *gohistory.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpauseWorkflowExecution is mock implementation for UnpauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) UnpauseWorkflowExecution(ctx context.Context, request *gohistory.UnpauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.UnpauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWorkflowExecution is mock implementation for DeleteWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DeleteWorkflowExecution(ctx context.Context, request *gohistory.DeleteWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertWorkflowExecutionAttributes is mock implementation for UpsertWorkflowExecutionAttributes of HistoryEngine
+func (_m *MockHistoryEngine) UpsertWorkflowExecutionAttributes(ctx context.Context, request *gohistory.UpsertWorkflowExecutionAttributesRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.UpsertWorkflowExecutionAttributesRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) ResetWorkflowExecution(ctx context.Context, request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(request)
@@ -613,3 +692,77 @@ func (_m *MockHistoryEngine) ReapplyEvents(ctx context.Context, domainUUID strin
 
 	return r0
 }
+
+// RefreshWorkflowTasks is mock implementation for RefreshWorkflowTasks of HistoryEngine
+func (_m *MockHistoryEngine) RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution shared.WorkflowExecution) error {
+	ret := _m.Called(domainUUID, execution)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, shared.WorkflowExecution) error); ok {
+		r0 = rf(domainUUID, execution)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadTaskDLQ is mock implementation for ReadTaskDLQ of HistoryEngine
+func (_m *MockHistoryEngine) ReadTaskDLQ(ctx context.Context, request *shared.ReadTaskDLQRequest) (*shared.ReadTaskDLQResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ReadTaskDLQResponse
+	if rf, ok := ret.Get(0).(func(*shared.ReadTaskDLQRequest) *shared.ReadTaskDLQResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReadTaskDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ReadTaskDLQRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplayTaskDLQ is mock implementation for ReplayTaskDLQ of HistoryEngine
+func (_m *MockHistoryEngine) ReplayTaskDLQ(ctx context.Context, request *shared.ReplayTaskDLQRequest) (*shared.ReplayTaskDLQResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ReplayTaskDLQResponse
+	if rf, ok := ret.Get(0).(func(*shared.ReplayTaskDLQRequest) *shared.ReplayTaskDLQResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReplayTaskDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ReplayTaskDLQRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeTaskDLQ is mock implementation for PurgeTaskDLQ of HistoryEngine
+func (_m *MockHistoryEngine) PurgeTaskDLQ(ctx context.Context, request *shared.PurgeTaskDLQRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.PurgeTaskDLQRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
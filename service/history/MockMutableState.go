@@ -1026,6 +1026,29 @@ func (_m *mockMutableState) AddUpsertWorkflowSearchAttributesEvent(_a0 int64, _a
 	return r0, r1
 }
 
+// AddUpsertWorkflowMemoEvent provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) AddUpsertWorkflowMemoEvent(_a0 int64, _a1 *shared.UpsertWorkflowMemoDecisionAttributes) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, *shared.UpsertWorkflowMemoDecisionAttributes) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *shared.UpsertWorkflowMemoDecisionAttributes) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddWorkflowExecutionCancelRequestedEvent provides a mock function with given fields: _a0, _a1
 func (_m *mockMutableState) AddWorkflowExecutionCancelRequestedEvent(_a0 string, _a1 *h.RequestCancelWorkflowExecutionRequest) (*shared.HistoryEvent, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1072,6 +1095,52 @@ func (_m *mockMutableState) AddWorkflowExecutionCanceledEvent(_a0 int64, _a1 *sh
 	return r0, r1
 }
 
+// AddWorkflowExecutionPausedEvent provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) AddWorkflowExecutionPausedEvent(_a0 string, _a1 string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionAttributesUpsertedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) AddWorkflowExecutionAttributesUpsertedEvent(_a0 *shared.UpsertWorkflowExecutionAttributesRequest) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(*shared.UpsertWorkflowExecutionAttributesRequest) *shared.HistoryEvent); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.UpsertWorkflowExecutionAttributesRequest) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddWorkflowExecutionSignaled provides a mock function with given fields: signalName, input, identity
 func (_m *mockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*shared.HistoryEvent, error) {
 	ret := _m.Called(signalName, input, identity)
@@ -1118,6 +1187,29 @@ func (_m *mockMutableState) AddWorkflowExecutionStartedEvent(_a0 shared.Workflow
 	return r0, r1
 }
 
+// AddWorkflowExecutionTerminatedByNewRunEvent provides a mock function with given fields: reason, identity, newRunID
+func (_m *mockMutableState) AddWorkflowExecutionTerminatedByNewRunEvent(reason string, identity string, newRunID string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(reason, identity, newRunID)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string, string) *shared.HistoryEvent); ok {
+		r0 = rf(reason, identity, newRunID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(reason, identity, newRunID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddWorkflowExecutionTerminatedEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *mockMutableState) AddWorkflowExecutionTerminatedEvent(_a0 string, _a1 []byte, _a2 string) (*shared.HistoryEvent, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -1141,6 +1233,75 @@ func (_m *mockMutableState) AddWorkflowExecutionTerminatedEvent(_a0 string, _a1
 	return r0, r1
 }
 
+// AddWorkflowExecutionUnpausedEvent provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) AddWorkflowExecutionUnpausedEvent(_a0 string, _a1 string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *mockMutableState) AddWorkflowExecutionUpdateAcceptedEvent(_a0 int64, _a1 string, _a2 string, _a3 *shared.WorkflowUpdate) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, string, string, *shared.WorkflowUpdate) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, string, string, *shared.WorkflowUpdate) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *mockMutableState) AddWorkflowExecutionUpdateCompletedEvent(_a0 int64, _a1 int64, _a2 *shared.WorkflowUpdateResult) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, int64, *shared.WorkflowUpdateResult) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, *shared.WorkflowUpdateResult) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckResettable provides a mock function with given fields:
 func (_m *mockMutableState) CheckResettable() error {
 	ret := _m.Called()
@@ -2667,6 +2828,11 @@ func (_m *mockMutableState) ReplicateUpsertWorkflowSearchAttributesEvent(_a0 *sh
 	_m.Called(_a0)
 }
 
+// ReplicateUpsertWorkflowMemoEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateUpsertWorkflowMemoEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
 // ReplicateWorkflowExecutionCancelRequestedEvent provides a mock function with given fields: _a0
 func (_m *mockMutableState) ReplicateWorkflowExecutionCancelRequestedEvent(_a0 *shared.HistoryEvent) error {
 	ret := _m.Called(_a0)
@@ -2737,6 +2903,25 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionFailedEvent(_a0 int64, _a1
 	return r0
 }
 
+// ReplicateWorkflowExecutionPausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionPausedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionAttributesUpsertedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionAttributesUpsertedEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
 // ReplicateWorkflowExecutionSignaled provides a mock function with given fields: _a0
 func (_m *mockMutableState) ReplicateWorkflowExecutionSignaled(_a0 *shared.HistoryEvent) error {
 	ret := _m.Called(_a0)
@@ -2793,6 +2978,20 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionTimedoutEvent(_a0 int64, _
 	return r0
 }
 
+// ReplicateWorkflowExecutionUnpausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionUnpausedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetCurrentBranchToken provides a mock function with given fields: _a0
 func (_m *mockMutableState) SetCurrentBranchToken(_a0 []byte) error {
 	ret := _m.Called(_a0)
@@ -59,3 +59,17 @@ func (_m *MockTransferQueueProcessor) LockTaskPrrocessing() {
 func (_m *MockTransferQueueProcessor) UnlockTaskPrrocessing() {
 	_m.Called()
 }
+
+// ReplayTaskFromDLQ is mock implementation for ReplayTaskFromDLQ of Processor
+func (_m *MockTransferQueueProcessor) ReplayTaskFromDLQ(task *persistence.TransferTaskInfo) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.TransferTaskInfo) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -101,6 +101,21 @@ func (_m *mockWorkflowExecutionContext) getLogger() log.Logger {
 	return r0
 }
 
+func (_m *mockWorkflowExecutionContext) getUpdateRegistry() updateRegistry {
+	ret := _m.Called()
+
+	var r0 updateRegistry
+	if rf, ok := ret.Get(0).(func() updateRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(updateRegistry)
+		}
+	}
+
+	return r0
+}
+
 func (_m *mockWorkflowExecutionContext) lock(_a0 context.Context) error {
 	ret := _m.Called(_a0)
 
@@ -0,0 +1,150 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+// The estimates below only account for the variable sized payloads held in memory plus a
+// fixed overhead per object. They are meant to be cheap enough to be computed on every cache
+// access and precise enough to keep a few huge workflows from blowing up the history host.
+const (
+	historyEventBaseSize             = 256
+	workflowExecutionContextBaseSize = 1024
+	mutableStateBaseSize             = 2048
+	pendingInfoBaseSize              = 256
+)
+
+// estimateHistoryEventSize returns the estimated in memory size of a history event in bytes
+func estimateHistoryEventSize(event *workflow.HistoryEvent) int {
+	if event == nil {
+		return 0
+	}
+
+	size := historyEventBaseSize
+	switch event.GetEventType() {
+	case workflow.EventTypeWorkflowExecutionStarted:
+		attr := event.WorkflowExecutionStartedEventAttributes
+		size += len(attr.GetInput()) + len(attr.GetContinuedFailureDetails()) + len(attr.GetLastCompletionResult()) +
+			payloadsSize(attr.GetMemo().GetFields()) +
+			payloadsSize(attr.GetSearchAttributes().GetIndexedFields()) +
+			payloadsSize(attr.GetHeader().GetFields())
+	case workflow.EventTypeWorkflowExecutionCompleted:
+		size += len(event.WorkflowExecutionCompletedEventAttributes.GetResult())
+	case workflow.EventTypeWorkflowExecutionFailed:
+		size += len(event.WorkflowExecutionFailedEventAttributes.GetDetails())
+	case workflow.EventTypeWorkflowExecutionCanceled:
+		size += len(event.WorkflowExecutionCanceledEventAttributes.GetDetails())
+	case workflow.EventTypeWorkflowExecutionTerminated:
+		size += len(event.WorkflowExecutionTerminatedEventAttributes.GetDetails())
+	case workflow.EventTypeWorkflowExecutionContinuedAsNew:
+		attr := event.WorkflowExecutionContinuedAsNewEventAttributes
+		size += len(attr.GetInput()) + len(attr.GetFailureDetails()) + len(attr.GetLastCompletionResult()) +
+			payloadsSize(attr.GetMemo().GetFields()) +
+			payloadsSize(attr.GetSearchAttributes().GetIndexedFields()) +
+			payloadsSize(attr.GetHeader().GetFields())
+	case workflow.EventTypeWorkflowExecutionSignaled:
+		size += len(event.WorkflowExecutionSignaledEventAttributes.GetInput())
+	case workflow.EventTypeDecisionTaskCompleted:
+		size += len(event.DecisionTaskCompletedEventAttributes.GetExecutionContext())
+	case workflow.EventTypeActivityTaskScheduled:
+		attr := event.ActivityTaskScheduledEventAttributes
+		size += len(attr.GetInput()) + payloadsSize(attr.GetHeader().GetFields())
+	case workflow.EventTypeActivityTaskCompleted:
+		size += len(event.ActivityTaskCompletedEventAttributes.GetResult())
+	case workflow.EventTypeActivityTaskFailed:
+		size += len(event.ActivityTaskFailedEventAttributes.GetDetails())
+	case workflow.EventTypeActivityTaskTimedOut:
+		size += len(event.ActivityTaskTimedOutEventAttributes.GetDetails())
+	case workflow.EventTypeActivityTaskCanceled:
+		size += len(event.ActivityTaskCanceledEventAttributes.GetDetails())
+	case workflow.EventTypeMarkerRecorded:
+		attr := event.MarkerRecordedEventAttributes
+		size += len(attr.GetDetails()) + payloadsSize(attr.GetHeader().GetFields())
+	case workflow.EventTypeStartChildWorkflowExecutionInitiated:
+		attr := event.StartChildWorkflowExecutionInitiatedEventAttributes
+		size += len(attr.GetInput()) + len(attr.GetControl()) +
+			payloadsSize(attr.GetMemo().GetFields()) +
+			payloadsSize(attr.GetSearchAttributes().GetIndexedFields()) +
+			payloadsSize(attr.GetHeader().GetFields())
+	case workflow.EventTypeChildWorkflowExecutionCompleted:
+		size += len(event.ChildWorkflowExecutionCompletedEventAttributes.GetResult())
+	case workflow.EventTypeChildWorkflowExecutionFailed:
+		size += len(event.ChildWorkflowExecutionFailedEventAttributes.GetDetails())
+	case workflow.EventTypeSignalExternalWorkflowExecutionInitiated:
+		attr := event.SignalExternalWorkflowExecutionInitiatedEventAttributes
+		size += len(attr.GetInput()) + len(attr.GetControl())
+	case workflow.EventTypeUpsertWorkflowSearchAttributes:
+		size += payloadsSize(event.UpsertWorkflowSearchAttributesEventAttributes.GetSearchAttributes().GetIndexedFields())
+	case workflow.EventTypeUpsertWorkflowMemo:
+		size += payloadsSize(event.UpsertWorkflowMemoEventAttributes.GetMemo().GetFields())
+	case workflow.EventTypeWorkflowExecutionAttributesUpserted:
+		attr := event.WorkflowExecutionAttributesUpsertedEventAttributes
+		size += payloadsSize(attr.GetSearchAttributes().GetIndexedFields()) + payloadsSize(attr.GetMemo().GetFields())
+	}
+	return size
+}
+
+// estimateMutableStateSize returns the estimated in memory size of a mutable state in bytes
+func estimateMutableStateSize(msBuilder *mutableStateBuilder) int {
+	if msBuilder == nil {
+		return 0
+	}
+
+	size := mutableStateBaseSize
+	if executionInfo := msBuilder.executionInfo; executionInfo != nil {
+		size += len(executionInfo.ExecutionContext) + len(executionInfo.BranchToken) +
+			payloadsSize(executionInfo.Memo) + payloadsSize(executionInfo.SearchAttributes) +
+			estimateHistoryEventSize(executionInfo.CompletionEvent)
+	}
+	for _, ai := range msBuilder.pendingActivityInfoIDs {
+		size += pendingInfoBaseSize + len(ai.Details) + len(ai.LastFailureDetails) +
+			estimateHistoryEventSize(ai.ScheduledEvent) + estimateHistoryEventSize(ai.StartedEvent)
+	}
+	size += len(msBuilder.pendingTimerInfoIDs) * pendingInfoBaseSize
+	for _, ci := range msBuilder.pendingChildExecutionInfoIDs {
+		size += pendingInfoBaseSize +
+			estimateHistoryEventSize(ci.InitiatedEvent) + estimateHistoryEventSize(ci.StartedEvent)
+	}
+	size += len(msBuilder.pendingRequestCancelInfoIDs) * pendingInfoBaseSize
+	for _, si := range msBuilder.pendingSignalInfoIDs {
+		size += pendingInfoBaseSize + len(si.Input) + len(si.Control)
+	}
+	for requestID := range msBuilder.pendingSignalRequestedIDs {
+		size += len(requestID)
+	}
+	for _, event := range msBuilder.bufferedEvents {
+		size += estimateHistoryEventSize(event)
+	}
+	for _, event := range msBuilder.updateBufferedEvents {
+		size += estimateHistoryEventSize(event)
+	}
+	return size
+}
+
+func payloadsSize(fields map[string][]byte) int {
+	size := 0
+	for key, value := range fields {
+		size += len(key) + len(value)
+	}
+	return size
+}
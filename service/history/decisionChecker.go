@@ -482,6 +482,25 @@ func (v *decisionAttrValidator) validateUpsertWorkflowSearchAttributes(
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
+func (v *decisionAttrValidator) validateUpsertWorkflowMemo(
+	attributes *workflow.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "UpsertWorkflowMemoDecisionAttributes is not set on decision."}
+	}
+
+	if !attributes.IsSetMemo() {
+		return &workflow.BadRequestError{Message: "Memo is not set on decision."}
+	}
+
+	if len(attributes.GetMemo().GetFields()) == 0 {
+		return &workflow.BadRequestError{Message: "Fields is empty on decision."}
+	}
+
+	return nil
+}
+
 func (v *decisionAttrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes,
 	executionInfo *persistence.WorkflowExecutionInfo,
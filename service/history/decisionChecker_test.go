@@ -146,6 +146,25 @@ func (s *decisionAttrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes(
 	s.Nil(err)
 }
 
+func (s *decisionAttrValidatorSuite) TestValidateUpsertWorkflowMemo() {
+	var attributes *workflow.UpsertWorkflowMemoDecisionAttributes
+
+	err := s.validator.validateUpsertWorkflowMemo(attributes)
+	s.EqualError(err, "BadRequestError{Message: UpsertWorkflowMemoDecisionAttributes is not set on decision.}")
+
+	attributes = &workflow.UpsertWorkflowMemoDecisionAttributes{}
+	err = s.validator.validateUpsertWorkflowMemo(attributes)
+	s.EqualError(err, "BadRequestError{Message: Memo is not set on decision.}")
+
+	attributes.Memo = &workflow.Memo{}
+	err = s.validator.validateUpsertWorkflowMemo(attributes)
+	s.EqualError(err, "BadRequestError{Message: Fields is empty on decision.}")
+
+	attributes.Memo.Fields = map[string][]byte{"progress": []byte(`50`)}
+	err = s.validator.validateUpsertWorkflowMemo(attributes)
+	s.Nil(err)
+}
+
 func (s *decisionAttrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: s.testDomainID},
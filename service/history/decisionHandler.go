@@ -152,12 +152,23 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 	scheduleID := req.GetScheduleId()
 	requestID := req.GetRequestId()
 
+	// the update registry lives on the workflow context, grab it before the action takes the lock
+	context, release, err := handler.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	updateRegistry := context.getUpdateRegistry()
+	release(nil)
+
 	var resp *h.RecordDecisionTaskStartedResponse
 	err = handler.historyEngine.updateWorkflowExecutionWithAction(ctx, domainID, execution,
 		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
 			}
+			if msBuilder.GetExecutionInfo().Paused {
+				return nil, ErrWorkflowPaused
+			}
 
 			decision, isRunning := msBuilder.GetDecisionInfo(scheduleID)
 
@@ -187,6 +198,7 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 					if err != nil {
 						return nil, err
 					}
+					resp.Updates = updateRegistry.startUpdates()
 					updateAction.noop = true
 					return updateAction, nil
 				}
@@ -206,6 +218,7 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 			if err != nil {
 				return nil, err
 			}
+			resp.Updates = updateRegistry.startUpdates()
 			return updateAction, nil
 		})
 
@@ -287,6 +300,7 @@ func (handler *decisionHandlerImpl) handleDecisionTaskCompleted(
 		return nil, err
 	}
 	defer func() { release(retError) }()
+	updateRegistry := context.getUpdateRegistry()
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
@@ -358,6 +372,14 @@ Update_History_Loop:
 			}
 		}
 
+		var updateResults []*workflow.WorkflowUpdateResult
+		if !decisionHeartbeatTimeout {
+			updateResults, err = handler.handleUpdateResults(msBuilder, updateRegistry, completedEvent.GetEventId(), request)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		var (
 			failDecision                bool
 			failCause                   workflow.DecisionTaskFailedCause
@@ -454,11 +476,19 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			// the update events were dropped together with the rest of the decision
+			updateResults = nil
+		}
+
+		answeredUpdates := make(map[string]struct{}, len(updateResults))
+		for _, result := range updateResults {
+			answeredUpdates[result.GetUpdateId()] = struct{}{}
 		}
+		hasPendingUpdates := updateRegistry.hasUnanswered(answeredUpdates)
 
 		// Schedule another decision task if new events came in during this decision or if request forced to
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() &&
-			(hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+			(hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled || hasPendingUpdates)
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			var newDecision *decisionInfo
@@ -548,6 +578,17 @@ Update_History_Loop:
 			return nil, updateErr
 		}
 
+		for _, result := range updateResults {
+			if err := updateRegistry.recordResult(result.GetUpdateId(), result); err != nil {
+				handler.logger.Warn("Failed to record update result.", tag.Error(err),
+					tag.WorkflowID(token.WorkflowID),
+					tag.WorkflowRunID(token.RunID),
+					tag.WorkflowDomainID(domainID))
+			}
+		}
+		// updates the worker did not answer are delivered again with the next decision task
+		updateRegistry.rebufferUpdates()
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, &workflow.EntityNotExistsError{
@@ -564,6 +605,7 @@ Update_History_Loop:
 			}
 			// sticky is always enabled when worker request for new decision task from RespondDecisionTaskCompleted
 			resp.StartedResponse.StickyExecutionEnabled = common.BoolPtr(true)
+			resp.StartedResponse.Updates = updateRegistry.startUpdates()
 		}
 
 		return resp, nil
@@ -572,6 +614,52 @@ Update_History_Loop:
 	return nil, ErrMaxAttemptsExceeded
 }
 
+// handleUpdateResults records the history events of the updates completed by the decision and
+// returns the results which must be reported back to the callers once the decision is persisted,
+// rejected updates are answered without touching the history
+func (handler *decisionHandlerImpl) handleUpdateResults(
+	msBuilder mutableState,
+	updateRegistry updateRegistry,
+	decisionCompletedEventID int64,
+	request *workflow.RespondDecisionTaskCompletedRequest,
+) ([]*workflow.WorkflowUpdateResult, error) {
+
+	var results []*workflow.WorkflowUpdateResult
+	for _, result := range request.UpdateResults {
+		update, requestID, err := updateRegistry.getStartedUpdate(result.GetUpdateId())
+		if err != nil {
+			// the update was not dispatched to this decision or it has been answered already
+			handler.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.UnknownUpdateResultCounter)
+			continue
+		}
+
+		switch result.GetResultType() {
+		case workflow.WorkflowUpdateResultTypeCompleted:
+			acceptedEvent, err := msBuilder.AddWorkflowExecutionUpdateAcceptedEvent(
+				decisionCompletedEventID,
+				requestID,
+				request.GetIdentity(),
+				update,
+			)
+			if err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to add WorkflowExecutionUpdateAccepted event to history."}
+			}
+			if _, err := msBuilder.AddWorkflowExecutionUpdateCompletedEvent(
+				decisionCompletedEventID,
+				acceptedEvent.GetEventId(),
+				result,
+			); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to add WorkflowExecutionUpdateCompleted event to history."}
+			}
+		case workflow.WorkflowUpdateResultTypeRejected:
+		default:
+			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown update result type: %v.", result.GetResultType())}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 	domainID string,
 	msBuilder mutableState,
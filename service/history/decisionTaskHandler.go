@@ -173,6 +173,9 @@ func (handler *decisionTaskHandlerImpl) handleDecision(decision *workflow.Decisi
 	case workflow.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case workflow.DecisionTypeUpsertWorkflowMemo:
+		return handler.handleDecisionUpsertWorkflowMemo(decision.UpsertWorkflowMemoDecisionAttributes)
+
 	default:
 		return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -879,6 +882,41 @@ func (handler *decisionTaskHandlerImpl) handleDecisionUpsertWorkflowSearchAttrib
 	return err
 }
 
+func (handler *decisionTaskHandlerImpl) handleDecisionUpsertWorkflowMemo(
+	attr *workflow.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeUpsertWorkflowMemoCounter,
+	)
+
+	// valid memo for upsert
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateUpsertWorkflowMemo(attr)
+		},
+		workflow.DecisionTaskFailedCauseBadMemo,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	// blob size limit check
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		convertSearchAttributesToByteArray(attr.GetMemo().GetFields()),
+		"UpsertWorkflowMemoDecisionAttributes exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	_, err = handler.mutableState.AddUpsertWorkflowMemoEvent(
+		handler.decisionTaskCompletedID, attr,
+	)
+	return err
+}
+
 func convertSearchAttributesToByteArray(fields map[string][]byte) []byte {
 	result := make([]byte, 0)
 
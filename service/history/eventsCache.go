@@ -42,11 +42,12 @@ type (
 
 	eventsCacheImpl struct {
 		cache.Cache
-		eventsV2Mgr   persistence.HistoryV2Manager
-		disabled      bool
-		logger        log.Logger
-		metricsClient metrics.Client
-		shardID       *int
+		eventsV2Mgr      persistence.HistoryV2Manager
+		disabled         bool
+		logger           log.Logger
+		metricsClient    metrics.Client
+		sizeMetricsScope metrics.Scope
+		shardID          *int
 	}
 
 	eventKey struct {
@@ -66,26 +67,40 @@ var _ eventsCache = (*eventsCacheImpl)(nil)
 func newEventsCache(shardCtx ShardContext) eventsCache {
 	config := shardCtx.GetConfig()
 	shardID := common.IntPtr(shardCtx.GetShardID())
-	return newEventsCacheWithOptions(config.EventsCacheInitialSize(), config.EventsCacheMaxSize(), config.EventsCacheTTL(),
-		shardCtx.GetHistoryV2Manager(), false, shardCtx.GetLogger(), shardCtx.GetMetricsClient(), shardID)
+	return newEventsCacheWithOptions(config.EventsCacheInitialSize(), config.EventsCacheMaxSize(), config.EventsCacheMaxSizeInBytes(),
+		config.EventsCacheTTL(), shardCtx.GetHistoryV2Manager(), false, shardCtx.GetLogger(), shardCtx.GetMetricsClient(), shardID)
 }
 
-func newEventsCacheWithOptions(initialSize, maxSize int, ttl time.Duration,
-	eventsV2Mgr persistence.HistoryV2Manager, disabled bool, logger log.Logger, metrics metrics.Client, shardID *int) *eventsCacheImpl {
+func newEventsCacheWithOptions(initialSize, maxSize, maxBytes int, ttl time.Duration,
+	eventsV2Mgr persistence.HistoryV2Manager, disabled bool, logger log.Logger, metricsClient metrics.Client, shardID *int) *eventsCacheImpl {
 	opts := &cache.Options{}
 	opts.InitialCapacity = initialSize
 	opts.TTL = ttl
+	opts.MaxBytes = maxBytes
+	opts.SizeFunc = historyEventSize
+	// the cache exists per shard, so its size is reported per shard
+	sizeMetricsScope := metricsClient.Scope(metrics.EventsCacheScope)
+	if shardID != nil {
+		sizeMetricsScope = sizeMetricsScope.Tagged(metrics.ShardTag(*shardID))
+	}
+	opts.EvictedFunc = newCacheEvictedFunc(sizeMetricsScope)
 
 	return &eventsCacheImpl{
-		Cache:         cache.New(maxSize, opts),
-		eventsV2Mgr:   eventsV2Mgr,
-		disabled:      disabled,
-		logger:        logger.WithTags(tag.ComponentEventsCache),
-		metricsClient: metrics,
-		shardID:       shardID,
+		Cache:            cache.New(maxSize, opts),
+		eventsV2Mgr:      eventsV2Mgr,
+		disabled:         disabled,
+		logger:           logger.WithTags(tag.ComponentEventsCache),
+		metricsClient:    metricsClient,
+		sizeMetricsScope: sizeMetricsScope,
+		shardID:          shardID,
 	}
 }
 
+// historyEventSize is the cache.SizeFunc of the events cache
+func historyEventSize(value interface{}) int {
+	return estimateHistoryEventSize(value.(*shared.HistoryEvent))
+}
+
 func newEventKey(domainID, workflowID, runID string, eventID int64) eventKey {
 	return eventKey{
 		domainID:   domainID,
@@ -124,6 +139,7 @@ func (e *eventsCacheImpl) getEvent(domainID, workflowID, runID string, firstEven
 	}
 
 	e.Put(key, event)
+	e.sizeMetricsScope.UpdateGauge(metrics.CacheSizeInBytes, float64(e.SizeInBytes()))
 	return event, nil
 }
 
@@ -134,6 +150,7 @@ func (e *eventsCacheImpl) putEvent(domainID, workflowID, runID string, eventID i
 
 	key := newEventKey(domainID, workflowID, runID, eventID)
 	e.Put(key, event)
+	e.sizeMetricsScope.UpdateGauge(metrics.CacheSizeInBytes, float64(e.SizeInBytes()))
 }
 
 func (e *eventsCacheImpl) deleteEvent(domainID, workflowID, runID string, eventID int64) {
@@ -143,6 +160,7 @@ func (e *eventsCacheImpl) deleteEvent(domainID, workflowID, runID string, eventI
 
 	key := newEventKey(domainID, workflowID, runID, eventID)
 	e.Delete(key)
+	e.sizeMetricsScope.UpdateGauge(metrics.CacheSizeInBytes, float64(e.SizeInBytes()))
 }
 
 func (e *eventsCacheImpl) getHistoryEventFromStore(domainID, workflowID, runID string, firstEventID, eventID int64,
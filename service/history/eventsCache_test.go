@@ -77,7 +77,7 @@ func (s *eventsCacheSuite) TearDownTest() {
 }
 
 func (s *eventsCacheSuite) newTestEventsCache() *eventsCacheImpl {
-	return newEventsCacheWithOptions(16, 32, time.Minute, s.mockEventsV2Mgr, false, s.logger,
+	return newEventsCacheWithOptions(16, 32, 0, time.Minute, s.mockEventsV2Mgr, false, s.logger,
 		metrics.NewClient(tally.NoopScope, metrics.History), common.IntPtr(10))
 }
 
@@ -210,3 +210,55 @@ func (s *eventsCacheSuite) TestEventsCacheDisableSuccess() {
 	s.Nil(err)
 	s.Equal(event2, actualEvent)
 }
+
+func (s *eventsCacheSuite) TestEventsCacheMaxBytes() {
+	domainID := "events-cache-max-bytes-domain"
+	workflowID := "events-cache-max-bytes-workflow-id"
+	runID := "events-cache-max-bytes-run-id"
+	newEvent := func(eventID int64) *shared.HistoryEvent {
+		return &shared.HistoryEvent{
+			EventId:   common.Int64Ptr(eventID),
+			EventType: shared.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &shared.ActivityTaskCompletedEventAttributes{
+				Result: make([]byte, 1024),
+			},
+		}
+	}
+	eventSize := historyEventBaseSize + 1024
+	s.cache = newEventsCacheWithOptions(16, 32, 2*eventSize, time.Minute, s.mockEventsV2Mgr, false, s.logger,
+		metrics.NewClient(tally.NoopScope, metrics.History), common.IntPtr(10))
+
+	s.cache.putEvent(domainID, workflowID, runID, 1, newEvent(1))
+	s.cache.putEvent(domainID, workflowID, runID, 2, newEvent(2))
+	s.Equal(2*eventSize, s.cache.SizeInBytes())
+
+	// the least recently used event is evicted to fit the new one
+	s.cache.putEvent(domainID, workflowID, runID, 3, newEvent(3))
+	s.Equal(2*eventSize, s.cache.SizeInBytes())
+	s.Nil(s.cache.Get(newEventKey(domainID, workflowID, runID, 1)))
+	s.NotNil(s.cache.Get(newEventKey(domainID, workflowID, runID, 2)))
+	s.NotNil(s.cache.Get(newEventKey(domainID, workflowID, runID, 3)))
+}
+
+func (s *eventsCacheSuite) TestEventsCacheSizeGauge_ExpiredEvent() {
+	domainID := "events-cache-size-gauge-domain"
+	workflowID := "events-cache-size-gauge-workflow-id"
+	runID := "events-cache-size-gauge-run-id"
+	metricsScope := tally.NewTestScope("", nil)
+	s.cache = newEventsCacheWithOptions(16, 32, 0, time.Millisecond, s.mockEventsV2Mgr, false, s.logger,
+		metrics.NewClient(metricsScope, metrics.History), common.IntPtr(10))
+	gaugeKey := "cache_size_in_bytes+cache_type=events,operation=EventsCache,shard=10"
+
+	event := &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(1),
+		EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+	}
+	s.cache.putEvent(domainID, workflowID, runID, 1, event)
+	s.Equal(float64(s.cache.SizeInBytes()), metricsScope.Snapshot().Gauges()[gaugeKey].Value())
+	s.NotZero(s.cache.SizeInBytes())
+
+	// the gauge is refreshed when the expired event is evicted
+	time.Sleep(5 * time.Millisecond)
+	s.Nil(s.cache.Get(newEventKey(domainID, workflowID, runID, 1)))
+	s.Equal(float64(0), metricsScope.Snapshot().Gauges()[gaugeKey].Value())
+}
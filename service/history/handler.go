@@ -88,6 +88,7 @@ var (
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
+	errDLQCategoryNotSet       = &gen.BadRequestError{Message: "DLQ category not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
 )
 
@@ -952,6 +953,45 @@ func (h *Handler) SignalWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution delivers an update to a running workflow execution and waits until the worker
+// completes or rejects it.
+func (h *Handler) UpdateWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *hist.UpdateWorkflowExecutionRequest,
+) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.UpdateRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1072,6 +1112,162 @@ func (h *Handler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history, the tasks of the execution are held back until it is unpaused.
+func (h *Handler) PauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *hist.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+// in the history.
+func (h *Handler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *hist.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUnpauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.UnpauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// DeleteWorkflowExecution terminates the workflow execution if it is still running, then deletes its
+// mutable state, current execution, history and visibility records through a timer task.
+func (h *Handler) DeleteWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *hist.DeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.DeleteRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UpsertWorkflowExecutionAttributes merges search attributes and memo into a running workflow execution
+// by recording WorkflowExecutionAttributesUpserted event in the history.
+func (h *Handler) UpsertWorkflowExecutionAttributes(
+	ctx context.Context,
+	wrappedRequest *hist.UpsertWorkflowExecutionAttributesRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpsertWorkflowExecutionAttributesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.UpsertRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UpsertWorkflowExecutionAttributes(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *Handler) ResetWorkflowExecution(
@@ -1556,10 +1752,9 @@ func (h *Handler) ReapplyEvents(
 		return h.error(err, scope, domainID, workflowID)
 	}
 	// deserialize history event object
-	historyEvents, err := h.GetPayloadSerializer().DeserializeBatchEvents(&persistence.DataBlob{
-		Encoding: common.EncodingTypeThriftRW,
-		Data:     request.GetRequest().GetEvents().GetData(),
-	})
+	historyEvents, err := h.GetPayloadSerializer().DeserializeBatchEvents(
+		persistence.NewDataBlobFromThrift(request.GetRequest().GetEvents()),
+	)
 	if err != nil {
 		return h.error(err, scope, domainID, workflowID)
 	}
@@ -1574,6 +1769,145 @@ func (h *Handler) ReapplyEvents(
 	return nil
 }
 
+// RefreshWorkflowTasks regenerates the transfer and timer tasks of a workflow execution from its mutable state
+func (h *Handler) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *hist.RefreshWorkflowTasksRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRefreshWorkflowTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	err = engine.RefreshWorkflowTasks(
+		ctx,
+		domainID,
+		gen.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		},
+	)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+	return nil
+}
+
+// ReadTaskDLQ returns the transfer or timer tasks of a shard which were moved to DLQ
+func (h *Handler) ReadTaskDLQ(
+	ctx context.Context,
+	request *gen.ReadTaskDLQRequest,
+) (resp *gen.ReadTaskDLQResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReadTaskDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+	if request.Category == nil {
+		return nil, h.error(errDLQCategoryNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ReadTaskDLQ(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// ReplayTaskDLQ reprocesses the tasks stored in DLQ entries and removes the entries
+func (h *Handler) ReplayTaskDLQ(
+	ctx context.Context,
+	request *gen.ReplayTaskDLQRequest,
+) (resp *gen.ReplayTaskDLQResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReplayTaskDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+	if request.Category == nil {
+		return nil, h.error(errDLQCategoryNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ReplayTaskDLQ(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// PurgeTaskDLQ removes DLQ entries of a shard without processing them
+func (h *Handler) PurgeTaskDLQ(
+	ctx context.Context,
+	request *gen.PurgeTaskDLQRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPurgeTaskDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+	if request.Category == nil {
+		return h.error(errDLQCategoryNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.PurgeTaskDLQ(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -1595,6 +1929,10 @@ func (h *Handler) convertError(err error) error {
 	case *persistence.TransactionSizeLimitError:
 		err := err.(*persistence.TransactionSizeLimitError)
 		return &gen.BadRequestError{Message: err.Msg}
+	case *WorkflowPausedError:
+		// matching drops the task, it is dispatched again once the workflow execution is unpaused
+		err := err.(*WorkflowPausedError)
+		return &gen.EntityNotExistsError{Message: err.Msg}
 	}
 
 	return err
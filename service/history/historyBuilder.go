@@ -191,6 +191,39 @@ func (b *historyBuilder) AddWorkflowExecutionTerminatedEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionTerminatedByNewRunEvent(
+	reason string,
+	identity string,
+	newRunID string,
+) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionTerminatedEvent(reason, nil, identity)
+	event.WorkflowExecutionTerminatedEventAttributes.NewExecutionRunId = common.StringPtr(newRunID)
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionPausedEvent(reason, identity)
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUnpausedEvent(reason, identity)
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionAttributesUpsertedEvent(
+	request *workflow.UpsertWorkflowExecutionAttributesRequest,
+) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionAttributesUpsertedEvent(request)
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddContinuedAsNewEvent(decisionCompletedEventID int64, newRunID string,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes)
@@ -352,6 +385,33 @@ func (b *historyBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddUpsertWorkflowMemoEvent(
+	decisionTaskCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowMemoDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newUpsertWorkflowMemoEvent(decisionTaskCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionTaskCompletedEventID int64,
+	requestID string,
+	identity string,
+	update *workflow.WorkflowUpdate) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateAcceptedEvent(decisionTaskCompletedEventID, requestID, identity, update)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionTaskCompletedEventID int64,
+	acceptedEventID int64,
+	result *workflow.WorkflowUpdateResult) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateCompletedEvent(decisionTaskCompletedEventID, acceptedEventID, result)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
 	event := b.newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID,
@@ -697,6 +757,41 @@ func (b *historyBuilder) newWorkflowExecutionTerminatedEvent(
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionPausedEvent(
+	reason string, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionPaused)
+	attributes := &workflow.WorkflowExecutionPausedEventAttributes{}
+	attributes.Reason = common.StringPtr(reason)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionPausedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionUnpausedEvent(
+	reason string, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUnpaused)
+	attributes := &workflow.WorkflowExecutionUnpausedEventAttributes{}
+	attributes.Reason = common.StringPtr(reason)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionUnpausedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionAttributesUpsertedEvent(
+	request *workflow.UpsertWorkflowExecutionAttributesRequest) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionAttributesUpserted)
+	attributes := &workflow.WorkflowExecutionAttributesUpsertedEventAttributes{}
+	attributes.SearchAttributes = request.SearchAttributes
+	attributes.Memo = request.Memo
+	attributes.Reason = common.StringPtr(request.GetReason())
+	attributes.Identity = common.StringPtr(request.GetIdentity())
+	historyEvent.WorkflowExecutionAttributesUpsertedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newMarkerRecordedEventAttributes(decisionTaskCompletedEventID int64,
 	request *workflow.RecordMarkerDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeMarkerRecorded)
@@ -817,6 +912,47 @@ func (b *historyBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskComp
 	return event
 }
 
+func (b *historyBuilder) newUpsertWorkflowMemoEvent(decisionTaskCompletedEventID int64,
+	request *workflow.UpsertWorkflowMemoDecisionAttributes) *workflow.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeUpsertWorkflowMemo)
+	attributes := &workflow.UpsertWorkflowMemoEventAttributes{}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.Memo = request.GetMemo()
+	event.UpsertWorkflowMemoEventAttributes = attributes
+
+	return event
+}
+
+func (b *historyBuilder) newWorkflowExecutionUpdateAcceptedEvent(decisionTaskCompletedEventID int64,
+	requestID string, identity string, update *workflow.WorkflowUpdate) *workflow.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateAccepted)
+	attributes := &workflow.WorkflowExecutionUpdateAcceptedEventAttributes{}
+	attributes.UpdateId = common.StringPtr(update.GetUpdateId())
+	attributes.UpdateName = common.StringPtr(update.GetUpdateName())
+	attributes.Input = update.Input
+	attributes.Identity = common.StringPtr(identity)
+	if requestID != "" {
+		attributes.RequestId = common.StringPtr(requestID)
+	}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	event.WorkflowExecutionUpdateAcceptedEventAttributes = attributes
+
+	return event
+}
+
+func (b *historyBuilder) newWorkflowExecutionUpdateCompletedEvent(decisionTaskCompletedEventID int64,
+	acceptedEventID int64, result *workflow.WorkflowUpdateResult) *workflow.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateCompleted)
+	attributes := &workflow.WorkflowExecutionUpdateCompletedEventAttributes{}
+	attributes.UpdateId = common.StringPtr(result.GetUpdateId())
+	attributes.AcceptedEventId = common.Int64Ptr(acceptedEventID)
+	attributes.Result = result.Result
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	event.WorkflowExecutionUpdateCompletedEventAttributes = attributes
+
+	return event
+}
+
 func (b *historyBuilder) newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeSignalExternalWorkflowExecutionFailed)
@@ -46,6 +46,7 @@ type (
 		disabled         bool
 		logger           log.Logger
 		metricsClient    metrics.Client
+		sizeMetricsScope metrics.Scope
 		config           *Config
 	}
 )
@@ -63,6 +64,11 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	opts.InitialCapacity = config.HistoryCacheInitialSize()
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
+	opts.MaxBytes = config.HistoryCacheMaxSizeInBytes()
+	opts.SizeFunc = workflowExecutionContextSize
+	// the cache exists per shard, so its size is reported per shard
+	sizeMetricsScope := shard.GetMetricsClient().Scope(metrics.HistoryCacheScope, metrics.ShardTag(shard.GetShardID()))
+	opts.EvictedFunc = newCacheEvictedFunc(sizeMetricsScope)
 
 	return &historyCache{
 		Cache:            cache.New(config.HistoryCacheMaxSize(), opts),
@@ -70,10 +76,27 @@ func newHistoryCache(shard ShardContext) *historyCache {
 		executionManager: shard.GetExecutionManager(),
 		logger:           shard.GetLogger().WithTags(tag.ComponentHistoryCache),
 		metricsClient:    shard.GetMetricsClient(),
+		sizeMetricsScope: sizeMetricsScope,
 		config:           config,
 	}
 }
 
+// workflowExecutionContextSize is the cache.SizeFunc of the history cache
+func workflowExecutionContextSize(value interface{}) int {
+	if context, ok := value.(*workflowExecutionContextImpl); ok {
+		return context.getCacheSize()
+	}
+	return workflowExecutionContextBaseSize
+}
+
+// newCacheEvictedFunc returns a cache.EvictedFunc emitting evictions by reason and the cache size under the given scope
+func newCacheEvictedFunc(metricsScope metrics.Scope) cache.EvictedFunc {
+	return func(reason cache.EvictionReason, sizeInBytes int) {
+		metricsScope.Tagged(metrics.CacheEvictionReasonTag(reason.String())).IncCounter(metrics.CacheEvictionCounter)
+		metricsScope.UpdateGauge(metrics.CacheSizeInBytes, float64(sizeInBytes))
+	}
+}
+
 func (c *historyCache) getOrCreateCurrentWorkflowExecution(
 	ctx context.Context,
 	domainID string,
@@ -258,6 +281,7 @@ func (c *historyCache) makeReleaseFunc(
 			}
 			context.unlock()
 			c.Release(key)
+			c.sizeMetricsScope.UpdateGauge(metrics.CacheSizeInBytes, float64(c.SizeInBytes()))
 		}
 	}
 }
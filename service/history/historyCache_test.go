@@ -250,3 +250,38 @@ func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
 	s.Nil(context.(*workflowExecutionContextImpl).msBuilder)
 	release(nil)
 }
+
+func (s *historyCacheSuite) TestHistoryCacheMaxBytes() {
+	executionContextSize := 3 * workflowExecutionContextBaseSize
+	contextSize := workflowExecutionContextBaseSize + mutableStateBaseSize + executionContextSize
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
+	s.mockShard.GetConfig().HistoryCacheMaxSizeInBytes = dynamicconfig.GetIntPropertyFn(contextSize)
+	domainID := "test_domain_id"
+	s.cache = newHistoryCache(s.mockShard)
+	we1 := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-test-max-bytes-1"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	we2 := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-test-max-bytes-2"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	_, release1, err := s.cache.getOrCreateWorkflowExecutionForBackground(domainID, we1)
+	s.Nil(err)
+	release1(nil)
+	s.Equal(workflowExecutionContextBaseSize, s.cache.SizeInBytes())
+
+	// the mutable state of the second workflow grows while it is pinned,
+	// the first workflow should be evicted once the second one is released
+	context2, release2, err := s.cache.getOrCreateWorkflowExecutionForBackground(domainID, we2)
+	s.Nil(err)
+	context2.(*workflowExecutionContextImpl).msBuilder = &mutableStateBuilder{
+		executionInfo: &persistence.WorkflowExecutionInfo{
+			ExecutionContext: make([]byte, executionContextSize),
+		},
+	}
+	release2(nil)
+	s.Equal(1, s.cache.Size())
+	s.Equal(contextSize, s.cache.SizeInBytes())
+}
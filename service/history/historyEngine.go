@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -57,6 +58,9 @@ const (
 	activityCancellationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancellationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancellationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	terminateIfRunningReason                  = "TerminateIfRunning Policy"
+	taskDLQPageSize                           = 100
+	taskDLQSizeMaxPages                       = 100
 )
 
 type (
@@ -88,6 +92,12 @@ type (
 		publicClient              workflowserviceclient.Interface
 		eventsReapplier           nDCEventsReapplier
 	}
+
+	// WorkflowPausedError is the error to indicate a task of the workflow execution cannot be processed while it
+	// is paused, queue tasks failing with it are dropped and regenerated once the workflow execution is unpaused
+	WorkflowPausedError struct {
+		Msg string
+	}
 )
 
 var _ Engine = (*historyEngineImpl)(nil)
@@ -109,6 +119,8 @@ var (
 	ErrActivityTaskNotFound = &workflow.EntityNotExistsError{Message: "activity task not found"}
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = &workflow.EntityNotExistsError{Message: "workflow execution already completed"}
+	// ErrWorkflowPaused is the error to indicate no task of the workflow execution can be processed while it is paused
+	ErrWorkflowPaused = &WorkflowPausedError{Msg: "workflow execution is paused"}
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "workflow parent does not match"}
 	// ErrDeserializingToken is the error to indicate task token is invalid
@@ -123,6 +135,12 @@ var (
 	ErrEventsAterWorkflowFinish = &workflow.InternalServiceError{Message: "error validating last event being workflow finish event"}
 	// ErrQueryTimeout is the error indicating query timed out before being answered
 	ErrQueryTimeout = errors.New("query timed out")
+	// ErrUpdateTimeout is the error indicating update timed out before being completed or rejected by the worker
+	ErrUpdateTimeout = errors.New("update timed out")
+	// ErrUpdateBeforeFirstDecision is the error indicating update is sent to a cron workflow which has not started yet
+	ErrUpdateBeforeFirstDecision = &workflow.BadRequestError{Message: "workflow execution has not started processing decisions yet"}
+	// ErrTaskNotFoundInDLQ is the error indicating the requested task is not in the shard DLQ
+	ErrTaskNotFoundInDLQ = &workflow.EntityNotExistsError{Message: "task not found in DLQ"}
 
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -134,6 +152,10 @@ var (
 	}
 )
 
+func (e *WorkflowPausedError) Error() string {
+	return e.Msg
+}
+
 // NewEngineWithShardContext creates an instance of history engine
 func NewEngineWithShardContext(
 	shard ShardContext,
@@ -504,6 +526,44 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 				)
 			}
 
+			if (t.State == persistence.WorkflowStateCreated || t.State == persistence.WorkflowStateRunning) &&
+				request.GetWorkflowIdReusePolicy() == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+				runningContext, runningRelease, err := e.historyCache.getOrCreateWorkflowExecution(
+					ctx,
+					domainID,
+					workflow.WorkflowExecution{
+						WorkflowId: common.StringPtr(workflowID),
+						RunId:      common.StringPtr(t.RunID),
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+				defer func() { runningRelease(retError) }()
+				resp, err := e.terminateAndStartWorkflow(runningContext, domainEntry, startRequest, nil)
+				if err != ErrWorkflowCompleted {
+					// the new run is created with its own history branch, the one persisted above is never referenced
+					e.deleteHistoryBranch(msBuilder)
+					return resp, err
+				}
+
+				// the running workflow execution closed concurrently, create as ID reuse of the current run instead
+				currentExecution, err := e.historyCache.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+					DomainID:   domainID,
+					WorkflowID: workflowID,
+				})
+				if err != nil {
+					return nil, err
+				}
+				t = &persistence.WorkflowExecutionAlreadyStartedError{
+					StartRequestID:   currentExecution.StartRequestID,
+					RunID:            currentExecution.RunID,
+					State:            currentExecution.State,
+					CloseStatus:      currentExecution.CloseStatus,
+					LastWriteVersion: currentExecution.LastWriteVersion,
+				}
+			}
+
 			// create as ID reuse
 			createMode = persistence.CreateWorkflowModeWorkflowIDReuse
 			prevRunID = t.RunID
@@ -972,6 +1032,13 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		}
 		result.WorkflowExecutionInfo.ParentDomainId = common.StringPtr(executionInfo.ParentDomainID)
 	}
+	if executionInfo.Paused {
+		result.WorkflowExecutionInfo.Paused = common.BoolPtr(true)
+	}
+	// the first decision of a delayed or cron workflow is scheduled once the backoff fires
+	if msBuilder.IsWorkflowExecutionRunning() && !msBuilder.HasProcessedOrPendingDecision() && backoffDuration > 0 {
+		result.WorkflowExecutionInfo.WaitingToStart = common.BoolPtr(true)
+	}
 	if executionInfo.State == persistence.WorkflowStateCompleted {
 		// for closed workflow
 		closeStatus := persistence.ToThriftWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
@@ -1042,6 +1109,31 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		}
 	}
 
+	if len(msBuilder.GetPendingTimerInfos()) > 0 {
+		for _, ti := range msBuilder.GetPendingTimerInfos() {
+			p := &workflow.PendingTimerInfo{
+				TimerID:         common.StringPtr(ti.TimerID),
+				StartedEventID:  common.Int64Ptr(ti.StartedID),
+				ExpiryTimestamp: common.Int64Ptr(ti.ExpiryTime.UnixNano()),
+			}
+			result.PendingTimers = append(result.PendingTimers, p)
+		}
+	}
+
+	if di, ok := msBuilder.GetPendingDecision(); ok {
+		pendingDecision := &workflow.PendingDecisionInfo{
+			State:                      workflow.PendingDecisionStateScheduled.Ptr(),
+			ScheduledTimestamp:         common.Int64Ptr(di.ScheduledTimestamp),
+			Attempt:                    common.Int64Ptr(di.Attempt),
+			OriginalScheduledTimestamp: common.Int64Ptr(di.OriginalScheduledTimestamp),
+		}
+		if di.StartedID != common.EmptyEventID {
+			pendingDecision.State = workflow.PendingDecisionStateStarted.Ptr()
+			pendingDecision.StartedTimestamp = common.Int64Ptr(di.StartedTimestamp)
+		}
+		result.PendingDecision = pendingDecision
+	}
+
 	return result, nil
 }
 
@@ -1071,6 +1163,9 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
 			}
+			if msBuilder.GetExecutionInfo().Paused {
+				return ErrWorkflowPaused
+			}
 
 			scheduleID := request.GetScheduleId()
 			requestID := request.GetRequestId()
@@ -1511,19 +1606,20 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 
 	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 		executionInfo := msBuilder.GetExecutionInfo()
-		createDecisionTask := true
-		// Do not create decision task when the workflow is cron and the cron has not been started yet
-		if msBuilder.GetExecutionInfo().CronSchedule != "" && !msBuilder.HasProcessedOrPendingDecision() {
-			createDecisionTask = false
-		}
-		postActions := &updateWorkflowAction{
-			createDecision: createDecisionTask,
-		}
-
 		if !msBuilder.IsWorkflowExecutionRunning() {
 			return nil, ErrWorkflowCompleted
 		}
 
+		// Do not create decision task when the first decision is waiting for its cron schedule or start delay,
+		// the first decision picks up the signal
+		backoffPending, err := isFirstDecisionBackoffPending(msBuilder)
+		if err != nil {
+			return nil, err
+		}
+		postActions := &updateWorkflowAction{
+			createDecision: !backoffPending,
+		}
+
 		maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
 		if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
 			e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
@@ -1561,6 +1657,107 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	})
 }
 
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx ctx.Context,
+	updateRequest *h.UpdateWorkflowExecutionRequest,
+) (*workflow.UpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(updateRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := updateRequest.UpdateRequest
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the run ID is resolved by the cache, the update stays bound to that run
+	execution := *context.getExecution()
+	updateRegistry := context.getUpdateRegistry()
+	release(nil)
+
+	updateID, updateTermCh := updateRegistry.bufferUpdate(request.GetRequestId(), request.GetUpdateName(), request.Input)
+
+	ttl := e.config.LongPollExpirationInterval(domainEntry.GetInfo().Name)
+	if timeout := time.Duration(request.GetTimeoutSeconds()) * time.Second; timeout > 0 && timeout < ttl {
+		ttl = timeout
+	}
+	timer := time.NewTimer(ttl)
+	defer timer.Stop()
+
+	select {
+	case <-updateTermCh:
+		// a retried request joined an update which is already completed
+		return e.getUpdateWorkflowExecutionResponse(updateRegistry, updateID)
+	default:
+	}
+
+	// ensure decision task exists to dispatch update on
+	err = e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+		if !msBuilder.IsWorkflowExecutionRunning() {
+			return nil, ErrWorkflowCompleted
+		}
+		// Do not create decision task when the first decision is waiting for its cron schedule or start delay
+		backoffPending, err := isFirstDecisionBackoffPending(msBuilder)
+		if err != nil {
+			return nil, err
+		}
+		if backoffPending {
+			return nil, ErrUpdateBeforeFirstDecision
+		}
+		// a pending decision task picks the update up when it is started, or schedules another one when it completes
+		if msBuilder.HasPendingDecision() {
+			return &updateWorkflowAction{noop: true}, nil
+		}
+		return &updateWorkflowAction{createDecision: true}, nil
+	})
+	if err != nil {
+		updateRegistry.removeUpdate(updateID)
+		return nil, err
+	}
+
+	select {
+	case <-updateTermCh:
+		return e.getUpdateWorkflowExecutionResponse(updateRegistry, updateID)
+	case <-timer.C:
+		updateRegistry.removeUpdate(updateID)
+		return nil, ErrUpdateTimeout
+	case <-ctx.Done():
+		updateRegistry.removeUpdate(updateID)
+		return nil, ctx.Err()
+	}
+}
+
+func (e *historyEngineImpl) getUpdateWorkflowExecutionResponse(
+	updateRegistry updateRegistry,
+	updateID string,
+) (*workflow.UpdateWorkflowExecutionResponse, error) {
+
+	updateSnapshot, err := updateRegistry.getUpdateSnapshot(updateID)
+	if err != nil {
+		return nil, err
+	}
+	result := updateSnapshot.result
+	response := &workflow.UpdateWorkflowExecutionResponse{
+		UpdateId: common.StringPtr(updateID),
+	}
+	switch result.GetResultType() {
+	case workflow.WorkflowUpdateResultTypeCompleted:
+		response.Result = result.Result
+	case workflow.WorkflowUpdateResultTypeRejected:
+		response.UpdateRejected = &workflow.WorkflowUpdateRejected{
+			Reason:  result.RejectionReason,
+			Details: result.RejectionDetails,
+		}
+	}
+	return response, nil
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx ctx.Context,
 	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest,
@@ -1600,6 +1797,30 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				break
 			}
 
+			// workflow running and policy is TerminateIfRunning, will terminate the running workflow
+			// then start the new one and signal it
+			if sRequest.GetWorkflowIdReusePolicy() == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+				startRequest := getStartRequest(domainID, sRequest)
+				if err := validateStartWorkflowExecutionRequest(
+					startRequest.StartRequest,
+					e.config.MaxIDLengthLimit(),
+				); err != nil {
+					return nil, err
+				}
+				e.overrideStartWorkflowExecutionRequest(domainEntry, startRequest.StartRequest)
+				resp, err := e.terminateAndStartWorkflow(context, domainEntry, startRequest, sRequest)
+				if err != ErrWorkflowCompleted {
+					return resp, err
+				}
+
+				// the running workflow execution closed concurrently, will restart workflow then signal
+				prevMutableState, err = context.loadWorkflowExecution()
+				if err != nil {
+					return nil, err
+				}
+				break
+			}
+
 			executionInfo := msBuilder.GetExecutionInfo()
 			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
 			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
@@ -1617,8 +1838,13 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecision() {
+			// Create a transfer task to schedule a decision task, unless the first decision is waiting for
+			// its cron schedule or start delay
+			backoffPending, err := isFirstDecisionBackoffPending(msBuilder)
+			if err != nil {
+				return nil, err
+			}
+			if !backoffPending && !msBuilder.HasPendingDecision() {
 				_, err := msBuilder.AddDecisionTaskScheduledEvent(false)
 				if err != nil {
 					return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -1840,6 +2066,237 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// PauseWorkflowExecution records WorkflowExecutionPaused event, no decision or activity task of the
+// workflow execution is dispatched and no timer fires until it is unpaused
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx ctx.Context,
+	pauseRequest *h.PauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if msBuilder.GetExecutionInfo().Paused {
+				// already paused, nothing to do
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionPausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// UnpauseWorkflowExecution records WorkflowExecutionUnpaused event and generates again the tasks
+// which were held back while the workflow execution was paused
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx ctx.Context,
+	unpauseRequest *h.UnpauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(unpauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := unpauseRequest.UnpauseRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if !msBuilder.GetExecutionInfo().Paused {
+				// not paused, nothing to do
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionUnpausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to unpause workflow execution."}
+			}
+
+			// the queue processors and matching drop the tasks of a paused workflow execution
+			taskRefresher := newMutableStateTaskRefresher(
+				e.config,
+				e.shard.GetDomainCache(),
+				e.shard.GetEventsCache(),
+				e.logger,
+			)
+			if err := taskRefresher.refreshTasks(e.shard.GetTimeSource().Now(), msBuilder); err != nil {
+				return nil, err
+			}
+
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// UpsertWorkflowExecutionAttributes records WorkflowExecutionAttributesUpserted event, which merges the
+// given search attributes and memo into the workflow execution and updates its visibility record
+func (e *historyEngineImpl) UpsertWorkflowExecutionAttributes(
+	ctx ctx.Context,
+	upsertRequest *h.UpsertWorkflowExecutionAttributesRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(upsertRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := upsertRequest.UpsertRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionAttributesUpsertedEvent(request); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to upsert workflow execution attributes."}
+			}
+
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// DeleteWorkflowExecution terminates the workflow execution if it is still running and schedules
+// an immediate delete history event timer task, which removes the current execution, mutable state,
+// history branch and visibility records (archiving them first if archival is enabled for the domain)
+// once the close execution transfer task of the run is processed
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx ctx.Context,
+	deleteRequest *h.DeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	domainEntry, err := e.getActiveDomainEntry(deleteRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := deleteRequest.DeleteRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err := context.loadWorkflowExecution()
+		if err != nil {
+			return err
+		}
+		executionInfo := msBuilder.GetExecutionInfo()
+
+		if msBuilder.IsWorkflowExecutionRunning() {
+			if _, err := msBuilder.AddWorkflowExecutionTerminatedEvent(
+				request.GetReason(),
+				nil,
+				request.GetIdentity(),
+			); err != nil {
+				return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+			}
+		}
+
+		now := e.shard.GetTimeSource().Now()
+		msBuilder.AddTimerTasks(&persistence.DeleteHistoryEventTask{
+			VisibilityTimestamp: now,
+			Version:             msBuilder.GetCurrentVersion(),
+		})
+
+		updateMode, err := e.getUpdateWorkflowMode(domainID, msBuilder)
+		if err != nil {
+			return err
+		}
+
+		err = context.updateWorkflowExecutionWithNew(
+			now,
+			updateMode,
+			nil,
+			nil,
+			transactionPolicyActive,
+			nil,
+		)
+		if err == ErrConflict {
+			continue Update_History_Loop
+		}
+		if err != nil {
+			return err
+		}
+
+		e.logger.Info("Workflow execution scheduled for deletion.",
+			tag.WorkflowDomainID(domainID),
+			tag.WorkflowID(executionInfo.WorkflowID),
+			tag.WorkflowRunID(executionInfo.RunID),
+		)
+		return nil
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+// getUpdateWorkflowMode returns the mode to persist the given mutable state with,
+// closed runs which are no longer the current run of the workflow have to be
+// updated without touching the current execution record
+func (e *historyEngineImpl) getUpdateWorkflowMode(
+	domainID string,
+	msBuilder mutableState,
+) (persistence.UpdateWorkflowMode, error) {
+
+	if msBuilder.IsCurrentWorkflowGuaranteed() {
+		return persistence.UpdateWorkflowModeUpdateCurrent, nil
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	resp, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: executionInfo.WorkflowID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.RunID != executionInfo.RunID {
+		return persistence.UpdateWorkflowModeBypassCurrent, nil
+	}
+	return persistence.UpdateWorkflowModeUpdateCurrent, nil
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx ctx.Context,
@@ -1963,6 +2420,12 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		}
 		return
 	}
+	if request.IsSetResetType() {
+		request, retError = e.resolveResetPoint(ctx, domainID, request)
+		if retError != nil {
+			return
+		}
+	}
 	if request.GetDecisionFinishEventId() <= common.FirstEventID {
 		retError = &workflow.BadRequestError{
 			Message: "Decision finish ID must be > 1.",
@@ -2033,6 +2496,142 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	return e.resetor.ResetWorkflowExecution(ctx, request, baseContext, baseMutableState, currContext, currMutableState)
 }
 
+// resolveResetPoint returns a copy of the request with the base run and the DecisionFinishEventId
+// resolved from the ResetType of the request
+func (e *historyEngineImpl) resolveResetPoint(
+	ctx ctx.Context,
+	domainID string,
+	request *workflow.ResetWorkflowExecutionRequest,
+) (*workflow.ResetWorkflowExecutionRequest, error) {
+
+	baseExecution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+	var decisionFinishEventID int64
+	err := e.withMutableStateForReset(ctx, domainID, baseExecution, func(baseMutableState mutableState) error {
+		var err error
+		switch request.GetResetType() {
+		case workflow.ResetTypeFirstDecisionCompleted:
+			decisionFinishEventID, err = e.findDecisionCompletedEventID(baseMutableState, true)
+		case workflow.ResetTypeLastDecisionCompleted:
+			decisionFinishEventID, err = e.findDecisionCompletedEventID(baseMutableState, false)
+		case workflow.ResetTypeLastContinuedAsNew:
+			startEvent, err := baseMutableState.GetStartEvent()
+			if err != nil {
+				return err
+			}
+			continuedRunID := startEvent.WorkflowExecutionStartedEventAttributes.GetContinuedExecutionRunId()
+			if continuedRunID == "" {
+				return &workflow.BadRequestError{Message: "Workflow run is not continued from another run."}
+			}
+			// the reset point is resolved in the continued run, after the lock of this run is released
+			baseExecution.RunId = common.StringPtr(continuedRunID)
+		case workflow.ResetTypeBadBinary:
+			_, point := FindAutoResetPoint(e.timeSource, &workflow.BadBinaries{
+				Binaries: map[string]*workflow.BadBinaryInfo{
+					request.GetResetBinaryChecksum(): {},
+				},
+			}, baseMutableState.GetExecutionInfo().AutoResetPoints)
+			if point == nil {
+				return &workflow.BadRequestError{Message: "Cannot find reset point for the binary checksum."}
+			}
+			// auto reset points are carried over by continueAsNew, the point can belong to a previous run
+			baseExecution.RunId = point.RunId
+			decisionFinishEventID = point.GetFirstDecisionCompletedId()
+		default:
+			return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown reset type: %v.", request.GetResetType())}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetResetType() == workflow.ResetTypeLastContinuedAsNew {
+		err = e.withMutableStateForReset(ctx, domainID, baseExecution, func(baseMutableState mutableState) error {
+			var err error
+			decisionFinishEventID, err = e.findDecisionCompletedEventID(baseMutableState, false)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if decisionFinishEventID == 0 {
+		return nil, &workflow.BadRequestError{Message: "Cannot find DecisionTaskCompleted event to reset to."}
+	}
+
+	resolved := *request
+	resolved.WorkflowExecution = &baseExecution
+	resolved.DecisionFinishEventId = common.Int64Ptr(decisionFinishEventID)
+	resolved.ResetType = nil
+	resolved.ResetBinaryChecksum = nil
+	return &resolved, nil
+}
+
+// withMutableStateForReset loads the mutable state of the execution and runs the action on it
+// while holding the lock of the execution
+func (e *historyEngineImpl) withMutableStateForReset(
+	ctx ctx.Context,
+	domainID string,
+	execution workflow.WorkflowExecution,
+	action func(msBuilder mutableState) error,
+) (retError error) {
+
+	context, release, retError := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if retError != nil {
+		return
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, retError := context.loadWorkflowExecution()
+	if retError != nil {
+		return
+	}
+	return action(msBuilder)
+}
+
+// findDecisionCompletedEventID reads the history of the run and returns the ID of
+// the first or the last DecisionTaskCompleted event, 0 if there is none
+func (e *historyEngineImpl) findDecisionCompletedEventID(
+	msBuilder mutableState,
+	first bool,
+) (int64, error) {
+
+	branchToken, err := msBuilder.GetCurrentBranchToken()
+	if err != nil {
+		return 0, err
+	}
+
+	var decisionFinishEventID int64
+	req := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  msBuilder.GetNextEventID(),
+		PageSize:    defaultHistoryPageSize,
+		ShardID:     common.IntPtr(e.shard.GetShardID()),
+	}
+	for {
+		resp, err := e.historyV2Mgr.ReadHistoryBranch(req)
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range resp.HistoryEvents {
+			if event.GetEventType() == workflow.EventTypeDecisionTaskCompleted {
+				decisionFinishEventID = event.GetEventId()
+				if first {
+					return decisionFinishEventID, nil
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return decisionFinishEventID, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
 func (e *historyEngineImpl) DeleteExecutionFromVisibility(
 	task *persistence.TimerTaskInfo,
 ) error {
@@ -2374,6 +2973,7 @@ func getStartRequest(
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
+		DelayStartSeconds:                   request.DelayStartSeconds,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
@@ -2396,6 +2996,105 @@ func setTaskInfo(
 	}
 }
 
+// terminateAndStartWorkflow terminates the running workflow execution and starts a new one with the
+// same workflow ID in the same transaction, the new run is also signaled if signalWithStartRequest is set,
+// ErrWorkflowCompleted is returned if the workflow execution is no longer running
+func (e *historyEngineImpl) terminateAndStartWorkflow(
+	runningContext workflowExecutionContext,
+	domainEntry *cache.DomainCacheEntry,
+	startRequest *h.StartWorkflowExecutionRequest,
+	signalWithStartRequest *workflow.SignalWithStartWorkflowExecutionRequest,
+) (*workflow.StartWorkflowExecutionResponse, error) {
+
+	domainID := domainEntry.GetInfo().ID
+	clusterMetadata := e.shard.GetService().GetClusterMetadata()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: startRequest.StartRequest.WorkflowId,
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		runningMutableState, err := runningContext.loadWorkflowExecution()
+		if err != nil {
+			return nil, err
+		}
+		if !runningMutableState.IsWorkflowExecutionRunning() {
+			return nil, ErrWorkflowCompleted
+		}
+
+		if _, err := runningMutableState.AddWorkflowExecutionTerminatedByNewRunEvent(
+			terminateIfRunningReason,
+			startRequest.StartRequest.GetIdentity(),
+			execution.GetRunId(),
+		); err != nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+		}
+
+		msBuilder, err := e.createMutableState(clusterMetadata, domainEntry, execution.GetRunId())
+		if err != nil {
+			return nil, err
+		}
+		startEvent, err := msBuilder.AddWorkflowExecutionStartedEvent(
+			execution,
+			startRequest,
+		)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: "Failed to add workflow execution started event.",
+			}
+		}
+		if signalWithStartRequest != nil {
+			if _, err := msBuilder.AddWorkflowExecutionSignaled(
+				signalWithStartRequest.GetSignalName(),
+				signalWithStartRequest.GetSignalInput(),
+				signalWithStartRequest.GetIdentity()); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
+			}
+		}
+		if err := e.generateFirstDecisionTask(
+			msBuilder,
+			startRequest.ParentExecutionInfo,
+			startEvent,
+		); err != nil {
+			return nil, err
+		}
+
+		context := newWorkflowExecutionContext(domainID, execution, e.shard, e.executionManager, e.logger)
+		if err := runningContext.updateWorkflowExecutionWithNewAsActive(
+			e.timeSource.Now(),
+			context,
+			msBuilder,
+		); err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+			return nil, err
+		}
+		return &workflow.StartWorkflowExecutionResponse{
+			RunId: execution.RunId,
+		}, nil
+	}
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// deleteHistoryBranch deletes the current history branch of a mutable state which failed to be created
+func (e *historyEngineImpl) deleteHistoryBranch(
+	msBuilder mutableState,
+) {
+
+	branchToken, err := msBuilder.GetCurrentBranchToken()
+	if err != nil {
+		return
+	}
+	if err := e.historyV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     common.IntPtr(e.shard.GetShardID()),
+	}); err != nil {
+		e.logger.Warn("Failed to delete history branch of discarded workflow execution.", tag.Error(err))
+	}
+}
+
 // for startWorkflowExecution & signalWithStart to handle workflow reuse policy
 func (e *historyEngineImpl) applyWorkflowIDReusePolicyForSigWithStart(
 	prevExecutionInfo *persistence.WorkflowExecutionInfo,
@@ -2451,7 +3150,8 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(
 			msg := "Workflow execution already finished successfully. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: allow duplicate workflow ID if last run failed."
 			return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowId(), prevRunID)
 		}
-	case workflow.WorkflowIdReusePolicyAllowDuplicate:
+	case workflow.WorkflowIdReusePolicyAllowDuplicate,
+		workflow.WorkflowIdReusePolicyTerminateIfRunning:
 		// as long as workflow not running, so this case has no check
 	case workflow.WorkflowIdReusePolicyRejectDuplicate:
 		msg := "Workflow execution already finished. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: reject duplicate workflow ID."
@@ -2512,8 +3212,12 @@ func (e *historyEngineImpl) ReapplyEvents(
 			postActions := &updateWorkflowAction{
 				createDecision: true,
 			}
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if msBuilder.GetExecutionInfo().CronSchedule != "" && !msBuilder.HasProcessedOrPendingDecision() {
+			// Do not create decision task when the first decision is waiting for its cron schedule or start delay
+			backoffPending, err := isFirstDecisionBackoffPending(msBuilder)
+			if err != nil {
+				return nil, err
+			}
+			if backoffPending {
 				postActions.createDecision = false
 			}
 			// TODO when https://github.com/uber/cadence/issues/2420 is finished
@@ -2541,3 +3245,294 @@ func (e *historyEngineImpl) ReapplyEvents(
 			return postActions, nil
 		})
 }
+
+// isFirstDecisionBackoffPending returns true if the first decision of the workflow execution is not scheduled
+// yet because it is waiting for its cron schedule or start delay
+func isFirstDecisionBackoffPending(
+	msBuilder mutableState,
+) (bool, error) {
+
+	if msBuilder.HasProcessedOrPendingDecision() {
+		return false, nil
+	}
+	startEvent, err := msBuilder.GetStartEvent()
+	if err != nil {
+		return false, err
+	}
+	return startEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstDecisionTaskBackoffSeconds() > 0, nil
+}
+
+// RefreshWorkflowTasks regenerates the transfer and timer tasks of the workflow execution from its mutable state
+func (e *historyEngineImpl) RefreshWorkflowTasks(
+	ctx ctx.Context,
+	domainUUID string,
+	execution workflow.WorkflowExecution,
+) (retError error) {
+
+	domainEntry, err := e.getActiveDomainEntry(common.StringPtr(domainUUID))
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err := context.loadWorkflowExecution()
+		if err != nil {
+			return err
+		}
+
+		now := e.timeSource.Now()
+		taskRefresher := newMutableStateTaskRefresher(
+			e.config,
+			e.shard.GetDomainCache(),
+			e.shard.GetEventsCache(),
+			e.logger,
+		)
+		if err := taskRefresher.refreshTasks(now, msBuilder); err != nil {
+			return err
+		}
+
+		updateMode, err := e.getUpdateWorkflowMode(domainID, msBuilder)
+		if err != nil {
+			return err
+		}
+
+		err = context.updateWorkflowExecutionWithNew(
+			now,
+			updateMode,
+			nil,
+			nil,
+			transactionPolicyActive,
+			nil,
+		)
+		if err == ErrConflict {
+			continue
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+// ReadTaskDLQ returns the transfer or timer tasks of the shard which were moved to DLQ after exhausting their attempts
+func (e *historyEngineImpl) ReadTaskDLQ(
+	ctx ctx.Context,
+	request *workflow.ReadTaskDLQRequest,
+) (*workflow.ReadTaskDLQResponse, error) {
+
+	category := toPersistenceDLQCategory(request.GetCategory())
+	response, err := e.getTasksFromDLQ(category, request.TaskID, int(request.GetMaximumPageSize()), request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	if request.TaskID != nil && len(response.Tasks) == 0 {
+		return nil, ErrTaskNotFoundInDLQ
+	}
+
+	entries := make([]*workflow.TaskDLQEntry, 0, len(response.Tasks))
+	for _, task := range response.Tasks {
+		entry, err := toThriftTaskDLQEntry(task)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return &workflow.ReadTaskDLQResponse{
+		Entries:       entries,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// ReplayTaskDLQ reprocesses the tasks stored in the DLQ entries of the shard with the queue processors,
+// entries are removed from DLQ once their task is processed. Without a task ID one page of entries is
+// replayed per call, the returned token continues with the next page
+func (e *historyEngineImpl) ReplayTaskDLQ(
+	ctx ctx.Context,
+	request *workflow.ReplayTaskDLQRequest,
+) (*workflow.ReplayTaskDLQResponse, error) {
+
+	category := toPersistenceDLQCategory(request.GetCategory())
+	if request.TaskID != nil {
+		response, err := e.getTasksFromDLQ(category, request.TaskID, 1, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Tasks) == 0 {
+			return nil, ErrTaskNotFoundInDLQ
+		}
+		if err := e.replayTaskFromDLQ(response.Tasks[0]); err != nil {
+			return nil, err
+		}
+		emitTaskDLQSize(e.shard, category, e.logger)
+		return &workflow.ReplayTaskDLQResponse{
+			ReplayedCount: common.Int32Ptr(1),
+			FailedCount:   common.Int32Ptr(0),
+		}, nil
+	}
+
+	response, err := e.getTasksFromDLQ(category, nil, int(request.GetMaximumPageSize()), request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	replayedCount := 0
+	failedCount := 0
+	for _, task := range response.Tasks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := e.replayTaskFromDLQ(task); err != nil {
+			e.logger.Warn("Fail to replay task from DLQ.",
+				tag.ShardID(e.shard.GetShardID()),
+				tag.TaskID(task.TaskID),
+				tag.Error(err),
+			)
+			failedCount++
+			continue
+		}
+		replayedCount++
+	}
+	emitTaskDLQSize(e.shard, category, e.logger)
+	return &workflow.ReplayTaskDLQResponse{
+		ReplayedCount: common.Int32Ptr(int32(replayedCount)),
+		FailedCount:   common.Int32Ptr(int32(failedCount)),
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// PurgeTaskDLQ removes DLQ entries of the shard without processing them
+func (e *historyEngineImpl) PurgeTaskDLQ(
+	ctx ctx.Context,
+	request *workflow.PurgeTaskDLQRequest,
+) error {
+
+	category := toPersistenceDLQCategory(request.GetCategory())
+	var err error
+	if request.TaskID != nil {
+		err = e.executionManager.DeleteTaskFromDLQ(&persistence.DeleteTaskFromDLQRequest{
+			Category: category,
+			TaskID:   request.GetTaskID(),
+		})
+	} else {
+		err = e.executionManager.RangeDeleteTasksFromDLQ(&persistence.RangeDeleteTasksFromDLQRequest{
+			Category:             category,
+			ExclusiveBeginTaskID: 0,
+			InclusiveEndTaskID:   math.MaxInt64,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	emitTaskDLQSize(e.shard, category, e.logger)
+	return nil
+}
+
+func (e *historyEngineImpl) getTasksFromDLQ(
+	category int,
+	taskID *int64,
+	pageSize int,
+	pageToken []byte,
+) (*persistence.GetTasksFromDLQResponse, error) {
+
+	if pageSize <= 0 {
+		pageSize = taskDLQPageSize
+	}
+	request := &persistence.GetTasksFromDLQRequest{
+		Category:      category,
+		ReadLevel:     0,
+		MaxReadLevel:  math.MaxInt64,
+		BatchSize:     pageSize,
+		NextPageToken: pageToken,
+	}
+	if taskID != nil {
+		request.ReadLevel = *taskID - 1
+		request.MaxReadLevel = *taskID
+	}
+	return e.executionManager.GetTasksFromDLQ(request)
+}
+
+func (e *historyEngineImpl) replayTaskFromDLQ(
+	task *persistence.DLQTaskInfo,
+) error {
+
+	queueTask, err := getDLQQueueTask(task)
+	if err != nil {
+		return err
+	}
+	switch queueTask := queueTask.(type) {
+	case *persistence.TransferTaskInfo:
+		err = e.txProcessor.ReplayTaskFromDLQ(queueTask)
+	case *persistence.TimerTaskInfo:
+		err = e.timerProcessor.ReplayTaskFromDLQ(queueTask)
+	}
+	if err != nil {
+		return err
+	}
+	return e.executionManager.DeleteTaskFromDLQ(&persistence.DeleteTaskFromDLQRequest{
+		Category: task.Category,
+		TaskID:   task.TaskID,
+	})
+}
+
+func getDLQQueueTask(
+	task *persistence.DLQTaskInfo,
+) (queueTaskInfo, error) {
+
+	switch {
+	case task.Category == persistence.DLQCategoryTransfer && task.TransferTask != nil:
+		return task.TransferTask, nil
+	case task.Category == persistence.DLQCategoryTimer && task.TimerTask != nil:
+		return task.TimerTask, nil
+	default:
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("corrupted DLQ task, category: %v, task ID: %v", task.Category, task.TaskID),
+		}
+	}
+}
+
+func toPersistenceDLQCategory(
+	category workflow.TaskDLQCategory,
+) int {
+
+	if category == workflow.TaskDLQCategoryTimer {
+		return persistence.DLQCategoryTimer
+	}
+	return persistence.DLQCategoryTransfer
+}
+
+func toThriftTaskDLQEntry(
+	task *persistence.DLQTaskInfo,
+) (*workflow.TaskDLQEntry, error) {
+
+	queueTask, err := getDLQQueueTask(task)
+	if err != nil {
+		return nil, err
+	}
+	details, err := json.Marshal(queueTask)
+	if err != nil {
+		return nil, err
+	}
+
+	category := workflow.TaskDLQCategoryTransfer
+	if task.Category == persistence.DLQCategoryTimer {
+		category = workflow.TaskDLQCategoryTimer
+	}
+	return &workflow.TaskDLQEntry{
+		Category:            category.Ptr(),
+		TaskID:              common.Int64Ptr(task.TaskID),
+		TaskType:            common.Int32Ptr(int32(queueTask.GetTaskType())),
+		DomainID:            common.StringPtr(queueTask.GetDomainID()),
+		WorkflowID:          common.StringPtr(queueTask.GetWorkflowID()),
+		RunID:               common.StringPtr(queueTask.GetRunID()),
+		VisibilityTimestamp: common.Int64Ptr(queueTask.GetVisibilityTimestamp().UnixNano()),
+		Version:             common.Int64Ptr(queueTask.GetVersion()),
+		Attempt:             common.Int32Ptr(int32(task.Attempt)),
+		LastError:           common.StringPtr(task.LastError),
+		EnqueueTimestamp:    common.Int64Ptr(task.EnqueueTime.UnixNano()),
+		Details:             common.StringPtr(string(details)),
+	}, nil
+}
@@ -1011,6 +1011,133 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 	s.Nil(resp)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_TerminateIfRunning() {
+	domainID := testDomainID
+	workflowID := "workflowID"
+	runID := testRunID
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	lastWriteVersion := common.EmptyVersion
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	var terminatedEvent *workflow.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(arguments mock.Arguments) {
+		for _, event := range arguments.Get(0).(*p.AppendHistoryNodesRequest).Events {
+			if event.GetEventType() == workflow.EventTypeWorkflowExecutionTerminated {
+				terminatedEvent = event
+			}
+		}
+	}).Times(3)
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: lastWriteVersion,
+	}).Once()
+	// the history branch persisted for the first attempt is not referenced by any run
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.UpdateWorkflowMutation.ExecutionInfo.CloseStatus == p.WorkflowCloseStatusTerminated &&
+			request.NewWorkflowSnapshot != nil
+	})).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockEventsCache.On("getEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		&workflow.HistoryEvent{EventType: workflow.EventTypeWorkflowExecutionTerminated.Ptr()}, nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr("newRequestID"),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.NotEqual(runID, resp.GetRunId())
+	s.NotNil(terminatedEvent)
+	s.Equal(resp.GetRunId(), terminatedEvent.WorkflowExecutionTerminatedEventAttributes.GetNewExecutionRunId())
+	s.Nil(terminatedEvent.WorkflowExecutionTerminatedEventAttributes.Details)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_TerminateIfRunning_ClosedConcurrently() {
+	domainID := testDomainID
+	workflowID := "workflowID"
+	runID := testRunID
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	lastWriteVersion := common.EmptyVersion
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	ms := createMutableState(msBuilder)
+	// the running workflow execution completes before it is terminated
+	ms.ExecutionInfo.State = p.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = p.WorkflowCloseStatusCompleted
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *p.CreateWorkflowExecutionRequest) bool {
+		return request.Mode == p.CreateWorkflowModeBrandNew
+	})).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: lastWriteVersion,
+	}).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", &p.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+	}).Return(&p.GetCurrentExecutionResponse{
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            p.WorkflowStateCompleted,
+		CloseStatus:      p.WorkflowCloseStatusCompleted,
+		LastWriteVersion: lastWriteVersion,
+	}, nil).Once()
+	// the history branch persisted for the first attempt is referenced by the run created as ID reuse
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *p.CreateWorkflowExecutionRequest) bool {
+		return request.Mode == p.CreateWorkflowModeWorkflowIDReuse &&
+			request.PreviousRunID == runID &&
+			request.PreviousLastWriteVersion == lastWriteVersion
+	})).Return(&p.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr("newRequestID"),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.NotEqual(runID, resp.GetRunId())
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	domainID := testDomainID
 	workflowID := "workflowID"
@@ -1193,6 +1320,8 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
 
 	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockEventsCache.On("getEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&workflow.HistoryEvent{WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{}}, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
@@ -1204,6 +1333,59 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	s.Equal(runID, resp.GetRunId())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_TerminateIfRunning() {
+	domainID := testDomainID
+	workflowID := "wId"
+	runID := testRunID
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	sRequest := &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			SignalName:                          common.StringPtr(signalName),
+			Input:                               input,
+			RequestId:                           common.StringPtr(uuid.New()),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.Mode == p.UpdateWorkflowModeUpdateCurrent &&
+			request.UpdateWorkflowMutation.ExecutionInfo.State == p.WorkflowStateCompleted &&
+			request.UpdateWorkflowMutation.ExecutionInfo.CloseStatus == p.WorkflowCloseStatusTerminated &&
+			request.NewWorkflowSnapshot != nil &&
+			request.NewWorkflowSnapshot.ExecutionInfo.RunID != runID
+	})).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockEventsCache.On("getEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		&workflow.HistoryEvent{EventType: workflow.EventTypeWorkflowExecutionTerminated.Ptr()}, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.NotNil(resp.GetRunId())
+	s.NotEqual(runID, resp.GetRunId())
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	sRequest := &h.SignalWithStartWorkflowExecutionRequest{}
 	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
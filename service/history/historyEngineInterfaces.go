@@ -64,9 +64,14 @@ type (
 		RecordActivityTaskHeartbeat(ctx context.Context, request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *h.RequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *h.SignalWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(ctx context.Context, request *h.UpdateWorkflowExecutionRequest) (*workflow.UpdateWorkflowExecutionResponse, error)
 		SignalWithStartWorkflowExecution(ctx context.Context, request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *h.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, request *h.UnpauseWorkflowExecutionRequest) error
+		DeleteWorkflowExecution(ctx context.Context, request *h.DeleteWorkflowExecutionRequest) error
+		UpsertWorkflowExecutionAttributes(ctx context.Context, request *h.UpsertWorkflowExecutionAttributesRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *h.RecordChildExecutionCompletedRequest) error
@@ -78,6 +83,10 @@ type (
 		GetReplicationMessages(ctx context.Context, taskID int64) (*replicator.ReplicationMessages, error)
 		QueryWorkflow(ctx context.Context, request *h.QueryWorkflowRequest) (*h.QueryWorkflowResponse, error)
 		ReapplyEvents(ctx context.Context, domainUUID string, workflowID string, events []*workflow.HistoryEvent) error
+		RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution workflow.WorkflowExecution) error
+		ReadTaskDLQ(ctx context.Context, request *workflow.ReadTaskDLQRequest) (*workflow.ReadTaskDLQResponse, error)
+		ReplayTaskDLQ(ctx context.Context, request *workflow.ReplayTaskDLQRequest) (*workflow.ReplayTaskDLQResponse, error)
+		PurgeTaskDLQ(ctx context.Context, request *workflow.PurgeTaskDLQRequest) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -139,6 +148,7 @@ type (
 		NotifyNewTask(clusterName string, transferTasks []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		ReplayTaskFromDLQ(task *persistence.TransferTaskInfo) error
 	}
 
 	// TODO the timer queue processor and the one below, timer processor
@@ -150,6 +160,7 @@ type (
 		NotifyNewTimers(clusterName string, timerTask []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		ReplayTaskFromDLQ(task *persistence.TimerTaskInfo) error
 	}
 
 	timerProcessor interface {
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"sync"
 	"testing"
 	"time"
@@ -4246,6 +4247,211 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+// Test signal a workflow execution whose first decision is still waiting for the start delay
+func (s *engineSuite) TestSignalWorkflowExecution_WaitingToStart() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(testDomainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			SignalName:        common.StringPtr(signalName),
+			Input:             input,
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	startEvent := addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	startEvent.WorkflowExecutionStartedEventAttributes.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(60)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = testDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.NotNil(updateRequest)
+
+	// the signal is delivered with the first decision once the start delay fires
+	s.Equal(common.EmptyEventID, updateRequest.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		s.NotEqual(p.TransferTaskTypeDecisionTask, task.GetType())
+	}
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = testDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &history.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(testDomainID),
+			WorkflowExecution: &we,
+			Reason:            common.StringPtr("pause reason"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.NotNil(updateRequest)
+	s.True(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Paused)
+
+	// pausing a paused workflow execution is a noop
+	err = s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &history.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(testDomainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.mockExecutionMgr.AssertNumberOfCalls(s.T(), "UpdateWorkflowExecution", 1)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = testDomainID
+	ms.ExecutionInfo.Paused = true
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), &history.UnpauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		UnpauseRequest: &workflow.UnpauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(testDomainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.NotNil(updateRequest)
+	s.False(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Paused)
+
+	// the tasks dropped while paused are generated again
+	decisionTaskGenerated := false
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		if task.GetType() == p.TransferTaskTypeDecisionTask {
+			decisionTaskGenerated = true
+		}
+	}
+	s.True(decisionTaskGenerated)
+	workflowTimeoutTaskGenerated := false
+	for _, task := range updateRequest.UpdateWorkflowMutation.TimerTasks {
+		if task.GetType() == p.TaskTypeWorkflowTimeout {
+			workflowTimeoutTaskGenerated = true
+		}
+	}
+	s.True(workflowTimeoutTaskGenerated)
+}
+
+func (s *engineSuite) TestUpsertWorkflowExecutionAttributes() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = testDomainID
+	ms.ExecutionInfo.SearchAttributes = map[string][]byte{"CustomKeywordField": []byte(`"old"`), "CustomIntField": []byte("1")}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	var appendRequest *p.AppendHistoryNodesRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(arguments mock.Arguments) {
+		appendRequest = arguments.Get(0).(*p.AppendHistoryNodesRequest)
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.UpsertWorkflowExecutionAttributes(context.Background(), &history.UpsertWorkflowExecutionAttributesRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		UpsertRequest: &workflow.UpsertWorkflowExecutionAttributesRequest{
+			Domain:            common.StringPtr(testDomainID),
+			WorkflowExecution: &we,
+			SearchAttributes: &workflow.SearchAttributes{
+				IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"new"`)},
+			},
+			Memo:     &workflow.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.NotNil(updateRequest)
+	executionInfo := updateRequest.UpdateWorkflowMutation.ExecutionInfo
+	s.Equal(map[string][]byte{"CustomKeywordField": []byte(`"new"`), "CustomIntField": []byte("1")}, executionInfo.SearchAttributes)
+	s.Equal(map[string][]byte{"key": []byte("value")}, executionInfo.Memo)
+
+	// the attributes are recorded in the history and the visibility record is updated
+	s.Equal(workflow.EventTypeWorkflowExecutionAttributesUpserted, appendRequest.Events[0].GetEventType())
+	upsertTaskGenerated := false
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		if task.GetType() == p.TransferTaskTypeUpsertWorkflowSearchAttributes {
+			upsertTaskGenerated = true
+		}
+	}
+	s.True(upsertTaskGenerated)
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &history.SignalWorkflowExecutionRequest{}
@@ -4362,6 +4568,154 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestReadTaskDLQ() {
+	dlqTask := &p.DLQTaskInfo{
+		Category:    p.DLQCategoryTimer,
+		TaskID:      123,
+		Attempt:     100,
+		LastError:   "some random error",
+		EnqueueTime: time.Now(),
+		TimerTask: &p.TimerTaskInfo{
+			DomainID:            testDomainID,
+			WorkflowID:          "wId",
+			RunID:               testRunID,
+			TaskID:              123,
+			TaskType:            p.TaskTypeUserTimer,
+			VisibilityTimestamp: time.Now(),
+		},
+	}
+	s.mockExecutionMgr.On("GetTasksFromDLQ", &p.GetTasksFromDLQRequest{
+		Category:     p.DLQCategoryTimer,
+		ReadLevel:    122,
+		MaxReadLevel: 123,
+		BatchSize:    taskDLQPageSize,
+	}).Return(&p.GetTasksFromDLQResponse{Tasks: []*p.DLQTaskInfo{dlqTask}}, nil).Once()
+
+	response, err := s.mockHistoryEngine.ReadTaskDLQ(context.Background(), &workflow.ReadTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: workflow.TaskDLQCategoryTimer.Ptr(),
+		TaskID:   common.Int64Ptr(123),
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Entries))
+	entry := response.Entries[0]
+	s.Equal(workflow.TaskDLQCategoryTimer, entry.GetCategory())
+	s.Equal(int64(123), entry.GetTaskID())
+	s.Equal(int32(p.TaskTypeUserTimer), entry.GetTaskType())
+	s.Equal(testDomainID, entry.GetDomainID())
+	s.Equal("wId", entry.GetWorkflowID())
+	s.Equal(testRunID, entry.GetRunID())
+	s.Equal(int32(100), entry.GetAttempt())
+	s.Equal("some random error", entry.GetLastError())
+	s.NotEmpty(entry.GetDetails())
+}
+
+func (s *engineSuite) TestReadTaskDLQ_TaskNotFound() {
+	s.mockExecutionMgr.On("GetTasksFromDLQ", mock.Anything).Return(&p.GetTasksFromDLQResponse{}, nil).Once()
+
+	_, err := s.mockHistoryEngine.ReadTaskDLQ(context.Background(), &workflow.ReadTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: workflow.TaskDLQCategoryTransfer.Ptr(),
+		TaskID:   common.Int64Ptr(123),
+	})
+	s.Equal(ErrTaskNotFoundInDLQ, err)
+}
+
+func (s *engineSuite) TestReplayTaskDLQ() {
+	transferTask := &p.TransferTaskInfo{TaskID: 123}
+	timerTask := &p.TimerTaskInfo{TaskID: 124}
+	s.mockExecutionMgr.On("GetTasksFromDLQ", &p.GetTasksFromDLQRequest{
+		Category:      p.DLQCategoryTransfer,
+		ReadLevel:     0,
+		MaxReadLevel:  math.MaxInt64,
+		BatchSize:     2,
+		NextPageToken: []byte{1},
+	}).Return(&p.GetTasksFromDLQResponse{
+		Tasks: []*p.DLQTaskInfo{
+			{Category: p.DLQCategoryTransfer, TaskID: 123, TransferTask: transferTask},
+			{Category: p.DLQCategoryTransfer, TaskID: 124, TimerTask: timerTask},
+		},
+		NextPageToken: []byte{2},
+	}, nil).Once()
+	s.mockTxProcessor.On("ReplayTaskFromDLQ", transferTask).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteTaskFromDLQ", &p.DeleteTaskFromDLQRequest{
+		Category: p.DLQCategoryTransfer,
+		TaskID:   123,
+	}).Return(nil).Once()
+	// the size of DLQ is refreshed after the replay
+	s.mockExecutionMgr.On("GetTasksFromDLQ", mock.Anything).Return(&p.GetTasksFromDLQResponse{
+		Tasks: []*p.DLQTaskInfo{{Category: p.DLQCategoryTransfer, TaskID: 124, TimerTask: timerTask}},
+	}, nil).Once()
+
+	response, err := s.mockHistoryEngine.ReplayTaskDLQ(context.Background(), &workflow.ReplayTaskDLQRequest{
+		ShardID:         common.Int32Ptr(1),
+		Category:        workflow.TaskDLQCategoryTransfer.Ptr(),
+		MaximumPageSize: common.Int32Ptr(2),
+		NextPageToken:   []byte{1},
+	})
+	s.NoError(err)
+	s.Equal(int32(1), response.GetReplayedCount())
+	// the corrupted entry is kept in DLQ
+	s.Equal(int32(1), response.GetFailedCount())
+	s.Equal([]byte{2}, response.GetNextPageToken())
+}
+
+func (s *engineSuite) TestReplayTaskDLQ_Canceled() {
+	transferTask := &p.TransferTaskInfo{TaskID: 123}
+	s.mockExecutionMgr.On("GetTasksFromDLQ", mock.Anything).Return(&p.GetTasksFromDLQResponse{
+		Tasks: []*p.DLQTaskInfo{{Category: p.DLQCategoryTransfer, TaskID: 123, TransferTask: transferTask}},
+	}, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.mockHistoryEngine.ReplayTaskDLQ(ctx, &workflow.ReplayTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: workflow.TaskDLQCategoryTransfer.Ptr(),
+	})
+	s.Equal(context.Canceled, err)
+	s.mockTxProcessor.AssertNotCalled(s.T(), "ReplayTaskFromDLQ", transferTask)
+}
+
+func (s *engineSuite) TestReplayTaskDLQ_TaskFailed() {
+	timerTask := &p.TimerTaskInfo{TaskID: 123}
+	s.mockExecutionMgr.On("GetTasksFromDLQ", mock.Anything).Return(&p.GetTasksFromDLQResponse{
+		Tasks: []*p.DLQTaskInfo{{Category: p.DLQCategoryTimer, TaskID: 123, TimerTask: timerTask}},
+	}, nil).Once()
+	s.mockTimerProcessor.On("ReplayTaskFromDLQ", timerTask).Return(ErrTaskRetry).Once()
+
+	_, err := s.mockHistoryEngine.ReplayTaskDLQ(context.Background(), &workflow.ReplayTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: workflow.TaskDLQCategoryTimer.Ptr(),
+		TaskID:   common.Int64Ptr(123),
+	})
+	s.Equal(ErrTaskRetry, err)
+}
+
+func (s *engineSuite) TestPurgeTaskDLQ() {
+	s.mockExecutionMgr.On("DeleteTaskFromDLQ", &p.DeleteTaskFromDLQRequest{
+		Category: p.DLQCategoryTransfer,
+		TaskID:   123,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("GetTasksFromDLQ", mock.Anything).Return(&p.GetTasksFromDLQResponse{}, nil).Twice()
+	err := s.mockHistoryEngine.PurgeTaskDLQ(context.Background(), &workflow.PurgeTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: workflow.TaskDLQCategoryTransfer.Ptr(),
+		TaskID:   common.Int64Ptr(123),
+	})
+	s.NoError(err)
+
+	s.mockExecutionMgr.On("RangeDeleteTasksFromDLQ", &p.RangeDeleteTasksFromDLQRequest{
+		Category:             p.DLQCategoryTimer,
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   math.MaxInt64,
+	}).Return(nil).Once()
+	err = s.mockHistoryEngine.PurgeTaskDLQ(context.Background(), &workflow.PurgeTaskDLQRequest{
+		ShardID:  common.Int32Ptr(1),
+		Category: workflow.TaskDLQCategoryTimer.Ptr(),
+	})
+	s.NoError(err)
+}
+
 func (s *engineSuite) getBuilder(testDomainID string, we workflow.WorkflowExecution) mutableState {
 	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(testDomainID, we)
 	if err != nil {
@@ -1192,13 +1192,12 @@ func (r *historyReplicator) deserializeBlob(
 	blob *workflow.DataBlob,
 ) ([]*workflow.HistoryEvent, error) {
 
-	if blob.GetEncodingType() != workflow.EncodingTypeThriftRW {
+	switch blob.GetEncodingType() {
+	case workflow.EncodingTypeThriftRW, workflow.EncodingTypeThriftRWSnappy, workflow.EncodingTypeThriftRWZstd:
+	default:
 		return nil, ErrUnknownEncodingType
 	}
-	historyEvents, err := r.historySerializer.DeserializeBatchEvents(&persistence.DataBlob{
-		Encoding: common.EncodingTypeThriftRW,
-		Data:     blob.Data,
-	})
+	historyEvents, err := r.historySerializer.DeserializeBatchEvents(persistence.NewDataBlobFromThrift(blob))
 	if err != nil {
 		return nil, err
 	}
@@ -2533,6 +2533,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_BrandNew() {
 			Mode:                persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 		return true
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
@@ -2915,6 +2916,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			Mode:                persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -2924,6 +2926,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 	s.mockDomainCache.On("GetDomainByID", domainID).Return(
@@ -3052,6 +3055,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			Mode:                persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -3061,6 +3065,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 	s.mockDomainCache.On("GetDomainByID", domainID).Return(
@@ -3189,6 +3194,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			Mode:                persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -3198,6 +3204,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 	s.mockDomainCache.On("GetDomainByID", domainID).Return(
@@ -4180,6 +4187,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 			Mode:                persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -4189,6 +4197,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 
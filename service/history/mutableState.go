@@ -100,11 +100,18 @@ type (
 		AddTimerFiredEvent(int64, string) (*workflow.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *workflow.StartTimerDecisionAttributes) (*workflow.HistoryEvent, *persistence.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) (*workflow.HistoryEvent, error)
+		AddUpsertWorkflowMemoEvent(int64, *workflow.UpsertWorkflowMemoDecisionAttributes) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *h.RequestCancelWorkflowExecutionRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(reason string, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionAttributesUpsertedEvent(*workflow.UpsertWorkflowExecutionAttributesRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(workflow.WorkflowExecution, *h.StartWorkflowExecutionRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(reason string, details []byte, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionTerminatedByNewRunEvent(reason string, identity string, newRunID string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(reason string, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID int64, requestID string, identity string, update *workflow.WorkflowUpdate) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64, acceptedEventID int64, result *workflow.WorkflowUpdateResult) (*workflow.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
@@ -200,15 +207,19 @@ type (
 		ReplicateTimerStartedEvent(*workflow.HistoryEvent) (*persistence.TimerInfo, error)
 		ReplicateTransientDecisionTaskScheduled() (*decisionInfo, error)
 		ReplicateUpsertWorkflowSearchAttributesEvent(*workflow.HistoryEvent)
+		ReplicateUpsertWorkflowMemoEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionCancelRequestedEvent(*workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionCanceledEvent(int64, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionCompletedEvent(int64, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionFailedEvent(int64, *workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionAttributesUpsertedEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionSignaled(*workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*string, workflow.WorkflowExecution, string, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*workflow.HistoryEvent) error
 		SetCurrentBranchToken(branchToken []byte) error
 		SetHistoryBuilder(hBuilder *historyBuilder)
 		SetHistoryTree(treeID string) error
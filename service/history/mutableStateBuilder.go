@@ -22,6 +22,7 @@ package history
 
 import (
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -30,6 +31,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/errors"
@@ -878,7 +880,11 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		workflow.EventTypeMarkerRecorded,
 		workflow.EventTypeStartChildWorkflowExecutionInitiated,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated,
-		workflow.EventTypeUpsertWorkflowSearchAttributes:
+		workflow.EventTypeUpsertWorkflowSearchAttributes,
+		workflow.EventTypeUpsertWorkflowMemo,
+		// update events are recorded while completing the decision which carries the update results
+		workflow.EventTypeWorkflowExecutionUpdateAccepted,
+		workflow.EventTypeWorkflowExecutionUpdateCompleted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -2743,6 +2749,27 @@ func (e *mutableStateBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return event, nil
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowMemoEvent(
+	decisionCompletedEventID int64,
+	request *workflow.UpsertWorkflowMemoDecisionAttributes,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionUpsertWorkflowMemo
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddUpsertWorkflowMemoEvent(decisionCompletedEventID, request)
+	e.ReplicateUpsertWorkflowMemoEvent(event)
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.generateWorkflowSearchAttrTasks(
+		e.unixNanoToTime(event.GetTimestamp()),
+	); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	event *workflow.HistoryEvent,
 ) {
@@ -2753,6 +2780,16 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, upsertSearchAttr)
 }
 
+func (e *mutableStateBuilder) ReplicateUpsertWorkflowMemoEvent(
+	event *workflow.HistoryEvent,
+) {
+
+	upsertMemo := event.UpsertWorkflowMemoEventAttributes.GetMemo().GetFields()
+	currentMemo := e.GetExecutionInfo().Memo
+
+	e.executionInfo.Memo = mergeMapOfByteArray(currentMemo, upsertMemo)
+}
+
 func mergeMapOfByteArray(
 	current map[string][]byte,
 	upsert map[string][]byte,
@@ -3032,6 +3069,30 @@ func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	}
 
 	event := e.hBuilder.AddWorkflowExecutionTerminatedEvent(reason, details, identity)
+	return e.closeAsTerminated(event)
+}
+
+// AddWorkflowExecutionTerminatedByNewRunEvent terminates the workflow execution in favor of the run
+// started by the TerminateIfRunning reuse policy, the new run ID is recorded in the terminated event
+func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedByNewRunEvent(
+	reason string,
+	identity string,
+	newRunID string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowTerminated
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionTerminatedByNewRunEvent(reason, identity, newRunID)
+	return e.closeAsTerminated(event)
+}
+
+func (e *mutableStateBuilder) closeAsTerminated(
+	event *workflow.HistoryEvent,
+) (*workflow.HistoryEvent, error) {
+
 	if err := e.ReplicateWorkflowExecutionTerminatedEvent(event.GetEventId(), event); err != nil {
 		return nil, err
 	}
@@ -3088,6 +3149,132 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionCompletedEventID int64,
+	requestID string,
+	identity string,
+	update *workflow.WorkflowUpdate,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateAccepted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	return e.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, requestID, identity, update), nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	acceptedEventID int64,
+	result *workflow.WorkflowUpdateResult,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	return e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, acceptedEventID, result), nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.generateWorkflowSearchAttrTasks(
+		e.unixNanoToTime(event.GetTimestamp()),
+	); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *workflow.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = true
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.generateWorkflowSearchAttrTasks(
+		e.unixNanoToTime(event.GetTimestamp()),
+	); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *workflow.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = false
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionAttributesUpsertedEvent(
+	request *workflow.UpsertWorkflowExecutionAttributesRequest,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowAttributesUpserted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionAttributesUpsertedEvent(request)
+	e.ReplicateWorkflowExecutionAttributesUpsertedEvent(event)
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.generateWorkflowSearchAttrTasks(
+		e.unixNanoToTime(event.GetTimestamp()),
+	); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionAttributesUpsertedEvent(
+	event *workflow.HistoryEvent,
+) {
+
+	attributes := event.WorkflowExecutionAttributesUpsertedEventAttributes
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(
+		e.executionInfo.SearchAttributes,
+		attributes.GetSearchAttributes().GetIndexedFields(),
+	)
+	e.executionInfo.Memo = mergeMapOfByteArray(
+		e.executionInfo.Memo,
+		attributes.GetMemo().GetFields(),
+	)
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	firstEventID int64,
 	decisionCompletedEventID int64,
@@ -3751,6 +3938,8 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 	// update last update time
 	e.executionInfo.LastUpdatedTimestamp = now
 
+	checksum := e.generateChecksum()
+
 	workflowMutation := &persistence.WorkflowMutation{
 		ExecutionInfo:    e.executionInfo,
 		ReplicationState: e.replicationState,
@@ -3776,6 +3965,7 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 		TimerTasks:       e.insertTimerTasks,
 
 		Condition: e.nextEventIDInDB,
+		Checksum:  checksum,
 	}
 
 	if err := e.cleanupTransaction(transactionPolicy); err != nil {
@@ -3829,6 +4019,8 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 	// update last update time
 	e.executionInfo.LastUpdatedTimestamp = now
 
+	checksum := e.generateChecksum()
+
 	workflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    e.executionInfo,
 		ReplicationState: e.replicationState,
@@ -3846,6 +4038,7 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 		TimerTasks:       e.insertTimerTasks,
 
 		Condition: e.nextEventIDInDB,
+		Checksum:  checksum,
 	}
 
 	if err := e.cleanupTransaction(transactionPolicy); err != nil {
@@ -3854,6 +4047,25 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 	return workflowSnapshot, workflowEventsSeq, nil
 }
 
+func (e *mutableStateBuilder) generateChecksum() checksum.Checksum {
+	if !e.shouldGenerateChecksum() {
+		return checksum.Checksum{}
+	}
+	csum, err := generateMutableStateChecksum(e)
+	if err != nil {
+		e.logger.Warn("failed to generate mutable state checksum", tag.Error(err))
+		return checksum.Checksum{}
+	}
+	return csum
+}
+
+func (e *mutableStateBuilder) shouldGenerateChecksum() bool {
+	if e.domainEntry == nil {
+		return false
+	}
+	return rand.Intn(100) < e.config.MutableStateChecksumGenProbability(e.domainEntry.GetInfo().Name)
+}
+
 func (e *mutableStateBuilder) prepareCloseTransaction(
 	now time.Time,
 	transactionPolicy transactionPolicy,
@@ -293,6 +293,13 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		workflow.EventTypeStartChildWorkflowExecutionInitiated:            true,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated:        true,
 		workflow.EventTypeUpsertWorkflowSearchAttributes:                  true,
+		workflow.EventTypeUpsertWorkflowMemo:                              true,
+	}
+
+	// update events are recorded while completing the decision which carries the update results
+	updateEvents := map[workflow.EventType]bool{
+		workflow.EventTypeWorkflowExecutionUpdateAccepted:  true,
+		workflow.EventTypeWorkflowExecutionUpdateCompleted: true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -308,6 +315,9 @@ OtherEventsLoop:
 		if _, ok := decisionEvents[eventType]; ok {
 			continue OtherEventsLoop
 		}
+		if _, ok := updateEvents[eventType]; ok {
+			continue OtherEventsLoop
+		}
 		otherEvents[eventType] = true
 	}
 
@@ -321,6 +331,9 @@ OtherEventsLoop:
 	for eventType := range decisionEvents {
 		s.False(s.msBuilder.shouldBufferEvent(eventType))
 	}
+	for eventType := range updateEvents {
+		s.False(s.msBuilder.shouldBufferEvent(eventType))
+	}
 	// other events will return false
 	for eventType := range otherEvents {
 		s.True(s.msBuilder.shouldBufferEvent(eventType))
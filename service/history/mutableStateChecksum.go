@@ -0,0 +1,154 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sort"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+)
+
+const (
+	mutableStateChecksumPayloadV1 = 1
+	// mutableStateChecksumPayloadV2 adds the schedule ID, started ID and version of each pending activity
+	mutableStateChecksumPayloadV2 = 2
+
+	// actions taken when the checksum of a loaded mutable state does not match
+	mutableStateChecksumMismatchActionMetric  = "metric"
+	mutableStateChecksumMismatchActionLog     = "log"
+	mutableStateChecksumMismatchActionRebuild = "rebuild"
+)
+
+func generateMutableStateChecksum(
+	msBuilder mutableState,
+) (checksum.Checksum, error) {
+
+	payload := newMutableStateChecksumPayload(msBuilder, mutableStateChecksumPayloadV2)
+	return checksum.GenerateCRC32(payload, mutableStateChecksumPayloadV2)
+}
+
+func verifyMutableStateChecksum(
+	msBuilder mutableState,
+	csum checksum.Checksum,
+) error {
+
+	if csum.Version != mutableStateChecksumPayloadV1 && csum.Version != mutableStateChecksumPayloadV2 {
+		// checksums generated by an unknown payload version cannot be verified
+		return nil
+	}
+	payload := newMutableStateChecksumPayload(msBuilder, csum.Version)
+	return checksum.Verify(payload, csum)
+}
+
+func newMutableStateChecksumPayload(
+	msBuilder mutableState,
+	payloadVersion int,
+) *h.MutableStateChecksumPayload {
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	replicationState := msBuilder.GetReplicationState()
+	payload := &h.MutableStateChecksumPayload{
+		CancelRequested:      common.BoolPtr(executionInfo.CancelRequested),
+		State:                common.Int32Ptr(int32(executionInfo.State)),
+		CloseStatus:          common.Int32Ptr(int32(executionInfo.CloseStatus)),
+		LastFirstEventID:     common.Int64Ptr(executionInfo.LastFirstEventID),
+		NextEventID:          common.Int64Ptr(executionInfo.NextEventID),
+		LastProcessedEventID: common.Int64Ptr(executionInfo.LastProcessedEvent),
+		SignalCount:          common.Int64Ptr(int64(executionInfo.SignalCount)),
+		DecisionAttempt:      common.Int32Ptr(int32(executionInfo.DecisionAttempt)),
+		DecisionVersion:      common.Int64Ptr(executionInfo.DecisionVersion),
+		DecisionScheduledID:  common.Int64Ptr(executionInfo.DecisionScheduleID),
+		DecisionStartedID:    common.Int64Ptr(executionInfo.DecisionStartedID),
+		StickyTaskListName:   common.StringPtr(executionInfo.StickyTaskList),
+	}
+
+	if replicationState != nil {
+		payload.LastWriteVersion = common.Int64Ptr(replicationState.LastWriteVersion)
+		payload.LastWriteEventID = common.Int64Ptr(replicationState.LastWriteEventID)
+	}
+
+	if versionHistories := msBuilder.GetVersionHistories(); versionHistories != nil {
+		payload.VersionHistories = versionHistories.ToThrift()
+	}
+
+	// for each of the pending maps below, the keys (event IDs) are included in the checksum,
+	// sorted so that the result does not depend on the map iteration order
+	timerStartedIDs := make([]int64, 0, len(msBuilder.GetPendingTimerInfos()))
+	for _, ti := range msBuilder.GetPendingTimerInfos() {
+		timerStartedIDs = append(timerStartedIDs, ti.StartedID)
+	}
+	payload.PendingTimerStartedIDs = sortInt64Slice(timerStartedIDs)
+
+	activityInfos := msBuilder.GetPendingActivityInfos()
+	activityScheduledIDs := make([]int64, 0, len(activityInfos))
+	for id := range activityInfos {
+		activityScheduledIDs = append(activityScheduledIDs, id)
+	}
+	payload.PendingActivityScheduledIDs = sortInt64Slice(activityScheduledIDs)
+	if payloadVersion >= mutableStateChecksumPayloadV2 {
+		// activity infos are also covered field by field, in the order of their keys,
+		// so that activity infos out of sync with history are detected
+		activityInfoScheduleIDs := make([]int64, 0, len(activityInfos))
+		activityStartedIDs := make([]int64, 0, len(activityInfos))
+		activityVersions := make([]int64, 0, len(activityInfos))
+		for _, id := range activityScheduledIDs {
+			ai := activityInfos[id]
+			activityInfoScheduleIDs = append(activityInfoScheduleIDs, ai.ScheduleID)
+			activityStartedIDs = append(activityStartedIDs, ai.StartedID)
+			activityVersions = append(activityVersions, ai.Version)
+		}
+		payload.PendingActivityScheduledIDs = activityInfoScheduleIDs
+		payload.PendingActivityStartedIDs = activityStartedIDs
+		payload.PendingActivityVersions = activityVersions
+	}
+
+	childInitiatedIDs := make([]int64, 0, len(msBuilder.GetPendingChildExecutionInfos()))
+	for id := range msBuilder.GetPendingChildExecutionInfos() {
+		childInitiatedIDs = append(childInitiatedIDs, id)
+	}
+	payload.PendingChildInitiatedIDs = sortInt64Slice(childInitiatedIDs)
+
+	signalInitiatedIDs := make([]int64, 0, len(msBuilder.GetPendingSignalExternalInfos()))
+	for id := range msBuilder.GetPendingSignalExternalInfos() {
+		signalInitiatedIDs = append(signalInitiatedIDs, id)
+	}
+	payload.PendingSignalInitiatedIDs = sortInt64Slice(signalInitiatedIDs)
+
+	requestCancelInitiatedIDs := make([]int64, 0, len(msBuilder.GetPendingRequestCancelExternalInfos()))
+	for id := range msBuilder.GetPendingRequestCancelExternalInfos() {
+		requestCancelInitiatedIDs = append(requestCancelInitiatedIDs, id)
+	}
+	payload.PendingReqCancelInitiatedIDs = sortInt64Slice(requestCancelInitiatedIDs)
+
+	return payload
+}
+
+func sortInt64Slice(
+	slice []int64,
+) []int64 {
+
+	sort.Slice(slice, func(i int, j int) bool {
+		return slice[i] < slice[j]
+	})
+	return slice
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	mutableStateChecksumSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		msBuilder     *mockMutableState
+		executionInfo *persistence.WorkflowExecutionInfo
+	}
+)
+
+func TestMutableStateChecksumSuite(t *testing.T) {
+	s := new(mutableStateChecksumSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateChecksumSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.executionInfo = &persistence.WorkflowExecutionInfo{
+		State:              persistence.WorkflowStateRunning,
+		CloseStatus:        persistence.WorkflowCloseStatusNone,
+		NextEventID:        12,
+		LastFirstEventID:   10,
+		LastProcessedEvent: 8,
+		DecisionScheduleID: 10,
+		DecisionStartedID:  11,
+		StickyTaskList:     "some random sticky task list",
+	}
+	s.msBuilder = &mockMutableState{}
+	s.msBuilder.On("GetExecutionInfo").Return(s.executionInfo)
+	s.msBuilder.On("GetReplicationState").Return(&persistence.ReplicationState{
+		LastWriteVersion: 123,
+		LastWriteEventID: 11,
+	})
+	s.msBuilder.On("GetVersionHistories").Return((*persistence.VersionHistories)(nil))
+	s.msBuilder.On("GetPendingTimerInfos").Return(map[string]*persistence.TimerInfo{
+		"timer-1": {StartedID: 5},
+		"timer-2": {StartedID: 3},
+	})
+	s.msBuilder.On("GetPendingActivityInfos").Return(map[int64]*persistence.ActivityInfo{
+		6: {ScheduleID: 6, StartedID: common.EmptyEventID, Version: 100},
+		7: {ScheduleID: 7, StartedID: 8, Version: 101},
+	})
+	s.msBuilder.On("GetPendingChildExecutionInfos").Return(map[int64]*persistence.ChildExecutionInfo{})
+	s.msBuilder.On("GetPendingSignalExternalInfos").Return(map[int64]*persistence.SignalInfo{})
+	s.msBuilder.On("GetPendingRequestCancelExternalInfos").Return(map[int64]*persistence.RequestCancelInfo{})
+}
+
+func (s *mutableStateChecksumSuite) TearDownTest() {
+	s.msBuilder.AssertExpectations(s.T())
+}
+
+func (s *mutableStateChecksumSuite) TestNewPayload_SortedIDs() {
+	payload := newMutableStateChecksumPayload(s.msBuilder, mutableStateChecksumPayloadV2)
+	s.Equal([]int64{3, 5}, payload.PendingTimerStartedIDs)
+	s.Equal([]int64{6, 7}, payload.PendingActivityScheduledIDs)
+	s.Equal([]int64{common.EmptyEventID, 8}, payload.PendingActivityStartedIDs)
+	s.Equal([]int64{100, 101}, payload.PendingActivityVersions)
+	s.Empty(payload.PendingChildInitiatedIDs)
+	s.Equal(int64(123), payload.GetLastWriteVersion())
+	s.Equal(int64(12), payload.GetNextEventID())
+}
+
+func (s *mutableStateChecksumSuite) TestGenerateAndVerify() {
+	csum, err := generateMutableStateChecksum(s.msBuilder)
+	s.NoError(err)
+	s.Equal(mutableStateChecksumPayloadV2, csum.Version)
+	s.Equal(checksum.FlavorIEEECRC32OverThriftBinary, csum.Flavor)
+	s.NoError(verifyMutableStateChecksum(s.msBuilder, csum))
+
+	s.executionInfo.NextEventID++
+	s.Equal(checksum.ErrMismatch, verifyMutableStateChecksum(s.msBuilder, csum))
+}
+
+func (s *mutableStateChecksumSuite) TestVerify_ActivityInfoOutOfSync() {
+	csum, err := generateMutableStateChecksum(s.msBuilder)
+	s.NoError(err)
+
+	s.msBuilder.GetPendingActivityInfos()[6].StartedID = 9
+	s.Equal(checksum.ErrMismatch, verifyMutableStateChecksum(s.msBuilder, csum))
+}
+
+func (s *mutableStateChecksumSuite) TestVerify_PayloadV1() {
+	csum, err := checksum.GenerateCRC32(
+		newMutableStateChecksumPayload(s.msBuilder, mutableStateChecksumPayloadV1),
+		mutableStateChecksumPayloadV1,
+	)
+	s.NoError(err)
+
+	// checksums of the previous payload version only cover the keys of pending activities
+	s.msBuilder.GetPendingActivityInfos()[6].StartedID = 9
+	s.NoError(verifyMutableStateChecksum(s.msBuilder, csum))
+}
+
+func (s *mutableStateChecksumSuite) TestVerify_UnknownPayloadVersion() {
+	csum, err := generateMutableStateChecksum(s.msBuilder)
+	s.NoError(err)
+
+	s.executionInfo.NextEventID++
+	csum.Version = mutableStateChecksumPayloadV2 + 1
+	s.NoError(verifyMutableStateChecksum(s.msBuilder, csum))
+}
+
+func (s *mutableStateChecksumSuite) TestVerifyChecksum_RebuildWithoutVersionHistories() {
+	csum, err := generateMutableStateChecksum(s.msBuilder)
+	s.NoError(err)
+	s.executionInfo.NextEventID++
+
+	metricsScope := tally.NewTestScope("", nil)
+	shard := &shardContextImpl{
+		config:        NewDynamicConfigForTest(),
+		logger:        loggerimpl.NewNopLogger(),
+		metricsClient: metrics.NewClient(metricsScope, metrics.History),
+		timeSource:    clock.NewRealTimeSource(),
+	}
+	shard.config.MutableStateChecksumVerifyProbability = dynamicconfig.GetIntPropertyFilteredByDomain(100)
+	shard.config.MutableStateChecksumMismatchAction = func(string) string {
+		return mutableStateChecksumMismatchActionRebuild
+	}
+	context := newWorkflowExecutionContext(
+		testDomainID,
+		workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr(testRunID),
+		},
+		shard,
+		&mocks.ExecutionManager{},
+		shard.logger,
+	)
+	context.msBuilder = s.msBuilder
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName},
+		&persistence.DomainConfig{},
+		"",
+		nil,
+	)
+
+	// mutable states without version histories cannot be rebuilt, they are still loaded
+	s.NoError(context.verifyChecksum(domainEntry, csum))
+	s.Equal(s.msBuilder, context.msBuilder)
+	s.Equal(int64(1), metricsScope.Snapshot().Counters()["mutable_state_checksum_rebuild_skipped+domain="+testDomainName+",operation=WorkflowContext"].Value())
+}
@@ -360,8 +360,5 @@ func deserializeBlob(
 		return nil, nil
 	}
 
-	return historySerializer.DeserializeBatchEvents(&persistence.DataBlob{
-		Encoding: common.EncodingTypeThriftRW,
-		Data:     blob.Data,
-	})
+	return historySerializer.DeserializeBatchEvents(persistence.NewDataBlobFromThrift(blob))
 }
@@ -92,6 +92,7 @@ func newQueueProcessorBase(
 	taskProcessorOptions := taskProcessorOptions{
 		queueSize:   options.BatchSize(),
 		workerCount: options.WorkerCount(),
+		metricScope: options.MetricScope,
 	}
 	taskProcessor := newTaskProcessor(taskProcessorOptions, shard, historyCache, logger)
 	p := &queueProcessorBase{
@@ -212,6 +213,12 @@ func (p *queueProcessorBase) processBatch() {
 	}
 	cancel()
 
+	if p.taskProcessor.isFull() {
+		// the deferred tasks of busy domains are not dispatched yet
+		p.notifyNewTask() // re-enqueue the event
+		return
+	}
+
 	p.lastPollTime = p.timeSource.Now()
 	tasks, more, err := p.ackMgr.readQueueTasks()
 
@@ -19,3 +19,161 @@
 // THE SOFTWARE.
 
 package history
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	queueProcessorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		logger          log.Logger
+		mockShard       *shardContextImpl
+		mockDomainCache *cache.DomainCacheMock
+		mockProcessor   *MockProcessor
+		mockQueueAckMgr *MockQueueAckMgr
+
+		queueProcessor *queueProcessorBase
+	}
+)
+
+func TestQueueProcessorSuite(t *testing.T) {
+	s := new(queueProcessorSuite)
+	suite.Run(t, s)
+}
+
+func (s *queueProcessorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(testLocalDomainEntry, nil)
+	s.mockProcessor = &MockProcessor{}
+	s.mockQueueAckMgr = &MockQueueAckMgr{}
+	s.mockQueueAckMgr.On("getFinishedChan").Return(nil)
+	s.mockShard = &shardContextImpl{
+		service:                   service.NewTestService(nil, nil, metricsClient, nil, nil, nil, nil),
+		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1, TransferAckLevel: 0},
+		transferSequenceNumber:    1,
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   make(chan int, 100),
+		config:                    NewDynamicConfigForTest(),
+		logger:                    s.logger,
+		domainCache:               s.mockDomainCache,
+		metricsClient:             metricsClient,
+		timeSource:                clock.NewRealTimeSource(),
+	}
+
+	options := &QueueProcessorOptions{
+		BatchSize:                          dynamicconfig.GetIntPropertyFn(2),
+		WorkerCount:                        dynamicconfig.GetIntPropertyFn(1),
+		MaxPollRPS:                         dynamicconfig.GetIntPropertyFn(100),
+		MaxPollInterval:                    dynamicconfig.GetDurationPropertyFn(time.Minute),
+		MaxPollIntervalJitterCoefficient:   dynamicconfig.GetFloatPropertyFn(0.1),
+		UpdateAckInterval:                  dynamicconfig.GetDurationPropertyFn(time.Minute),
+		UpdateAckIntervalJitterCoefficient: dynamicconfig.GetFloatPropertyFn(0.1),
+		MaxRetryCount:                      dynamicconfig.GetIntPropertyFn(10),
+		MetricScope:                        metrics.TransferActiveQueueProcessorScope,
+	}
+	s.queueProcessor = newQueueProcessorBase(
+		"",
+		s.mockShard,
+		options,
+		s.mockProcessor,
+		s.mockQueueAckMgr,
+		nil,
+		s.logger,
+	)
+}
+
+func (s *queueProcessorSuite) TearDownTest() {
+	s.mockProcessor.AssertExpectations(s.T())
+	s.mockQueueAckMgr.AssertExpectations(s.T())
+}
+
+func (s *queueProcessorSuite) TestProcessorPump_NoisyDomainDoesNotBlockQuietDomain() {
+	noisyDomainID := "noisy-domain"
+	quietDomainID := "quiet-domain"
+	var tasks []queueTaskInfo
+	for taskID := int64(1); taskID <= 6; taskID++ {
+		tasks = append(tasks, &persistence.TransferTaskInfo{DomainID: noisyDomainID, TaskID: taskID})
+	}
+	tasks = append(tasks, &persistence.TransferTaskInfo{DomainID: quietDomainID, TaskID: 7})
+
+	releaseNoisyTasks := make(chan struct{})
+	readDeferred := make(chan bool, 1)
+	s.mockQueueAckMgr.On("readQueueTasks").Return(tasks, true, nil).Once()
+	s.mockQueueAckMgr.On("readQueueTasks").Return([]queueTaskInfo{}, false, nil).Run(func(arguments mock.Arguments) {
+		// the next batch is only loaded once the deferred tasks of the noisy domain are dispatched
+		select {
+		case <-releaseNoisyTasks:
+			readDeferred <- true
+		default:
+			readDeferred <- false
+		}
+	}).Once()
+
+	var lock sync.Mutex
+	var processedDomainIDs []string
+	var completedWG sync.WaitGroup
+	completedWG.Add(len(tasks))
+	s.mockProcessor.On("getTaskFilter").Return(queueTaskFilter(func(task queueTaskInfo) (bool, error) {
+		return true, nil
+	}))
+	s.mockProcessor.On("process", mock.Anything, true).Return(metrics.TransferActiveQueueProcessorScope, nil).Run(func(arguments mock.Arguments) {
+		task := arguments.Get(0).(queueTaskInfo)
+		if task.GetDomainID() == noisyDomainID {
+			<-releaseNoisyTasks
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		processedDomainIDs = append(processedDomainIDs, task.GetDomainID())
+	})
+	s.mockProcessor.On("complete", mock.Anything).Run(func(arguments mock.Arguments) {
+		completedWG.Done()
+	})
+
+	s.queueProcessor.Start()
+	defer s.queueProcessor.Stop()
+
+	// the pump schedules the whole batch while the only worker is stuck on a task of the noisy domain
+	scheduled := false
+	for i := 0; i < 100 && !scheduled; i++ {
+		time.Sleep(10 * time.Millisecond)
+		scheduled = s.queueProcessor.taskProcessor.scheduler.len() == len(tasks)-1
+	}
+	s.True(scheduled)
+	s.True(s.queueProcessor.taskProcessor.isFull())
+
+	close(releaseNoisyTasks)
+	completedWG.Wait()
+	s.True(<-readDeferred)
+
+	// the quiet domain is dispatched ahead of the backlog of the noisy domain
+	quietTaskIndex := -1
+	for index, domainID := range processedDomainIDs {
+		if domainID == quietDomainID {
+			quietTaskIndex = index
+		}
+	}
+	s.True(quietTaskIndex >= 0 && quietTaskIndex <= 2)
+}
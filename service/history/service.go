@@ -57,15 +57,17 @@ type Config struct {
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
-	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
-	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
-	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	HistoryCacheInitialSize    dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSize        dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSizeInBytes dynamicconfig.IntPropertyFn
+	HistoryCacheTTL            dynamicconfig.DurationPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
-	EventsCacheInitialSize dynamicconfig.IntPropertyFn
-	EventsCacheMaxSize     dynamicconfig.IntPropertyFn
-	EventsCacheTTL         dynamicconfig.DurationPropertyFn
+	EventsCacheInitialSize    dynamicconfig.IntPropertyFn
+	EventsCacheMaxSize        dynamicconfig.IntPropertyFn
+	EventsCacheMaxSizeInBytes dynamicconfig.IntPropertyFn
+	EventsCacheTTL            dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
 	RangeSizeBits        uint
@@ -105,6 +107,12 @@ type Config struct {
 	TransferProcessorCompleteTransferInterval           dynamicconfig.DurationPropertyFn
 	TransferProcessorVisibilityArchivalTimeLimit        dynamicconfig.DurationPropertyFn
 
+	// TaskScheduler settings
+	TaskSchedulerDomainWeight dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// TaskDLQ settings
+	TaskMaxAttemptsBeforeDLQ dynamicconfig.IntPropertyFn
+
 	// ReplicatorQueueProcessor settings
 	ReplicatorTaskBatchSize                               dynamicconfig.IntPropertyFn
 	ReplicatorTaskWorkerCount                             dynamicconfig.IntPropertyFn
@@ -135,6 +143,10 @@ type Config struct {
 
 	// encoding the history events
 	EventEncodingType dynamicconfig.StringPropertyFnWithDomainFilter
+	// encoding (optionally compressed) of newly appended history event batches, falls back to EventEncodingType if empty
+	EventBatchEncodingType dynamicconfig.StringPropertyFnWithDomainFilter
+	// whether or not to encrypt history events and mutable state payloads at rest
+	EnablePayloadEncryption dynamicconfig.BoolPropertyFnWithDomainFilter
 	// whether or not using ParentClosePolicy
 	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithDomainFilter
 	// whether or not enable system workers for processing parent close policy task
@@ -172,6 +184,14 @@ type Config struct {
 	DecisionHeartbeatTimeout dynamicconfig.DurationPropertyFnWithDomainFilter
 	// MaxDecisionStartToCloseSeconds is the StartToCloseSeconds for decision
 	MaxDecisionStartToCloseSeconds dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// Mutable state checksum settings
+	// MutableStateChecksumGenProbability is the probability [0-100] that a checksum is generated on mutable state update
+	MutableStateChecksumGenProbability dynamicconfig.IntPropertyFnWithDomainFilter
+	// MutableStateChecksumVerifyProbability is the probability [0-100] that a checksum is verified on mutable state load
+	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithDomainFilter
+	// MutableStateChecksumMismatchAction is the action taken on checksum mismatch: metric, log or rebuild
+	MutableStateChecksumMismatchAction dynamicconfig.StringPropertyFnWithDomainFilter
 }
 
 const (
@@ -230,6 +250,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		TransferProcessorUpdateAckIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.TransferProcessorUpdateAckIntervalJitterCoefficient, 0.15),
 		TransferProcessorCompleteTransferInterval:             dc.GetDurationProperty(dynamicconfig.TransferProcessorCompleteTransferInterval, 60*time.Second),
 		TransferProcessorVisibilityArchivalTimeLimit:          dc.GetDurationProperty(dynamicconfig.TransferProcessorVisibilityArchivalTimeLimit, 1*time.Second),
+		TaskSchedulerDomainWeight:                             dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskSchedulerDomainWeight, 1),
+		TaskMaxAttemptsBeforeDLQ:                              dc.GetIntProperty(dynamicconfig.TaskMaxAttemptsBeforeDLQ, 100),
 		ReplicatorTaskBatchSize:                               dc.GetIntProperty(dynamicconfig.ReplicatorTaskBatchSize, 100),
 		ReplicatorTaskWorkerCount:                             dc.GetIntProperty(dynamicconfig.ReplicatorTaskWorkerCount, 10),
 		ReplicatorTaskMaxRetryCount:                           dc.GetIntProperty(dynamicconfig.ReplicatorTaskMaxRetryCount, 100),
@@ -249,6 +271,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
 		EventEncodingType:                   dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.DefaultEventEncoding, string(common.EncodingTypeThriftRW)),
+		EventBatchEncodingType:              dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.EventBatchEncoding, string(common.EncodingTypeEmpty)),
+		EnablePayloadEncryption:             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnablePayloadEncryption, false),
 		EnableParentClosePolicy:             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableParentClosePolicy, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
@@ -272,6 +296,13 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		StickyTTL:                         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.StickyTTL, time.Hour*24*365),
 		DecisionHeartbeatTimeout:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.DecisionHeartbeatTimeout, time.Minute*30),
+
+		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability, 0),
+		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVerifyProbability, 100),
+		MutableStateChecksumMismatchAction:    dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.MutableStateChecksumMismatchAction, mutableStateChecksumMismatchActionMetric),
+
+		HistoryCacheMaxSizeInBytes: dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSizeInBytes, 0),
+		EventsCacheMaxSizeInBytes:  dc.GetIntProperty(dynamicconfig.EventsCacheMaxSizeInBytes, 0),
 	}
 
 	return cfg
@@ -327,7 +358,10 @@ func (s *Service) Start() {
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+	pFactory, err := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+	if err != nil {
+		log.Fatal("failed to create persistence factory", tag.Error(err))
+	}
 
 	shardMgr, err := pFactory.NewShardManager()
 	if err != nil {
@@ -440,6 +440,8 @@ func (s *shardContextImpl) CreateWorkflowExecution(
 	}
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
+	request.Encoding = s.getDefaultEncoding(domainEntry)
+
 Create_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
@@ -489,7 +491,27 @@ Create_Loop:
 }
 
 func (s *shardContextImpl) getDefaultEncoding(domainEntry *cache.DomainCacheEntry) common.EncodingType {
-	return common.EncodingType(s.config.EventEncodingType(domainEntry.GetInfo().Name))
+	encoding := common.EncodingType(s.config.EventEncodingType(domainEntry.GetInfo().Name))
+	return s.getPayloadEncoding(domainEntry, encoding)
+}
+
+func (s *shardContextImpl) getEventBatchEncoding(domainEntry *cache.DomainCacheEntry) common.EncodingType {
+	encoding := common.EncodingType(s.config.EventBatchEncodingType(domainEntry.GetInfo().Name))
+	if encoding == common.EncodingTypeEmpty {
+		encoding = common.EncodingType(s.config.EventEncodingType(domainEntry.GetInfo().Name))
+	}
+	return s.getPayloadEncoding(domainEntry, encoding)
+}
+
+func (s *shardContextImpl) getPayloadEncoding(
+	domainEntry *cache.DomainCacheEntry,
+	encoding common.EncodingType,
+) common.EncodingType {
+
+	if s.config.EnablePayloadEncryption(domainEntry.GetInfo().Name) {
+		return persistence.EncryptedEncoding(encoding)
+	}
+	return encoding
 }
 
 func (s *shardContextImpl) UpdateWorkflowExecution(
@@ -779,7 +801,7 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 		return 0, err
 	}
 
-	request.Encoding = s.getDefaultEncoding(domainEntry)
+	request.Encoding = s.getEventBatchEncoding(domainEntry)
 	request.ShardID = common.IntPtr(s.shardID)
 	request.TransactionID = transactionID
 
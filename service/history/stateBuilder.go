@@ -470,6 +470,10 @@ func (b *stateBuilderImpl) applyEvents(
 		case shared.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case shared.EventTypeWorkflowExecutionUpdateAccepted,
+			shared.EventTypeWorkflowExecutionUpdateCompleted:
+			// No mutable state action is needed
+
 		case shared.EventTypeWorkflowExecutionSignaled:
 			if err := b.msBuilder.ReplicateWorkflowExecutionSignaled(event); err != nil {
 				return nil, nil, nil, err
@@ -534,6 +538,26 @@ func (b *stateBuilderImpl) applyEvents(
 			b.msBuilder.ReplicateUpsertWorkflowSearchAttributesEvent(event)
 			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
 
+		case shared.EventTypeUpsertWorkflowMemo:
+			b.msBuilder.ReplicateUpsertWorkflowMemoEvent(event)
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
+
+		case shared.EventTypeWorkflowExecutionPaused:
+			if err := b.msBuilder.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
+
+		case shared.EventTypeWorkflowExecutionUnpaused:
+			if err := b.msBuilder.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
+
+		case shared.EventTypeWorkflowExecutionAttributesUpserted:
+			b.msBuilder.ReplicateWorkflowExecutionAttributesUpsertedEvent(event)
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
+
 		case shared.EventTypeWorkflowExecutionContinuedAsNew:
 			if len(newRunHistory) == 0 {
 				return nil, nil, nil, errors.NewInternalFailureError(ErrMessageNewRunHistorySizeZero)
@@ -2098,9 +2098,73 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttribu
 	s.Empty(s.stateBuilder.newRunTransferTasks)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowMemo() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(testRunID),
+	}
+
+	now := time.Now()
+	evenType := shared.EventTypeUpsertWorkflowMemo
+	event := &shared.HistoryEvent{
+		Version:                           common.Int64Ptr(version),
+		EventId:                           common.Int64Ptr(130),
+		Timestamp:                         common.Int64Ptr(now.UnixNano()),
+		EventType:                         &evenType,
+		UpsertWorkflowMemoEventAttributes: &shared.UpsertWorkflowMemoEventAttributes{},
+	}
+	s.mockMutableState.On("ReplicateUpsertWorkflowMemoEvent", event).Return(nil).Once()
+	s.mockUpdateVersion(event)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+
+	s.mockMutableState.On("ClearStickyness").Once()
+	_, _, _, err := s.stateBuilder.applyEvents(testDomainID, requestID, execution, s.toHistory(event), nil, false)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Equal(1, len(s.stateBuilder.transferTasks))
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionAttributesUpserted() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(testRunID),
+	}
+
+	now := time.Now()
+	evenType := shared.EventTypeWorkflowExecutionAttributesUpserted
+	event := &shared.HistoryEvent{
+		Version:   common.Int64Ptr(version),
+		EventId:   common.Int64Ptr(130),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		WorkflowExecutionAttributesUpsertedEventAttributes: &shared.WorkflowExecutionAttributesUpsertedEventAttributes{},
+	}
+	s.mockMutableState.On("ReplicateWorkflowExecutionAttributesUpsertedEvent", event).Once()
+	s.mockUpdateVersion(event)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+
+	s.mockMutableState.On("ClearStickyness").Once()
+	_, _, _, err := s.stateBuilder.applyEvents(testDomainID, requestID, execution, s.toHistory(event), nil, false)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Equal(1, len(s.stateBuilder.transferTasks))
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
 func (s *stateBuilderSuite) TestApplyEventsNewEventsNotHandled() {
 	eventTypes := shared.EventType_Values()
-	s.Equal(42, len(eventTypes), "If you see this error, you are adding new event type. "+
+	s.Equal(48, len(eventTypes), "If you see this error, you are adding new event type. "+
 		"Before updating the number to make this test pass, please make sure you update stateBuilderImpl.applyEvents method "+
 		"to handle the new decision type. Otherwise cross dc will not work on the new event.")
 }
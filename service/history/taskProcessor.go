@@ -22,6 +22,7 @@ package history
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -40,11 +41,16 @@ type (
 	taskProcessorOptions struct {
 		queueSize   int
 		workerCount int
+		metricScope int
 	}
 
 	taskInfo struct {
 		processor taskExecutor
 		task      queueTaskInfo
+
+		// set by the task processor when the task is scheduled
+		domainName  string
+		enqueueTime time.Time
 	}
 
 	taskProcessor struct {
@@ -52,7 +58,8 @@ type (
 		cache         *historyCache
 		shutdownWG    sync.WaitGroup
 		shutdownCh    chan struct{}
-		tasksCh       chan *taskInfo
+		scheduler     *taskScheduler
+		metricScope   int
 		config        *Config
 		logger        log.Logger
 		metricsClient metrics.Client
@@ -85,7 +92,8 @@ func newTaskProcessor(
 		shard:                   shard,
 		cache:                   historyCache,
 		shutdownCh:              make(chan struct{}),
-		tasksCh:                 make(chan *taskInfo, options.queueSize),
+		scheduler:               newTaskScheduler(options.queueSize),
+		metricScope:             options.metricScope,
 		config:                  shard.GetConfig(),
 		logger:                  log,
 		metricsClient:           shard.GetMetricsClient(),
@@ -109,7 +117,7 @@ func (t *taskProcessor) start() {
 
 func (t *taskProcessor) stop() {
 	close(t.shutdownCh)
-	close(t.tasksCh)
+	t.scheduler.close()
 	if success := common.AwaitWaitGroup(&t.workerWG, time.Minute); !success {
 		t.logger.Warn("Timer queue task processor timedout on shutdown.")
 	}
@@ -122,15 +130,14 @@ func (t *taskProcessor) taskWorker(
 	defer t.workerWG.Done()
 
 	for {
-		select {
-		case <-t.shutdownCh:
+		// scheduler is closed on shutdown
+		task, ok := t.scheduler.get()
+		if !ok {
 			return
-		case task, ok := <-t.tasksCh:
-			if !ok {
-				return
-			}
-			t.processTaskAndAck(notificationChan, task)
 		}
+		t.metricsClient.Scope(t.metricScope, metrics.DomainTag(task.domainName)).
+			RecordTimer(metrics.TaskSchedulerQueueLatency, t.timeSource.Now().Sub(task.enqueueTime))
+		t.processTaskAndAck(notificationChan, task)
 	}
 }
 
@@ -147,14 +154,32 @@ func (t *taskProcessor) addTask(
 	task *taskInfo,
 ) bool {
 	// We have a timer to fire.
-	select {
-	case t.tasksCh <- task:
-	case <-t.shutdownCh:
+	task.domainName = t.getDomainName(task.task.GetDomainID())
+	task.enqueueTime = t.timeSource.Now()
+	if ok := t.scheduler.put(task, t.config.TaskSchedulerDomainWeight(task.domainName)); !ok {
 		return true
 	}
 	return false
 }
 
+// isFull returns whether the scheduled tasks reach the capacity of the task processor,
+// the queue processors defer loading more tasks until then
+func (t *taskProcessor) isFull() bool {
+	return t.scheduler.isFull()
+}
+
+func (t *taskProcessor) getDomainName(
+	domainID string,
+) string {
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		// the task filter will surface the error, schedule with the default weight
+		return ""
+	}
+	return domainEntry.GetInfo().Name
+}
+
 func (t *taskProcessor) processTaskAndAck(
 	notificationChan <-chan struct{},
 	task *taskInfo,
@@ -190,16 +215,28 @@ FilterLoop:
 		}
 	}
 
+	// only non transient errors count towards moving the task to DLQ
+	failedAttempt := 0
 	op := func() error {
 		scope, err = t.processTaskOnce(notificationChan, task, shouldProcessTask, logger)
-		return t.handleTaskError(scope, startTime, notificationChan, err, logger)
+		handledErr := t.handleTaskError(scope, startTime, notificationChan, err, logger)
+		if handledErr != nil && !isTransientTaskError(handledErr) {
+			failedAttempt++
+		}
+		return handledErr
 	}
 	retryCondition := func(err error) bool {
 		select {
 		case <-t.shutdownCh:
 			return false
 		default:
-			return true
+			if isTransientTaskError(err) {
+				return true
+			}
+			// stop retrying once the attempts are exhausted, if moving the task to DLQ
+			// fails the task is retried with backoff again before the next try
+			maxAttempts := t.config.TaskMaxAttemptsBeforeDLQ()
+			return maxAttempts <= 0 || failedAttempt != maxAttempts
 		}
 	}
 
@@ -215,10 +252,145 @@ FilterLoop:
 				return
 			}
 			incAttempt()
+			maxAttempts := t.config.TaskMaxAttemptsBeforeDLQ()
+			if maxAttempts > 0 && failedAttempt >= maxAttempts && t.moveTaskToDLQ(task, scope, attempt, err, logger) {
+				// the task is now owned by the DLQ, ack it so the ack level can move forward
+				t.ackTaskOnce(task, scope, false, startTime, attempt)
+				return
+			}
 		}
 	}
 }
 
+func (t *taskProcessor) moveTaskToDLQ(
+	task *taskInfo,
+	scope int,
+	attempt int,
+	taskErr error,
+	logger log.Logger,
+) bool {
+
+	dlqTask := &persistence.DLQTaskInfo{
+		TaskID:      task.task.GetTaskID(),
+		Attempt:     attempt,
+		LastError:   taskErr.Error(),
+		EnqueueTime: t.timeSource.Now(),
+	}
+	switch queueTask := task.task.(type) {
+	case *persistence.TransferTaskInfo:
+		dlqTask.Category = persistence.DLQCategoryTransfer
+		dlqTask.TransferTask = queueTask
+	case *persistence.TimerTaskInfo:
+		dlqTask.Category = persistence.DLQCategoryTimer
+		dlqTask.TimerTask = queueTask
+	default:
+		// replication tasks have their own retry handling and are never moved to DLQ
+		return false
+	}
+
+	metricsScope := t.metricsClient.Scope(scope, metrics.DomainTag(task.domainName))
+	if err := t.shard.GetExecutionManager().PutTaskToDLQ(&persistence.PutTaskToDLQRequest{
+		Task: dlqTask,
+	}); err != nil {
+		metricsScope.IncCounter(metrics.TaskMoveToDLQFailedCounter)
+		logger.Error("Fail to move task to DLQ.", tag.Error(err))
+		return false
+	}
+
+	metricsScope.IncCounter(metrics.TaskMovedToDLQCounter)
+	logger.Error("Critical error processing task, moved to DLQ.", tag.Error(taskErr), tag.Attempt(int32(attempt)), tag.OperationCritical)
+	emitTaskDLQSize(t.shard, dlqTask.Category, logger)
+	return true
+}
+
+// emitTaskDLQSize reports the number of tasks of the category in the DLQ of the shard, it is refreshed
+// whenever tasks are moved to, replayed from or purged from the DLQ, at most taskDLQSizeMaxPages pages
+// of the DLQ are read so the gauge saturates for a very large DLQ
+func emitTaskDLQSize(
+	shard ShardContext,
+	category int,
+	logger log.Logger,
+) {
+
+	var scope int
+	switch category {
+	case persistence.DLQCategoryTransfer:
+		scope = metrics.TransferQueueProcessorScope
+	case persistence.DLQCategoryTimer:
+		scope = metrics.TimerQueueProcessorScope
+	default:
+		return
+	}
+
+	size := 0
+	request := &persistence.GetTasksFromDLQRequest{
+		Category:     category,
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    taskDLQPageSize,
+	}
+	for page := 0; page < taskDLQSizeMaxPages; page++ {
+		response, err := shard.GetExecutionManager().GetTasksFromDLQ(request)
+		if err != nil {
+			logger.Warn("Fail to read the size of DLQ.", tag.Error(err))
+			return
+		}
+		size += len(response.Tasks)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	shard.GetMetricsClient().Scope(scope, metrics.ShardTag(shard.GetShardID())).
+		UpdateGauge(metrics.TaskDLQSize, float64(size))
+}
+
+// replayQueueTask processes the task with the first executor whose task filter accepts it,
+// the task is done if none of the executors accepts it or its domain or workflow execution no longer exists
+func replayQueueTask(
+	task queueTaskInfo,
+	executors []taskExecutor,
+) error {
+
+	for _, executor := range executors {
+		shouldProcessTask, err := executor.getTaskFilter()(task)
+		if err == nil && !shouldProcessTask {
+			continue
+		}
+		if err == nil {
+			_, err = executor.process(task, true)
+		}
+		switch err.(type) {
+		case *workflow.EntityNotExistsError, *WorkflowPausedError:
+			return nil
+		}
+		if err == ErrTaskDiscarded {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func isTransientTaskError(
+	err error,
+) bool {
+
+	if err == ErrTaskRetry {
+		return true
+	}
+
+	switch err.(type) {
+	case *workflow.DomainNotActiveError,
+		*workflow.ServiceBusyError,
+		*workflow.LimitExceededError,
+		*persistence.TimeoutError,
+		*persistence.ShardOwnershipLostError:
+		return true
+	}
+	return false
+}
+
 func (t *taskProcessor) processTaskOnce(
 	notificationChan <-chan struct{},
 	task *taskInfo,
@@ -269,6 +441,12 @@ func (t *taskProcessor) handleTaskError(
 		err = nil
 	}
 
+	// the task is dropped, unpausing the workflow execution regenerates it
+	if _, ok := err.(*WorkflowPausedError); ok {
+		t.metricsClient.IncCounter(scope, metrics.TaskWorkflowPausedCounter)
+		return nil
+	}
+
 	// this is a transient error
 	if _, ok := err.(*workflow.DomainNotActiveError); ok {
 		if t.timeSource.Now().Sub(startTime) > cache.DomainCacheRefreshInterval {
@@ -22,32 +22,38 @@ package history
 
 import (
 	"errors"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	taskProcessorSuite struct {
-		clusterName     string
-		logger          log.Logger
-		mockService     service.Service
-		mockShard       ShardContext
-		mockDomainCache *cache.DomainCacheMock
-		mockProcessor   *MockTimerProcessor
-		mockQueueAckMgr *MockTimerQueueAckMgr
+		clusterName      string
+		logger           log.Logger
+		mockService      service.Service
+		mockShard        ShardContext
+		mockDomainCache  *cache.DomainCacheMock
+		mockProcessor    *MockTimerProcessor
+		mockQueueAckMgr  *MockTimerQueueAckMgr
+		mockExecutionMgr *mocks.ExecutionManager
 
 		scope            int
 		notificationChan chan struct{}
@@ -78,6 +84,7 @@ func (s *taskProcessorSuite) SetupTest() {
 	s.mockProcessor = &MockTimerProcessor{}
 	s.mockQueueAckMgr = &MockTimerQueueAckMgr{}
 	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
 	s.mockService = service.NewTestService(nil, nil, metricsClient, nil, nil, nil, nil)
 	s.mockShard = &shardContextImpl{
 		service:                   s.mockService,
@@ -88,6 +95,7 @@ func (s *taskProcessorSuite) SetupTest() {
 		config:                    NewDynamicConfigForTest(),
 		logger:                    s.logger,
 		domainCache:               s.mockDomainCache,
+		executionManager:          s.mockExecutionMgr,
 		metricsClient:             metricsClient,
 		standbyClusterCurrentTime: make(map[string]time.Time),
 		timeSource:                clock.NewRealTimeSource(),
@@ -103,6 +111,7 @@ func (s *taskProcessorSuite) SetupTest() {
 	options := taskProcessorOptions{
 		queueSize:   s.mockShard.GetConfig().TimerTaskBatchSize() * s.mockShard.GetConfig().TimerTaskWorkerCount(),
 		workerCount: s.mockShard.GetConfig().TimerTaskWorkerCount(),
+		metricScope: metrics.TimerQueueProcessorScope,
 	}
 	s.taskProcessor = newTaskProcessor(options, s.mockShard, h.historyCache, s.logger)
 }
@@ -111,6 +120,7 @@ func (s *taskProcessorSuite) TearDownTest() {
 	s.mockDomainCache.AssertExpectations(s.T())
 	s.mockProcessor.AssertExpectations(s.T())
 	s.mockQueueAckMgr.AssertExpectations(s.T())
+	s.mockExecutionMgr.AssertExpectations(s.T())
 }
 
 func (s *taskProcessorSuite) TestProcessTaskAndAck_ShutDown() {
@@ -198,6 +208,98 @@ func (s *taskProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessErrNoErr()
 	)
 }
 
+func (s *taskProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessErr_MoveToDLQ() {
+	err := errors.New("some random err")
+	task := &persistence.TransferTaskInfo{TaskID: 12345, VisibilityTimestamp: time.Now()}
+	var taskFilter queueTaskFilter = func(timer queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+	s.mockShard.GetConfig().TaskMaxAttemptsBeforeDLQ = dynamicconfig.GetIntPropertyFn(2)
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(1)
+	s.taskProcessor.retryPolicy = retryPolicy
+	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
+	s.mockProcessor.On("process", task, true).Return(s.scope, err).Times(2)
+	s.mockExecutionMgr.On("PutTaskToDLQ", mock.MatchedBy(func(request *persistence.PutTaskToDLQRequest) bool {
+		return request.Task.Category == persistence.DLQCategoryTransfer &&
+			request.Task.TaskID == task.TaskID &&
+			request.Task.TransferTask == task &&
+			request.Task.LastError == err.Error()
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("GetTasksFromDLQ", mock.Anything).Return(&persistence.GetTasksFromDLQResponse{}, nil).Once()
+	s.mockProcessor.On("complete", task).Once()
+	s.taskProcessor.processTaskAndAck(
+		s.notificationChan,
+		&taskInfo{
+			processor: s.mockProcessor,
+			task:      task,
+		},
+	)
+}
+
+func (s *taskProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessErr_MoveToDLQErr() {
+	err := errors.New("some random err")
+	task := &persistence.TimerTaskInfo{TaskID: 12345, VisibilityTimestamp: time.Now()}
+	var taskFilter queueTaskFilter = func(timer queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+	s.mockShard.GetConfig().TaskMaxAttemptsBeforeDLQ = dynamicconfig.GetIntPropertyFn(1)
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(1)
+	s.taskProcessor.retryPolicy = retryPolicy
+	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
+	s.mockProcessor.On("process", task, true).Return(s.scope, err).Once()
+	s.mockExecutionMgr.On("PutTaskToDLQ", mock.Anything).Return(errors.New("some random persistence err")).Once()
+	s.mockProcessor.On("process", task, true).Return(s.scope, nil).Once()
+	s.mockProcessor.On("complete", task).Once()
+	s.taskProcessor.processTaskAndAck(
+		s.notificationChan,
+		&taskInfo{
+			processor: s.mockProcessor,
+			task:      task,
+		},
+	)
+}
+
+func (s *taskProcessorSuite) TestEmitTaskDLQSize() {
+	metricsScope := tally.NewTestScope("", nil)
+	s.mockShard.(*shardContextImpl).metricsClient = metrics.NewClient(metricsScope, metrics.History)
+	s.mockExecutionMgr.On("GetTasksFromDLQ", &persistence.GetTasksFromDLQRequest{
+		Category:     persistence.DLQCategoryTimer,
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    taskDLQPageSize,
+	}).Return(&persistence.GetTasksFromDLQResponse{
+		Tasks:         []*persistence.DLQTaskInfo{{TaskID: 1}, {TaskID: 2}},
+		NextPageToken: []byte{1},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetTasksFromDLQ", &persistence.GetTasksFromDLQRequest{
+		Category:      persistence.DLQCategoryTimer,
+		ReadLevel:     0,
+		MaxReadLevel:  math.MaxInt64,
+		BatchSize:     taskDLQPageSize,
+		NextPageToken: []byte{1},
+	}).Return(&persistence.GetTasksFromDLQResponse{
+		Tasks: []*persistence.DLQTaskInfo{{TaskID: 3}},
+	}, nil).Once()
+
+	emitTaskDLQSize(s.mockShard, persistence.DLQCategoryTimer, s.logger)
+	gauge, ok := metricsScope.Snapshot().Gauges()["task_dlq_size+operation=TimerQueueProcessor,shard=0"]
+	s.True(ok)
+	s.Equal(float64(3), gauge.Value())
+}
+
+func (s *taskProcessorSuite) TestIsTransientTaskError() {
+	s.True(isTransientTaskError(ErrTaskRetry))
+	s.True(isTransientTaskError(&workflow.DomainNotActiveError{}))
+	s.True(isTransientTaskError(&workflow.ServiceBusyError{}))
+	s.True(isTransientTaskError(&persistence.TimeoutError{}))
+	s.True(isTransientTaskError(&persistence.ShardOwnershipLostError{}))
+	s.False(isTransientTaskError(ErrWorkflowPaused))
+	s.False(isTransientTaskError(errors.New("random error")))
+	s.False(isTransientTaskError(&workflow.InternalServiceError{}))
+}
+
 func (s *taskProcessorSuite) TestHandleTaskError_EntityNotExists() {
 	err := &workflow.EntityNotExistsError{}
 	s.Nil(s.taskProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
@@ -219,6 +321,11 @@ func (s *taskProcessorSuite) TestHandleTaskError_ErrTaskRetry() {
 	s.Equal(ErrTaskRetry, err)
 }
 
+func (s *taskProcessorSuite) TestHandleTaskError_WorkflowPausedError() {
+	err := ErrWorkflowPaused
+	s.Nil(s.taskProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
+}
+
 func (s *taskProcessorSuite) TestHandleTaskError_ErrTaskDiscarded() {
 	err := ErrTaskDiscarded
 	s.Nil(s.taskProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
@@ -243,3 +350,33 @@ func (s *taskProcessorSuite) TestHandleTaskError_RandomErr() {
 	err := errors.New("random error")
 	s.Equal(err, s.taskProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
 }
+
+func (s *taskProcessorSuite) TestReplayQueueTask_ProcessedByAcceptingExecutor() {
+	task := &persistence.TimerTaskInfo{TaskID: 12345, VisibilityTimestamp: time.Now()}
+	var rejectFilter queueTaskFilter = func(timer queueTaskInfo) (bool, error) {
+		return false, nil
+	}
+	var acceptFilter queueTaskFilter = func(timer queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+	activeProcessor := &MockTimerProcessor{}
+	activeProcessor.On("getTaskFilter").Return(rejectFilter).Once()
+	standbyProcessor := &MockTimerProcessor{}
+	standbyProcessor.On("getTaskFilter").Return(acceptFilter).Once()
+	standbyProcessor.On("process", task, true).Return(s.scope, ErrTaskRetry).Once()
+
+	s.Equal(ErrTaskRetry, replayQueueTask(task, []taskExecutor{activeProcessor, standbyProcessor}))
+	activeProcessor.AssertExpectations(s.T())
+	standbyProcessor.AssertExpectations(s.T())
+}
+
+func (s *taskProcessorSuite) TestReplayQueueTask_WorkflowNotExists() {
+	task := &persistence.TransferTaskInfo{TaskID: 12345, VisibilityTimestamp: time.Now()}
+	var taskFilter queueTaskFilter = func(timer queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
+	s.mockProcessor.On("process", task, true).Return(s.scope, &workflow.EntityNotExistsError{}).Once()
+
+	s.NoError(replayQueueTask(task, []taskExecutor{s.mockProcessor}))
+}
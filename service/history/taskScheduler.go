@@ -0,0 +1,199 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"container/list"
+	"sync"
+)
+
+type (
+	// taskScheduler dispatches queue tasks to task workers with weighted
+	// round robin across domains, so that a single domain with a large
+	// backlog cannot starve the tasks of other domains on the same shard,
+	// the capacity is shared by the domains with pending tasks in proportion
+	// to their weights, tasks beyond the share of a domain are deferred to
+	// the overflow buffer of that domain instead of blocking the queue pump
+	taskScheduler struct {
+		sync.Mutex
+		notEmpty *sync.Cond
+
+		capacity     int
+		size         int
+		overflowSize int
+		totalWeight  int
+		closed       bool
+
+		// domain ID -> pending tasks of that domain
+		queues map[string]*domainTaskQueue
+		// round robin order of domains with pending tasks
+		activeDomains []string
+	}
+
+	domainTaskQueue struct {
+		weight  int
+		credits int
+		tasks   *list.List
+		// tasks beyond the share of the domain, moved to tasks
+		// as the pending tasks of the domain are dispatched
+		overflow *list.List
+	}
+)
+
+func newTaskScheduler(
+	capacity int,
+) *taskScheduler {
+
+	if capacity <= 0 {
+		capacity = 1
+	}
+	s := &taskScheduler{
+		capacity: capacity,
+		queues:   make(map[string]*domainTaskQueue),
+	}
+	s.notEmpty = sync.NewCond(s)
+	return s
+}
+
+// put enqueues the task under its domain without blocking, the task is deferred to the overflow buffer
+// of the domain if the domain has used up its share of the capacity,
+// the weight is the number of tasks the domain may dispatch per round,
+// return false if the scheduler is closed
+func (s *taskScheduler) put(
+	task *taskInfo,
+	weight int,
+) bool {
+
+	if weight <= 0 {
+		weight = 1
+	}
+	domainID := task.task.GetDomainID()
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	queue, ok := s.queues[domainID]
+	if !ok {
+		queue = &domainTaskQueue{
+			tasks:    list.New(),
+			overflow: list.New(),
+		}
+		s.queues[domainID] = queue
+		s.activeDomains = append(s.activeDomains, domainID)
+	}
+	s.totalWeight += weight - queue.weight
+	queue.weight = weight
+
+	// keep the order of tasks within the domain
+	if queue.overflow.Len() > 0 || s.isDomainFull(domainID, weight) {
+		queue.overflow.PushBack(task)
+		s.overflowSize++
+		return true
+	}
+	queue.tasks.PushBack(task)
+	s.size++
+	s.notEmpty.Signal()
+	return true
+}
+
+// get dequeues the next task, blocking while the scheduler is empty,
+// return false if the scheduler is closed
+func (s *taskScheduler) get() (*taskInfo, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	for s.size == 0 && !s.closed {
+		s.notEmpty.Wait()
+	}
+	if s.closed {
+		return nil, false
+	}
+
+	domainID := s.activeDomains[0]
+	queue := s.queues[domainID]
+	if queue.credits <= 0 {
+		queue.credits = queue.weight
+	}
+	task := queue.tasks.Remove(queue.tasks.Front()).(*taskInfo)
+	queue.credits--
+	s.size--
+
+	for queue.overflow.Len() > 0 && !s.isDomainFull(domainID, queue.weight) {
+		queue.tasks.PushBack(queue.overflow.Remove(queue.overflow.Front()))
+		s.overflowSize--
+		s.size++
+	}
+
+	if queue.tasks.Len() == 0 {
+		delete(s.queues, domainID)
+		s.activeDomains = s.activeDomains[1:]
+		s.totalWeight -= queue.weight
+	} else if queue.credits == 0 {
+		s.activeDomains = append(s.activeDomains[1:], domainID)
+	}
+	return task, true
+}
+
+// isDomainFull returns whether the pending tasks of the domain reach its share of the capacity,
+// a domain without pending tasks is never full, so it is never deferred by the backlog of other domains
+func (s *taskScheduler) isDomainFull(
+	domainID string,
+	weight int,
+) bool {
+
+	queue, ok := s.queues[domainID]
+	if !ok {
+		return false
+	}
+	share := s.capacity * weight / (s.totalWeight - queue.weight + weight)
+	if share < 1 {
+		share = 1
+	}
+	return queue.tasks.Len() >= share
+}
+
+// isFull returns whether the deferred tasks reach the capacity,
+// the queue processors stop loading tasks until the deferred tasks are dispatched
+func (s *taskScheduler) isFull() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.overflowSize >= s.capacity
+}
+
+func (s *taskScheduler) len() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.size + s.overflowSize
+}
+
+func (s *taskScheduler) close() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.closed = true
+	s.notEmpty.Broadcast()
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	taskSchedulerSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestTaskSchedulerSuite(t *testing.T) {
+	s := new(taskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskSchedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *taskSchedulerSuite) newTask(domainID string, taskID int64) *taskInfo {
+	return &taskInfo{
+		task: &persistence.TransferTaskInfo{
+			DomainID: domainID,
+			TaskID:   taskID,
+		},
+	}
+}
+
+func (s *taskSchedulerSuite) TestGet_RoundRobin() {
+	scheduler := newTaskScheduler(10)
+	s.True(scheduler.put(s.newTask("A", 1), 1))
+	s.True(scheduler.put(s.newTask("A", 2), 1))
+	s.True(scheduler.put(s.newTask("A", 3), 1))
+	s.True(scheduler.put(s.newTask("B", 4), 1))
+	s.True(scheduler.put(s.newTask("B", 5), 1))
+	s.Equal(5, scheduler.len())
+
+	var taskIDs []int64
+	for i := 0; i < 5; i++ {
+		task, ok := scheduler.get()
+		s.True(ok)
+		taskIDs = append(taskIDs, task.task.GetTaskID())
+	}
+	s.Equal([]int64{1, 4, 2, 5, 3}, taskIDs)
+	s.Equal(0, scheduler.len())
+}
+
+func (s *taskSchedulerSuite) TestGet_Weighted() {
+	scheduler := newTaskScheduler(10)
+	for i := int64(1); i <= 4; i++ {
+		s.True(scheduler.put(s.newTask("A", i), 3))
+	}
+	for i := int64(5); i <= 6; i++ {
+		s.True(scheduler.put(s.newTask("B", i), 1))
+	}
+
+	var taskIDs []int64
+	for i := 0; i < 6; i++ {
+		task, ok := scheduler.get()
+		s.True(ok)
+		taskIDs = append(taskIDs, task.task.GetTaskID())
+	}
+	s.Equal([]int64{1, 2, 3, 5, 4, 6}, taskIDs)
+}
+
+func (s *taskSchedulerSuite) TestPut_DefersBeyondDomainShare() {
+	scheduler := newTaskScheduler(2)
+	s.True(scheduler.put(s.newTask("A", 1), 1))
+	s.True(scheduler.put(s.newTask("A", 2), 1))
+	s.False(scheduler.isFull())
+
+	// the backlog of domain A is deferred without blocking domain B
+	s.True(scheduler.put(s.newTask("A", 3), 1))
+	s.True(scheduler.put(s.newTask("A", 4), 1))
+	s.True(scheduler.put(s.newTask("B", 5), 1))
+	s.True(scheduler.isFull())
+	s.Equal(5, scheduler.len())
+
+	var taskIDs []int64
+	for i := 0; i < 5; i++ {
+		task, ok := scheduler.get()
+		s.True(ok)
+		taskIDs = append(taskIDs, task.task.GetTaskID())
+	}
+	s.Equal([]int64{1, 5, 2, 3, 4}, taskIDs)
+	s.False(scheduler.isFull())
+	s.Equal(0, scheduler.len())
+}
+
+func (s *taskSchedulerSuite) TestPut_ShareByWeight() {
+	scheduler := newTaskScheduler(4)
+	s.True(scheduler.put(s.newTask("B", 1), 1))
+	for i := int64(2); i <= 4; i++ {
+		s.True(scheduler.put(s.newTask("A", i), 3))
+	}
+
+	scheduler.Lock()
+	defer scheduler.Unlock()
+	s.True(scheduler.isDomainFull("A", 3))
+	s.True(scheduler.isDomainFull("B", 1))
+	s.False(scheduler.isDomainFull("C", 1))
+}
+
+func (s *taskSchedulerSuite) TestClose() {
+	scheduler := newTaskScheduler(1)
+	s.True(scheduler.put(s.newTask("A", 1), 1))
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		task, ok := scheduler.get()
+		s.True(ok)
+		task, ok = scheduler.get()
+		s.False(ok)
+		s.Nil(task)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	scheduler.close()
+	waitGroup.Wait()
+	s.False(scheduler.put(s.newTask("A", 2), 1))
+}
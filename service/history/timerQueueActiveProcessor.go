@@ -290,7 +290,7 @@ func (t *timerQueueActiveProcessorImpl) process(
 
 	case persistence.TaskTypeDeleteHistoryEvent:
 		if shouldProcessTask {
-			err = t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
+			err = t.processDeleteHistoryEvent(timerTask)
 		}
 		return metrics.TimerActiveTaskDeleteHistoryEventScope, err
 
@@ -317,6 +317,9 @@ func (t *timerQueueActiveProcessorImpl) processExpiredUserTimer(
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if msBuilder.GetExecutionInfo().Paused {
+		return ErrWorkflowPaused
+	}
 	tBuilder := t.historyService.getTimerBuilder(context.getExecution())
 
 	updateHistory := false
@@ -372,6 +375,9 @@ func (t *timerQueueActiveProcessorImpl) processActivityTimeout(
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if msBuilder.GetExecutionInfo().Paused {
+		return ErrWorkflowPaused
+	}
 	tBuilder := t.historyService.getTimerBuilder(context.getExecution())
 
 	updateHistory := false
@@ -520,6 +526,9 @@ func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if msBuilder.GetExecutionInfo().Paused {
+		return ErrWorkflowPaused
+	}
 
 	scheduleID := task.EventID
 	decision, found := msBuilder.GetDecisionInfo(scheduleID)
@@ -625,6 +634,9 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if msBuilder.GetExecutionInfo().Paused {
+		return ErrWorkflowPaused
+	}
 
 	// generate activity task
 	scheduledID := task.EventID
@@ -784,6 +796,100 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowTimeout(
 	)
 }
 
+// processDeleteHistoryEvent only deletes the workflow once all of its transfer tasks are acked,
+// the close execution transfer task still needs the mutable state and history of the run,
+// so the delete is rescheduled until the transfer queue has caught up with it
+func (t *timerQueueActiveProcessorImpl) processDeleteHistoryEvent(
+	task *persistence.TimerTaskInfo,
+) (retError error) {
+
+	hasPendingTransferTasks, err := t.hasPendingTransferTasks(task)
+	if err != nil {
+		return err
+	}
+	if !hasPendingTransferTasks {
+		return t.timerQueueProcessorBase.processDeleteHistoryEvent(task)
+	}
+
+	context, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(
+		t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	} else if msBuilder == nil || msBuilder.IsWorkflowExecutionRunning() {
+		return nil
+	}
+	lastWriteVersion, err := msBuilder.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, lastWriteVersion, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	now := t.shard.GetTimeSource().Now()
+	msBuilder.AddTimerTasks(&persistence.DeleteHistoryEventTask{
+		VisibilityTimestamp: now.Add(t.config.TransferProcessorUpdateAckInterval()),
+		Version:             task.Version,
+	})
+
+	updateMode, err := t.historyService.getUpdateWorkflowMode(task.DomainID, msBuilder)
+	if err != nil {
+		return err
+	}
+	return context.updateWorkflowExecutionWithNew(
+		now,
+		updateMode,
+		nil,
+		nil,
+		transactionPolicyActive,
+		nil,
+	)
+}
+
+// hasPendingTransferTasks checks whether any transfer task of the run created before the given
+// timer task is not acked yet, transfer task IDs of a transaction are always allocated before
+// its timer task IDs so only the range between the transfer ack level and the timer task is read
+func (t *timerQueueActiveProcessorImpl) hasPendingTransferTasks(
+	task *persistence.TimerTaskInfo,
+) (bool, error) {
+
+	ackLevel := t.shard.GetTransferClusterAckLevel(t.currentClusterName)
+	if ackLevel >= task.TaskID {
+		return false, nil
+	}
+
+	request := &persistence.GetTransferTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: task.TaskID,
+		BatchSize:    t.config.TransferTaskBatchSize(),
+	}
+	for {
+		response, err := t.shard.GetExecutionManager().GetTransferTasks(request)
+		if err != nil {
+			return false, err
+		}
+		for _, transferTask := range response.Tasks {
+			if transferTask.DomainID == task.DomainID &&
+				transferTask.WorkflowID == task.WorkflowID &&
+				transferTask.RunID == task.RunID {
+				return true, nil
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			return false, nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
 func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	context workflowExecutionContext,
 	msBuilder mutableState,
@@ -221,6 +221,18 @@ func (t *timerQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+// ReplayTaskFromDLQ processes a timer task moved to DLQ with the active or standby processor accepting the task
+func (t *timerQueueProcessorImpl) ReplayTaskFromDLQ(
+	task *persistence.TimerTaskInfo,
+) error {
+
+	executors := []taskExecutor{t.activeTimerProcessor}
+	for _, standbyTimerProcessor := range t.standbyTimerProcessors {
+		executors = append(executors, standbyTimerProcessor)
+	}
+	return replayQueueTask(task, executors)
+}
+
 func (t *timerQueueProcessorImpl) getTimerFiredCount(
 	clusterName string,
 ) uint64 {
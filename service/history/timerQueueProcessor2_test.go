@@ -36,6 +36,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -62,6 +63,7 @@ type (
 		mockMatchingClient       *matchingservicetest.MockClient
 		mockDomainCache          *cache.DomainCacheMock
 		mockVisibilityMgr        *mocks.VisibilityManager
+		mockArchivalMetadata     *archiver.MockArchivalMetadata
 		mockExecutionMgr         *mocks.ExecutionManager
 		mockHistoryV2Mgr         *mocks.HistoryV2Manager
 		mockShard                ShardContext
@@ -102,6 +104,7 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 	s.mockShardManager = &mocks.ShardManager{}
 	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
 	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockArchivalMetadata = &archiver.MockArchivalMetadata{}
 	s.mockDomainCache = &cache.DomainCacheMock{}
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	// ack manager will use the domain information
@@ -114,7 +117,7 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
 	s.mockMessagingClient = mocks.NewMockMessagingClient(s.mockProducer, nil)
 	s.mockClientBean = &client.MockClientBean{}
-	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, metricsClient, s.mockClientBean, nil, nil, nil)
+	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, metricsClient, s.mockClientBean, s.mockArchivalMetadata, nil, nil)
 	s.mockEventsCache = &MockEventsCache{}
 
 	s.mockShard = &shardContextImpl{
@@ -162,6 +165,7 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 		clusterMetadata:      s.mockClusterMetadata,
 		historyV2Mgr:         s.mockHistoryV2Mgr,
 		executionManager:     s.mockExecutionMgr,
+		visibilityMgr:        s.mockVisibilityMgr,
 		historyCache:         historyCache,
 		logger:               s.logger,
 		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
@@ -192,6 +196,7 @@ func (s *timerQueueProcessor2Suite) TearDownTest() {
 	s.mockExecutionMgr.AssertExpectations(s.T())
 	s.mockHistoryV2Mgr.AssertExpectations(s.T())
 	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockArchivalMetadata.AssertExpectations(s.T())
 	s.mockProducer.AssertExpectations(s.T())
 	s.mockClientBean.AssertExpectations(s.T())
 	s.mockEventsCache.AssertExpectations(s.T())
@@ -438,3 +443,109 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout_Cron() {
 	<-waitCh
 	s.stopProcessor()
 }
+
+func (s *timerQueueProcessor2Suite) TestDeleteHistoryEvent_CloseExecutionPending() {
+	ms := s.newClosedMutableState()
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID:            s.domainID,
+		WorkflowID:          ms.ExecutionInfo.WorkflowID,
+		RunID:               ms.ExecutionInfo.RunID,
+		TaskID:              int64(101),
+		TaskType:            persistence.TaskTypeDeleteHistoryEvent,
+		VisibilityTimestamp: time.Now(),
+		Version:             common.EmptyVersion,
+	}
+
+	s.mockExecutionMgr.On("GetTransferTasks", &persistence.GetTransferTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: timerTask.TaskID,
+		BatchSize:    s.config.TransferTaskBatchSize(),
+	}).Return(&persistence.GetTransferTasksResponse{Tasks: []*persistence.TransferTaskInfo{{
+		DomainID:   timerTask.DomainID,
+		WorkflowID: timerTask.WorkflowID,
+		RunID:      timerTask.RunID,
+		TaskID:     int64(100),
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+	}}}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{RunID: timerTask.RunID}, nil).Once()
+	var request *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		request = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	_, err := s.timerQueueActiveProcessor.process(timerTask, true)
+	s.NoError(err)
+
+	// the delete is rescheduled instead of racing the close execution transfer task
+	s.Equal(persistence.UpdateWorkflowModeUpdateCurrent, request.Mode)
+	s.Equal(1, len(request.UpdateWorkflowMutation.TimerTasks))
+	deleteTask, ok := request.UpdateWorkflowMutation.TimerTasks[0].(*persistence.DeleteHistoryEventTask)
+	s.True(ok)
+	s.True(deleteTask.VisibilityTimestamp.After(timerTask.VisibilityTimestamp))
+}
+
+func (s *timerQueueProcessor2Suite) TestDeleteHistoryEvent_CloseExecutionAcked() {
+	ms := s.newClosedMutableState()
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID:            s.domainID,
+		WorkflowID:          ms.ExecutionInfo.WorkflowID,
+		RunID:               ms.ExecutionInfo.RunID,
+		TaskID:              int64(101),
+		TaskType:            persistence.TaskTypeDeleteHistoryEvent,
+		VisibilityTimestamp: time.Now(),
+		Version:             common.EmptyVersion,
+	}
+
+	// only transfer tasks of other runs are left before the delete
+	s.mockExecutionMgr.On("GetTransferTasks", mock.Anything).Return(&persistence.GetTransferTasksResponse{Tasks: []*persistence.TransferTaskInfo{{
+		DomainID:   timerTask.DomainID,
+		WorkflowID: timerTask.WorkflowID,
+		RunID:      uuid.New(),
+		TaskID:     int64(100),
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+	}}}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewDisabledArchvialConfig())
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
+	s.mockVisibilityMgr.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	_, err := s.timerQueueActiveProcessor.process(timerTask, true)
+	s.NoError(err)
+
+	// once the transfer ack level passed the delete no transfer tasks are read
+	s.mockShard.(*shardContextImpl).shardInfo.ClusterTransferAckLevel[s.clusterName] = timerTask.TaskID
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	_, err = s.timerQueueActiveProcessor.process(timerTask, true)
+	s.NoError(err)
+}
+
+func (s *timerQueueProcessor2Suite) newClosedMutableState() *persistence.WorkflowMutableState {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("workflow-deleted-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	builder := newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return().Once()
+	_, _ = builder.AddWorkflowExecutionStartedEvent(
+		we,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+				TaskList:                            common.TaskListPtr(workflow.TaskList{Name: common.StringPtr("task-workflow-deleted")}),
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+
+	ms := createMutableState(builder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = persistence.WorkflowCloseStatusTerminated
+	return ms
+}
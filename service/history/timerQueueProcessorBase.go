@@ -91,6 +91,7 @@ func newTimerQueueProcessorBase(
 	options := taskProcessorOptions{
 		workerCount: shard.GetConfig().TimerTaskWorkerCount(),
 		queueSize:   shard.GetConfig().TimerTaskWorkerCount() * shard.GetConfig().TimerTaskBatchSize(),
+		metricScope: scope,
 	}
 	taskProcessor := newTaskProcessor(options, shard, historyService.historyCache, logger)
 	base := &timerQueueProcessorBase{
@@ -337,6 +338,12 @@ func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerT
 	}
 	cancel()
 
+	if t.taskProcessor.isFull() {
+		// the deferred tasks of busy domains are not dispatched yet
+		t.notifyNewTimer(time.Time{}) // re-enqueue the event
+		return nil, nil
+	}
+
 	t.lastPollTime = t.timeSource.Now()
 	timerTasks, lookAheadTask, moreTasks, err := t.timerQueueAckMgr.readTimerTasks()
 	if err != nil {
@@ -328,6 +328,9 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if msBuilder.GetExecutionInfo().Paused {
+		return ErrWorkflowPaused
+	}
 
 	ai, ok := msBuilder.GetActivityInfo(task.ScheduleID)
 	if !ok {
@@ -375,6 +378,9 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if msBuilder.GetExecutionInfo().Paused {
+		return ErrWorkflowPaused
+	}
 
 	decision, found := msBuilder.GetDecisionInfo(task.ScheduleID)
 	if !found {
@@ -1066,6 +1072,7 @@ func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStartedOrUpsertH
 	executionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
 	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
 	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
+	paused := executionInfo.Paused
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -1076,7 +1083,7 @@ func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStartedOrUpsertH
 			workflowTimeout, task.GetTaskID(), visibilityMemo, searchAttr)
 	}
 	return t.upsertWorkflowExecution(task.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
-		workflowTimeout, task.GetTaskID(), visibilityMemo, searchAttr)
+		workflowTimeout, task.GetTaskID(), visibilityMemo, searchAttr, paused)
 }
 
 func copySearchAttributes(
@@ -242,6 +242,18 @@ func (t *transferQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+// ReplayTaskFromDLQ processes a transfer task moved to DLQ with the active or standby processor accepting the task
+func (t *transferQueueProcessorImpl) ReplayTaskFromDLQ(
+	task *persistence.TransferTaskInfo,
+) error {
+
+	executors := []taskExecutor{t.activeTaskProcessor}
+	for _, standbyTaskProcessor := range t.standbyTaskProcessors {
+		executors = append(executors, standbyTaskProcessor)
+	}
+	return replayQueueTask(task, executors)
+}
+
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval())
 	defer timer.Stop()
@@ -218,6 +218,7 @@ func (t *transferQueueProcessorBase) upsertWorkflowExecution(
 	taskID int64,
 	visibilityMemo *workflow.Memo,
 	searchAttributes map[string][]byte,
+	paused bool,
 ) error {
 
 	domain := defaultDomainName
@@ -241,6 +242,7 @@ func (t *transferQueueProcessorBase) upsertWorkflowExecution(
 		TaskID:             taskID,
 		Memo:               visibilityMemo,
 		SearchAttributes:   searchAttributes,
+		Paused:             paused,
 	}
 
 	return t.visibilityMgr.UpsertWorkflowExecution(request)
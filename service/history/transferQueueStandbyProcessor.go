@@ -539,13 +539,14 @@ func (t *transferQueueStandbyProcessorImpl) processRecordWorkflowStartedOrUpsert
 	executionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
 	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
 	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
+	paused := executionInfo.Paused
 
 	if isRecordStart {
 		return t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
 			workflowTimeout, transferTask.GetTaskID(), visibilityMemo, searchAttr)
 	}
 	return t.upsertWorkflowExecution(transferTask.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
-		workflowTimeout, transferTask.GetTaskID(), visibilityMemo, searchAttr)
+		workflowTimeout, transferTask.GetTaskID(), visibilityMemo, searchAttr, paused)
 
 }
 
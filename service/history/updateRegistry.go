@@ -0,0 +1,285 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/pborman/uuid"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	updateStateBuffered updateState = iota
+	updateStateStarted
+	updateStateCompleted
+)
+
+const (
+	// maxCompletedUpdates is the number of completed updates kept per workflow execution so that
+	// retried requests carrying the same request ID get the recorded result
+	maxCompletedUpdates = 100
+)
+
+var (
+	errUpdateNotExists  = errors.New("update does not exist")
+	errUpdateNotStarted = errors.New("update has not been dispatched to a decision task")
+)
+
+type (
+	updateState int
+
+	updateRegistry interface {
+		hasBuffered() bool
+		hasStarted() bool
+		hasUnanswered(answered map[string]struct{}) bool
+
+		bufferUpdate(requestID string, updateName string, input []byte) (string, <-chan struct{})
+		startUpdates() []*workflow.WorkflowUpdate
+		getStartedUpdate(string) (*workflow.WorkflowUpdate, string, error)
+		recordResult(string, *workflow.WorkflowUpdateResult) error
+		rebufferUpdates()
+		getUpdateSnapshot(string) (*updateSnapshot, error)
+		removeUpdate(string)
+	}
+
+	updateRegistryImpl struct {
+		sync.RWMutex
+
+		sequence  int64
+		updates   map[string]*updateEntry
+		requests  map[string]string
+		completed []string
+	}
+
+	updateEntry struct {
+		sequence  int64
+		requestID string
+		update    *workflow.WorkflowUpdate
+		result    *workflow.WorkflowUpdateResult
+		state     updateState
+		termCh    chan struct{}
+	}
+
+	updateSnapshot struct {
+		id     string
+		update *workflow.WorkflowUpdate
+		result *workflow.WorkflowUpdateResult
+		state  updateState
+	}
+)
+
+// newUpdateRegistry creates the registry tracking the in flight updates of a workflow execution,
+// an update moves from buffered to started when a decision task is started and to completed
+// once the result reported by the worker is persisted
+func newUpdateRegistry() updateRegistry {
+	return &updateRegistryImpl{
+		updates:  make(map[string]*updateEntry),
+		requests: make(map[string]string),
+	}
+}
+
+func (r *updateRegistryImpl) hasBuffered() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.countInState(updateStateBuffered) > 0
+}
+
+func (r *updateRegistryImpl) hasStarted() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.countInState(updateStateStarted) > 0
+}
+
+func (r *updateRegistryImpl) hasUnanswered(answered map[string]struct{}) bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	for id, entry := range r.updates {
+		if entry.state == updateStateCompleted {
+			continue
+		}
+		if _, ok := answered[id]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *updateRegistryImpl) bufferUpdate(requestID string, updateName string, input []byte) (string, <-chan struct{}) {
+	r.Lock()
+	defer r.Unlock()
+
+	// a retried request joins the update it already created
+	if requestID != "" {
+		if id, ok := r.requests[requestID]; ok {
+			if entry, ok := r.updates[id]; ok {
+				return id, entry.termCh
+			}
+		}
+	}
+
+	id := uuid.New()
+	r.sequence++
+	entry := &updateEntry{
+		sequence:  r.sequence,
+		requestID: requestID,
+		update: &workflow.WorkflowUpdate{
+			UpdateId:   common.StringPtr(id),
+			UpdateName: common.StringPtr(updateName),
+			Input:      input,
+		},
+		state:  updateStateBuffered,
+		termCh: make(chan struct{}),
+	}
+	r.updates[id] = entry
+	if requestID != "" {
+		r.requests[requestID] = id
+	}
+	return id, entry.termCh
+}
+
+func (r *updateRegistryImpl) startUpdates() []*workflow.WorkflowUpdate {
+	r.Lock()
+	defer r.Unlock()
+
+	// updates started by an earlier decision task which never completed are delivered again
+	var entries []*updateEntry
+	for _, entry := range r.updates {
+		switch entry.state {
+		case updateStateBuffered:
+			entry.state = updateStateStarted
+			entries = append(entries, entry)
+		case updateStateStarted:
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].sequence < entries[j].sequence
+	})
+	if len(entries) == 0 {
+		return nil
+	}
+
+	result := make([]*workflow.WorkflowUpdate, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.update)
+	}
+	return result
+}
+
+func (r *updateRegistryImpl) getStartedUpdate(id string) (*workflow.WorkflowUpdate, string, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	entry, ok := r.updates[id]
+	if !ok {
+		return nil, "", errUpdateNotExists
+	}
+	if entry.state != updateStateStarted {
+		return nil, "", errUpdateNotStarted
+	}
+	return entry.update, entry.requestID, nil
+}
+
+func (r *updateRegistryImpl) recordResult(id string, result *workflow.WorkflowUpdateResult) error {
+	r.Lock()
+	defer r.Unlock()
+
+	entry, ok := r.updates[id]
+	if !ok {
+		return errUpdateNotExists
+	}
+	if entry.state != updateStateStarted {
+		return errUpdateNotStarted
+	}
+	entry.result = result
+	entry.state = updateStateCompleted
+	close(entry.termCh)
+
+	r.completed = append(r.completed, id)
+	if len(r.completed) > maxCompletedUpdates {
+		r.deleteEntry(r.completed[0])
+		r.completed = r.completed[1:]
+	}
+	return nil
+}
+
+func (r *updateRegistryImpl) rebufferUpdates() {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, entry := range r.updates {
+		if entry.state == updateStateStarted {
+			entry.state = updateStateBuffered
+		}
+	}
+}
+
+func (r *updateRegistryImpl) getUpdateSnapshot(id string) (*updateSnapshot, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	entry, ok := r.updates[id]
+	if !ok {
+		return nil, errUpdateNotExists
+	}
+	return &updateSnapshot{
+		id:     id,
+		update: entry.update,
+		result: entry.result,
+		state:  entry.state,
+	}, nil
+}
+
+// removeUpdate drops an update which has not been dispatched yet, an update already handed
+// to a decision task is kept since the worker may still complete it
+func (r *updateRegistryImpl) removeUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+
+	if entry, ok := r.updates[id]; ok && entry.state == updateStateBuffered {
+		r.deleteEntry(id)
+	}
+}
+
+func (r *updateRegistryImpl) deleteEntry(id string) {
+	if entry, ok := r.updates[id]; ok && entry.requestID != "" {
+		delete(r.requests, entry.requestID)
+	}
+	delete(r.updates, id)
+}
+
+func (r *updateRegistryImpl) countInState(state updateState) int {
+	count := 0
+	for _, entry := range r.updates {
+		if entry.state == state {
+			count++
+		}
+	}
+	return count
+}
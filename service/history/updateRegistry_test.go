@@ -0,0 +1,159 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type UpdateRegistrySuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestUpdateLifecycle() {
+	ur := newUpdateRegistry()
+	id1, termCh1 := ur.bufferUpdate("", "update-1", []byte("input-1"))
+	id2, _ := ur.bufferUpdate("", "update-2", nil)
+	s.True(ur.hasBuffered())
+	s.False(ur.hasStarted())
+
+	updates := ur.startUpdates()
+	s.Len(updates, 2)
+	s.Equal(id1, updates[0].GetUpdateId())
+	s.Equal("update-1", updates[0].GetUpdateName())
+	s.Equal([]byte("input-1"), updates[0].Input)
+	s.Equal(id2, updates[1].GetUpdateId())
+	s.False(ur.hasBuffered())
+	s.True(ur.hasStarted())
+
+	s.True(ur.hasUnanswered(map[string]struct{}{id1: {}}))
+	s.False(ur.hasUnanswered(map[string]struct{}{id1: {}, id2: {}}))
+
+	update, requestID, err := ur.getStartedUpdate(id1)
+	s.NoError(err)
+	s.Equal(id1, update.GetUpdateId())
+	s.Equal("", requestID)
+
+	result := &workflow.WorkflowUpdateResult{
+		UpdateId:   common.StringPtr(id1),
+		ResultType: workflow.WorkflowUpdateResultTypeCompleted.Ptr(),
+		Result:     []byte("result-1"),
+	}
+	s.NoError(ur.recordResult(id1, result))
+	s.Equal(errUpdateNotStarted, ur.recordResult(id1, result))
+	select {
+	case <-termCh1:
+	default:
+		s.Fail("termination channel should be closed once the result is recorded")
+	}
+	snapshot, err := ur.getUpdateSnapshot(id1)
+	s.NoError(err)
+	s.Equal(updateStateCompleted, snapshot.state)
+	s.Equal(result, snapshot.result)
+
+	ur.rebufferUpdates()
+	s.True(ur.hasBuffered())
+	s.False(ur.hasStarted())
+	updates = ur.startUpdates()
+	s.Len(updates, 1)
+	s.Equal(id2, updates[0].GetUpdateId())
+}
+
+func (s *UpdateRegistrySuite) TestStartedUpdatesAreRedelivered() {
+	ur := newUpdateRegistry()
+	id1, _ := ur.bufferUpdate("", "update-1", nil)
+	s.Len(ur.startUpdates(), 1)
+
+	id2, _ := ur.bufferUpdate("", "update-2", nil)
+	updates := ur.startUpdates()
+	s.Len(updates, 2)
+	s.Equal(id1, updates[0].GetUpdateId())
+	s.Equal(id2, updates[1].GetUpdateId())
+}
+
+func (s *UpdateRegistrySuite) TestRequestIDDeduplication() {
+	ur := newUpdateRegistry()
+	id1, termCh1 := ur.bufferUpdate("request-1", "update", nil)
+	id2, termCh2 := ur.bufferUpdate("request-1", "update", nil)
+	s.Equal(id1, id2)
+	s.Equal(termCh1, termCh2)
+
+	ur.startUpdates()
+	_, requestID, err := ur.getStartedUpdate(id1)
+	s.NoError(err)
+	s.Equal("request-1", requestID)
+	s.NoError(ur.recordResult(id1, &workflow.WorkflowUpdateResult{UpdateId: common.StringPtr(id1)}))
+
+	id3, termCh3 := ur.bufferUpdate("request-1", "update", nil)
+	s.Equal(id1, id3)
+	s.Equal(termCh1, termCh3)
+	s.False(ur.hasBuffered())
+}
+
+func (s *UpdateRegistrySuite) TestRemoveUpdate() {
+	ur := newUpdateRegistry()
+	id1, _ := ur.bufferUpdate("request-1", "update-1", nil)
+	ur.removeUpdate(id1)
+	_, err := ur.getUpdateSnapshot(id1)
+	s.Equal(errUpdateNotExists, err)
+	id2, _ := ur.bufferUpdate("request-1", "update-1", nil)
+	s.NotEqual(id1, id2)
+
+	// dispatched updates stay until the worker answers them
+	ur.startUpdates()
+	ur.removeUpdate(id2)
+	snapshot, err := ur.getUpdateSnapshot(id2)
+	s.NoError(err)
+	s.Equal(updateStateStarted, snapshot.state)
+}
+
+func (s *UpdateRegistrySuite) TestCompletedUpdatesAreBounded() {
+	ur := newUpdateRegistry()
+	var ids []string
+	for i := 0; i < maxCompletedUpdates+1; i++ {
+		id, _ := ur.bufferUpdate("", "update", nil)
+		ids = append(ids, id)
+	}
+	ur.startUpdates()
+	for _, id := range ids {
+		s.NoError(ur.recordResult(id, &workflow.WorkflowUpdateResult{UpdateId: common.StringPtr(id)}))
+	}
+
+	_, err := ur.getUpdateSnapshot(ids[0])
+	s.Equal(errUpdateNotExists, err)
+	_, err = ur.getUpdateSnapshot(ids[maxCompletedUpdates])
+	s.NoError(err)
+}
@@ -23,12 +23,17 @@ package history
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync/atomic"
 	"time"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/locks"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -46,6 +51,7 @@ type (
 		getDomainID() string
 		getExecution() *workflow.WorkflowExecution
 		getLogger() log.Logger
+		getUpdateRegistry() updateRegistry
 
 		loadWorkflowExecution() (mutableState, error)
 		loadExecutionStats() (*persistence.ExecutionStats, error)
@@ -144,6 +150,9 @@ type (
 		msBuilder       mutableState
 		stats           *persistence.ExecutionStats
 		updateCondition int64
+		updateRegistry  updateRegistry
+		// estimated size in bytes used by historyCache, accessed atomically
+		cacheSize int64
 	}
 )
 
@@ -179,6 +188,8 @@ func newWorkflowExecutionContext(
 		stats: &persistence.ExecutionStats{
 			HistorySize: 0,
 		},
+		updateRegistry: newUpdateRegistry(),
+		cacheSize:      workflowExecutionContextBaseSize,
 	}
 }
 
@@ -187,9 +198,24 @@ func (c *workflowExecutionContextImpl) lock(ctx context.Context) error {
 }
 
 func (c *workflowExecutionContextImpl) unlock() {
+	// refresh the size estimate while the mutable state cannot change
+	c.updateCacheSize()
 	c.mutex.Unlock()
 }
 
+// getCacheSize returns the estimated size in bytes of the context as of the last unlock
+func (c *workflowExecutionContextImpl) getCacheSize() int {
+	return int(atomic.LoadInt64(&c.cacheSize))
+}
+
+func (c *workflowExecutionContextImpl) updateCacheSize() {
+	size := workflowExecutionContextBaseSize
+	if msBuilder, ok := c.msBuilder.(*mutableStateBuilder); ok {
+		size += estimateMutableStateSize(msBuilder)
+	}
+	atomic.StoreInt64(&c.cacheSize, int64(size))
+}
+
 func (c *workflowExecutionContextImpl) clear() {
 	c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.WorkflowContextCleared)
 	c.msBuilder = nil
@@ -208,6 +234,12 @@ func (c *workflowExecutionContextImpl) getLogger() log.Logger {
 	return c.logger
 }
 
+// getUpdateRegistry returns the in flight updates of the workflow execution, unlike the
+// mutable state the registry is kept when the context is cleared
+func (c *workflowExecutionContextImpl) getUpdateRegistry() updateRegistry {
+	return c.updateRegistry
+}
+
 func (c *workflowExecutionContextImpl) getDomainName() string {
 	domainEntry, err := c.shard.GetDomainCache().GetDomainByID(c.domainID)
 	if err != nil {
@@ -258,6 +290,11 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecution() (mutableState, er
 		c.stats = response.State.ExecutionStats
 		c.updateCondition = response.State.ExecutionInfo.NextEventID
 
+		if err := c.verifyChecksum(domainEntry, response.State.Checksum); err != nil {
+			c.clear()
+			return nil, err
+		}
+
 		// finally emit execution and session stats
 		emitWorkflowExecutionStats(
 			c.metricsClient,
@@ -294,6 +331,128 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecution() (mutableState, er
 	return c.msBuilder, nil
 }
 
+func (c *workflowExecutionContextImpl) verifyChecksum(
+	domainEntry *cache.DomainCacheEntry,
+	csum checksum.Checksum,
+) error {
+
+	if len(csum.Value) == 0 {
+		return nil
+	}
+	domainName := domainEntry.GetInfo().Name
+	if rand.Intn(100) >= c.shard.GetConfig().MutableStateChecksumVerifyProbability(domainName) {
+		return nil
+	}
+
+	err := verifyMutableStateChecksum(c.msBuilder, csum)
+	if err == nil {
+		return nil
+	}
+
+	metricsScope := c.metricsClient.Scope(metrics.WorkflowContextScope, metrics.DomainTag(domainName))
+	metricsScope.IncCounter(metrics.MutableStateChecksumMismatch)
+	switch c.shard.GetConfig().MutableStateChecksumMismatchAction(domainName) {
+	case mutableStateChecksumMismatchActionLog:
+		c.logger.Error("mutable state checksum mismatch", tag.Error(err))
+		return nil
+	case mutableStateChecksumMismatchActionRebuild:
+		if c.msBuilder.GetVersionHistories() == nil || c.msBuilder.HasBufferedEvents() {
+			// only mutable states with version histories and without buffered events can be rebuilt
+			metricsScope.IncCounter(metrics.MutableStateChecksumRebuildSkipped)
+			c.logger.Error("mutable state checksum mismatch, unable to rebuild mutable state from history", tag.Error(err))
+			return nil
+		}
+		c.logger.Error("mutable state checksum mismatch, rebuilding mutable state from history", tag.Error(err))
+		return c.rebuildMutableState(metricsScope)
+	default:
+		return nil
+	}
+}
+
+// rebuildMutableState replaces a corrupted mutable state with one rebuilt from the current history branch,
+// and leaves the rebuilt mutable state loaded in the context
+func (c *workflowExecutionContextImpl) rebuildMutableState(
+	metricsScope metrics.Scope,
+) error {
+
+	versionHistories := c.msBuilder.GetVersionHistories().Duplicate()
+	currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return err
+	}
+	lastItem, err := currentVersionHistory.GetLastItem()
+	if err != nil {
+		return err
+	}
+
+	workflowIdentifier := definition.NewWorkflowIdentifier(
+		c.domainID,
+		c.workflowExecution.GetWorkflowId(),
+		c.workflowExecution.GetRunId(),
+	)
+	now := c.shard.GetTimeSource().Now()
+	rebuiltMutableState, rebuiltHistorySize, err := newNDCStateRebuilder(c.shard, c.logger).rebuild(
+		context.Background(),
+		now,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		lastItem.GetEventID()+1,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		c.msBuilder.GetExecutionInfo().CreateRequestID,
+	)
+	if err != nil {
+		return err
+	}
+	if err := rebuiltMutableState.SetVersionHistories(versionHistories); err != nil {
+		return err
+	}
+	rebuiltMutableState.SetUpdateCondition(c.msBuilder.GetUpdateCondition())
+
+	resp, err := c.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   c.domainID,
+		WorkflowID: c.workflowExecution.GetWorkflowId(),
+	})
+	if err != nil {
+		return err
+	}
+	conflictResolveMode := persistence.ConflictResolveWorkflowModeUpdateCurrent
+	if resp.RunID != c.workflowExecution.GetRunId() {
+		conflictResolveMode = persistence.ConflictResolveWorkflowModeBypassCurrent
+		if rebuiltMutableState.IsWorkflowExecutionRunning() {
+			if err := rebuiltMutableState.GetExecutionInfo().UpdateWorkflowStateCloseStatus(
+				persistence.WorkflowStateZombie,
+				persistence.WorkflowCloseStatusNone,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.clear()
+	c.setHistorySize(rebuiltHistorySize)
+	if err := c.conflictResolveWorkflowExecution(
+		now,
+		conflictResolveMode,
+		rebuiltMutableState,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	); err != nil {
+		return err
+	}
+
+	// the context is cleared once the rebuilt mutable state is persisted
+	c.msBuilder = rebuiltMutableState
+	c.setHistorySize(rebuiltHistorySize)
+	c.updateCondition = rebuiltMutableState.GetNextEventID()
+	metricsScope.IncCounter(metrics.MutableStateChecksumRebuilt)
+	return nil
+}
+
 func (c *workflowExecutionContextImpl) createWorkflowExecution(
 	newWorkflow *persistence.WorkflowSnapshot,
 	historySize int64,
@@ -696,8 +855,9 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 		domainName,
 		resp.MutableStateUpdateSessionStats,
 	)
-	// emit workflow completion stats if any
-	if currentWorkflow.ExecutionInfo.State == persistence.WorkflowStateCompleted {
+	// emit workflow completion stats if any, updates of an already closed workflow
+	// (e.g. rescheduling its deletion) append no events and must not emit them again
+	if currentWorkflow.ExecutionInfo.State == persistence.WorkflowStateCompleted && len(currentWorkflowEventsSeq) > 0 {
 		if event, err := c.msBuilder.GetCompletionEvent(); err == nil {
 			taskList := currentWorkflow.ExecutionInfo.TaskList
 			emitWorkflowCompletionStats(c.metricsClient, domainName, taskList, event)
@@ -108,6 +108,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(
 	newMutableState, newHistorySize, newTransferTasks, newTimerTasks, retError := w.buildNewMutableStateForReset(
 		ctx, domainEntry, baseMutableState, currMutableState,
 		request.GetReason(), request.GetDecisionFinishEventId(), request.GetRequestId(), resetNewRunID,
+		request.GetResetReapplyType() != workflow.ResetReapplyTypeNone,
 	)
 	// complete the fork process at the end, it is OK even if this defer fails, because our timer task can still clean up correctly
 	defer func() {
@@ -250,6 +251,7 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	resetReason string,
 	resetDecisionCompletedEventID int64,
 	requestedID, newRunID string,
+	reapplySignals bool,
 ) (newMutableState mutableState, newHistorySize int64, newTransferTasks, newTimerTasks []persistence.Task, retError error) {
 
 	domainID := baseMutableState.GetExecutionInfo().DomainID
@@ -326,10 +328,12 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	if retError != nil {
 		return
 	}
-	// replay received signals back to mutableState/history:
-	retError = w.replayReceivedSignals(ctx, receivedSignals, continueRunID, newMutableState, currMutableState)
-	if retError != nil {
-		return
+	// replay received signals back to mutableState/history, unless the caller asked not to
+	if reapplySignals {
+		retError = w.replayReceivedSignals(ctx, receivedSignals, continueRunID, newMutableState, currMutableState)
+		if retError != nil {
+			return
+		}
 	}
 
 	// we always schedule a new decision after reset
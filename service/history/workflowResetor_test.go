@@ -889,6 +889,70 @@ func (s *resetorSuite) assertActivityIDs(ids []string, timers []*p.ActivityInfo)
 	s.Equal(0, len(m))
 }
 
+func (s *resetorSuite) TestResetWorkflowExecution_ByResetType_NoResetPoint() {
+	testDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&p.DomainInfo{ID: testDomainID}, &p.DomainConfig{Retention: 1}, "", nil,
+	)
+	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(testDomainEntry, nil)
+	s.mockDomainCache.On("GetDomain", mock.Anything).Return(testDomainEntry, nil)
+
+	domainID := testDomainID
+	wid := "wId"
+	forkRunID := uuid.New().String()
+	forkBranchToken := []byte("forkBranchToken")
+	forkExeInfo := &p.WorkflowExecutionInfo{
+		DomainID:           domainID,
+		WorkflowID:         wid,
+		WorkflowTypeName:   "wfType",
+		TaskList:           "taskList",
+		RunID:              forkRunID,
+		BranchToken:        forkBranchToken,
+		NextEventID:        3,
+		DecisionVersion:    common.EmptyVersion,
+		DecisionScheduleID: common.EmptyEventID,
+		DecisionStartedID:  common.EmptyEventID,
+	}
+	forkGwmsResponse := &p.GetWorkflowExecutionResponse{State: &p.WorkflowMutableState{
+		ExecutionInfo:  forkExeInfo,
+		ExecutionStats: &p.ExecutionStats{},
+	}}
+	readHistoryResp := &p.ReadHistoryBranchResponse{
+		HistoryEvents: []*workflow.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(1),
+				EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionStarted),
+			},
+			{
+				EventId:   common.Int64Ptr(2),
+				EventType: common.EventTypePtr(workflow.EventTypeDecisionTaskScheduled),
+			},
+		},
+	}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(forkGwmsResponse, nil)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(readHistoryResp, nil).Once()
+
+	request := &h.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain: common.StringPtr("testDomainName"),
+			WorkflowExecution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(wid),
+				RunId:      common.StringPtr(forkRunID),
+			},
+			Reason:    common.StringPtr("test reset"),
+			RequestId: common.StringPtr(uuid.New().String()),
+			ResetType: workflow.ResetTypeLastDecisionCompleted.Ptr(),
+		},
+	}
+	_, err := s.historyEngine.ResetWorkflowExecution(context.Background(), request)
+	s.EqualError(err, "BadRequestError{Message: Cannot find DecisionTaskCompleted event to reset to.}")
+
+	request.ResetRequest.ResetType = workflow.ResetTypeBadBinary.Ptr()
+	request.ResetRequest.ResetBinaryChecksum = common.StringPtr("bad-binary")
+	_, err = s.historyEngine.ResetWorkflowExecution(context.Background(), request)
+	s.EqualError(err, "BadRequestError{Message: Cannot find reset point for the binary checksum.}")
+}
+
 func (s *resetorSuite) TestResetWorkflowExecution_NoReplication_WithRequestCancel() {
 	testDomainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&p.DomainInfo{ID: testDomainID}, &p.DomainConfig{Retention: 1}, "", nil,
@@ -60,7 +60,10 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pFactory, err := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	if err != nil {
+		log.Fatal("failed to create persistence factory", tag.Error(err))
+	}
 
 	taskPersistence, err := pFactory.NewTaskManager()
 	if err != nil {
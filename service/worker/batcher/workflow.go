@@ -52,6 +52,10 @@ const (
 
 	// DefaultRPS is the default RPS
 	DefaultRPS = 50
+	// DefaultResetRPS is the default RPS of BatchTypeReset, each reset reads the history and forks a new run
+	DefaultResetRPS = 10
+	// DefaultSignalWithStartRPS is the default RPS of BatchTypeSignalWithStart, each operation may start a new run
+	DefaultSignalWithStartRPS = 20
 	// DefaultConcurrency is the default concurrency
 	DefaultConcurrency = 5
 	// DefaultAttemptsOnRetryableError is the default value for AttemptsOnRetryableError
@@ -67,10 +71,55 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypePause is batch type for pausing workflows
+	BatchTypePause = "pause"
+	// BatchTypeUnpause is batch type for unpausing workflows
+	BatchTypeUnpause = "unpause"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeSignalWithStart is batch type for signaling workflows and starting them if they are not running
+	BatchTypeSignalWithStart = "signalwithstart"
+	// BatchTypeDelete is batch type for deleting workflows
+	BatchTypeDelete = "delete"
+	// BatchTypeUpsert is batch type for upserting search attributes and memo of workflows
+	BatchTypeUpsert = "upsert"
+)
+
+const (
+	// ResetTypeLastDecisionCompleted resets to the last DecisionTaskCompleted event of the run
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeBadBinary resets to the first DecisionTaskCompleted event made by the bad binary
+	ResetTypeBadBinary = "BadBinary"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{
+	BatchTypeTerminate,
+	BatchTypeCancel,
+	BatchTypeSignal,
+	BatchTypePause,
+	BatchTypeUnpause,
+	BatchTypeReset,
+	BatchTypeSignalWithStart,
+	BatchTypeDelete,
+	BatchTypeUpsert,
+}
+
+// AllResetTypes is the reset types supported by BatchTypeReset
+var AllResetTypes = []string{ResetTypeLastDecisionCompleted, ResetTypeBadBinary}
+
+// resetTypes maps the reset types supported by BatchTypeReset to the reset types resolved by the server
+var resetTypes = map[string]shared.ResetType{
+	ResetTypeLastDecisionCompleted: shared.ResetTypeLastDecisionCompleted,
+	ResetTypeBadBinary:             shared.ResetTypeBadBinary,
+}
+
+// defaultRPSByBatchType is the RPS used when BatchParams.RPS is not set,
+// batch types missing from the map default to DefaultRPS
+var defaultRPSByBatchType = map[string]int{
+	BatchTypeReset:           DefaultResetRPS,
+	BatchTypeSignalWithStart: DefaultSignalWithStartRPS,
+}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -95,6 +144,35 @@ type (
 		Input      string
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// ResetType is one of AllResetTypes
+		ResetType string
+		// BadBinaryChecksum is required for ResetTypeBadBinary
+		BadBinaryChecksum string
+		// SkipOpen skips the workflows whose current run is still open
+		SkipOpen bool
+	}
+
+	// UpsertParams is the parameters for upserting search attributes and memo of workflow
+	UpsertParams struct {
+		// SearchAttributes are JSON encoded values keyed by search attribute name
+		SearchAttributes map[string][]byte
+		Memo             map[string][]byte
+	}
+
+	// SignalWithStartParams is the parameters for signaling workflow, and starting it if it is not running
+	SignalWithStartParams struct {
+		SignalName  string
+		SignalInput string
+		// Below are used to start the workflow if it is not running
+		WorkflowType                        string
+		TaskList                            string
+		Input                               string
+		ExecutionStartToCloseTimeoutSeconds int32
+		TaskStartToCloseTimeoutSeconds      int32
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target domain to execute batch operation
@@ -103,7 +181,7 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: reset,terminate
+		// One of AllBatchTypes
 		BatchType string
 
 		// Below are all optional
@@ -113,7 +191,13 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
-		// RPS of processing. Default to DefaultRPS
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// SignalWithStartParams is params only for BatchTypeSignalWithStart
+		SignalWithStartParams SignalWithStartParams
+		// UpsertParams is params only for BatchTypeUpsert
+		UpsertParams UpsertParams
+		// RPS of processing. Default depends on the BatchType, see defaultRPSByBatchType
 		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 		RPS int
 		// Number of goroutines running in parallel to process
@@ -195,9 +279,31 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeReset:
+		if _, ok := resetTypes[params.ResetParams.ResetType]; !ok {
+			return fmt.Errorf("not supported reset type: %v", params.ResetParams.ResetType)
+		}
+		if params.ResetParams.ResetType == ResetTypeBadBinary && params.ResetParams.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum for reset type %v", ResetTypeBadBinary)
+		}
+		return nil
+	case BatchTypeUpsert:
+		if len(params.UpsertParams.SearchAttributes) == 0 && len(params.UpsertParams.Memo) == 0 {
+			return fmt.Errorf("must provide search attributes or memo")
+		}
+		return nil
+	case BatchTypeSignalWithStart:
+		if params.SignalWithStartParams.SignalName == "" ||
+			params.SignalWithStartParams.WorkflowType == "" ||
+			params.SignalWithStartParams.TaskList == "" {
+			return fmt.Errorf("must provide required parameters: SignalName/WorkflowType/TaskList")
+		}
+		if params.SignalWithStartParams.ExecutionStartToCloseTimeoutSeconds <= 0 ||
+			params.SignalWithStartParams.TaskStartToCloseTimeoutSeconds <= 0 {
+			return fmt.Errorf("must provide positive ExecutionStartToCloseTimeoutSeconds/TaskStartToCloseTimeoutSeconds")
+		}
+		return nil
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypePause, BatchTypeUnpause, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -206,7 +312,7 @@ func validateParams(params BatchParams) error {
 
 func setDefaultParams(params BatchParams) BatchParams {
 	if params.RPS <= 0 {
-		params.RPS = DefaultRPS
+		params.RPS = getDefaultRPS(params.BatchType)
 	}
 	if params.Concurrency <= 0 {
 		params.Concurrency = DefaultConcurrency
@@ -229,6 +335,13 @@ func setDefaultParams(params BatchParams) BatchParams {
 	return params
 }
 
+func getDefaultRPS(batchType string) int {
+	if rps, ok := defaultRPSByBatchType[batchType]; ok {
+		return rps
+	}
+	return DefaultRPS
+}
+
 // BatchActivity is activity for processing batch operation
 func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
@@ -391,6 +504,84 @@ func startTaskProcessor(
 							Input:      []byte(batchParams.SignalParams.Input),
 						}, yarpcCallOptions...)
 					})
+			case BatchTypePause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.PauseWorkflowExecution(ctx, &shared.PauseWorkflowExecutionRequest{
+							Domain: common.StringPtr(batchParams.DomainName),
+							WorkflowExecution: &shared.WorkflowExecution{
+								WorkflowId: common.StringPtr(workflowID),
+								RunId:      common.StringPtr(runID),
+							},
+							Reason:   common.StringPtr(batchParams.Reason),
+							Identity: common.StringPtr(BatchWFTypeName),
+						}, yarpcCallOptions...)
+					})
+			case BatchTypeUnpause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.UnpauseWorkflowExecution(ctx, &shared.UnpauseWorkflowExecutionRequest{
+							Domain: common.StringPtr(batchParams.DomainName),
+							WorkflowExecution: &shared.WorkflowExecution{
+								WorkflowId: common.StringPtr(workflowID),
+								RunId:      common.StringPtr(runID),
+							},
+							Reason:   common.StringPtr(batchParams.Reason),
+							Identity: common.StringPtr(BatchWFTypeName),
+						}, yarpcCallOptions...)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.DeleteWorkflowExecution(ctx, &shared.DeleteWorkflowExecutionRequest{
+							Domain: common.StringPtr(batchParams.DomainName),
+							WorkflowExecution: &shared.WorkflowExecution{
+								WorkflowId: common.StringPtr(workflowID),
+								RunId:      common.StringPtr(runID),
+							},
+							Reason:   common.StringPtr(batchParams.Reason),
+							Identity: common.StringPtr(BatchWFTypeName),
+						}, yarpcCallOptions...)
+					})
+			case BatchTypeUpsert:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.UpsertWorkflowExecutionAttributes(ctx, &shared.UpsertWorkflowExecutionAttributesRequest{
+							Domain: common.StringPtr(batchParams.DomainName),
+							WorkflowExecution: &shared.WorkflowExecution{
+								WorkflowId: common.StringPtr(workflowID),
+								RunId:      common.StringPtr(runID),
+							},
+							SearchAttributes: &shared.SearchAttributes{IndexedFields: batchParams.UpsertParams.SearchAttributes},
+							Memo:             &shared.Memo{Fields: batchParams.UpsertParams.Memo},
+							Reason:           common.StringPtr(batchParams.Reason),
+							Identity:         common.StringPtr(BatchWFTypeName),
+						}, yarpcCallOptions...)
+					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, requestID, yarpcCallOptions)
+					})
+			case BatchTypeSignalWithStart:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, _ string) error {
+						params := batchParams.SignalWithStartParams
+						_, err := client.SignalWithStartWorkflowExecution(ctx, &shared.SignalWithStartWorkflowExecutionRequest{
+							Domain:                              common.StringPtr(batchParams.DomainName),
+							WorkflowId:                          common.StringPtr(workflowID),
+							WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr(params.WorkflowType)},
+							TaskList:                            &shared.TaskList{Name: common.StringPtr(params.TaskList)},
+							Input:                               []byte(params.Input),
+							ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(params.ExecutionStartToCloseTimeoutSeconds),
+							TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(params.TaskStartToCloseTimeoutSeconds),
+							Identity:                            common.StringPtr(BatchWFTypeName),
+							RequestId:                           common.StringPtr(requestID),
+							SignalName:                          common.StringPtr(params.SignalName),
+							SignalInput:                         []byte(params.SignalInput),
+						}, yarpcCallOptions...)
+						return err
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -472,6 +663,85 @@ func processTask(
 	return nil
 }
 
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	requestID string,
+	yarpcCallOptions []yarpc.CallOption,
+) error {
+	if batchParams.ResetParams.SkipOpen {
+		// the run returned by the query can be closed while a newer run of the workflow is open
+		resp, err := client.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+			Domain: common.StringPtr(batchParams.DomainName),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if resp.WorkflowExecutionInfo.CloseStatus == nil {
+			getActivityLogger(ctx).Info("Skip resetting workflow with open current run",
+				tag.WorkflowID(workflowID), tag.WorkflowRunID(runID))
+			return nil
+		}
+	}
+
+	if batchParams.ResetParams.ResetType == ResetTypeBadBinary {
+		resp, err := client.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+			Domain: common.StringPtr(batchParams.DomainName),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(runID),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if !hasResetPoint(resp.WorkflowExecutionInfo.AutoResetPoints, batchParams.ResetParams.BadBinaryChecksum) {
+			// the run was not touched by the bad binary, nothing to reset
+			return nil
+		}
+	}
+
+	request := &shared.ResetWorkflowExecutionRequest{
+		Domain: common.StringPtr(batchParams.DomainName),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		Reason:    common.StringPtr(batchParams.Reason),
+		RequestId: common.StringPtr(requestID),
+		ResetType: resetTypes[batchParams.ResetParams.ResetType].Ptr(),
+	}
+	if batchParams.ResetParams.ResetType == ResetTypeBadBinary {
+		request.ResetBinaryChecksum = common.StringPtr(batchParams.ResetParams.BadBinaryChecksum)
+	}
+	_, err := client.ResetWorkflowExecution(ctx, request, yarpcCallOptions...)
+	return err
+}
+
+// hasResetPoint returns whether the auto reset points contain an unexpired resettable point of the binary
+func hasResetPoint(
+	resetPoints *shared.ResetPoints,
+	binaryChecksum string,
+) bool {
+	now := time.Now().UnixNano()
+	for _, p := range resetPoints.GetPoints() {
+		if p.GetBinaryChecksum() != binaryChecksum || !p.GetResettable() {
+			continue
+		}
+		if p.GetExpiringTimeNano() > 0 && now > p.GetExpiringTimeNano() {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type workflowSuite struct {
+	suite.Suite
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) TestValidateParams_Reset() {
+	params := BatchParams{
+		DomainName: "test-domain",
+		Query:      "WorkflowType='test'",
+		Reason:     "test",
+		BatchType:  BatchTypeReset,
+	}
+	s.Error(validateParams(params))
+
+	params.ResetParams.ResetType = ResetTypeLastDecisionCompleted
+	s.NoError(validateParams(params))
+
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.Error(validateParams(params))
+	params.ResetParams.BadBinaryChecksum = "bad-binary"
+	s.NoError(validateParams(params))
+}
+
+func (s *workflowSuite) TestValidateParams_Upsert() {
+	params := BatchParams{
+		DomainName: "test-domain",
+		Query:      "WorkflowType='test'",
+		Reason:     "test",
+		BatchType:  BatchTypeUpsert,
+	}
+	s.Error(validateParams(params))
+
+	params.UpsertParams.Memo = map[string][]byte{"key": []byte(`"value"`)}
+	s.NoError(validateParams(params))
+}
+
+func (s *workflowSuite) TestHasResetPoint() {
+	resetPoints := &shared.ResetPoints{
+		Points: []*shared.ResetPointInfo{
+			{BinaryChecksum: common.StringPtr("not-resettable"), Resettable: common.BoolPtr(false)},
+			{BinaryChecksum: common.StringPtr("expired"), Resettable: common.BoolPtr(true), ExpiringTimeNano: common.Int64Ptr(time.Now().Add(-time.Hour).UnixNano())},
+			{BinaryChecksum: common.StringPtr("bad-binary"), Resettable: common.BoolPtr(true)},
+		},
+	}
+	s.True(hasResetPoint(resetPoints, "bad-binary"))
+	s.False(hasResetPoint(resetPoints, "not-resettable"))
+	s.False(hasResetPoint(resetPoints, "expired"))
+	s.False(hasResetPoint(resetPoints, "unknown"))
+	s.False(hasResetPoint(nil, "bad-binary"))
+}
+
+func (s *workflowSuite) TestValidateParams_SignalWithStart() {
+	params := BatchParams{
+		DomainName: "test-domain",
+		Query:      "WorkflowType='test'",
+		Reason:     "test",
+		BatchType:  BatchTypeSignalWithStart,
+		SignalWithStartParams: SignalWithStartParams{
+			SignalName:   "test-signal",
+			WorkflowType: "test-workflow-type",
+			TaskList:     "test-tasklist",
+		},
+	}
+	s.Error(validateParams(params))
+
+	params.SignalWithStartParams.ExecutionStartToCloseTimeoutSeconds = 60
+	params.SignalWithStartParams.TaskStartToCloseTimeoutSeconds = 10
+	s.NoError(validateParams(params))
+}
+
+func (s *workflowSuite) TestSetDefaultParams_RPSByBatchType() {
+	s.Equal(DefaultRPS, setDefaultParams(BatchParams{BatchType: BatchTypeTerminate}).RPS)
+	s.Equal(DefaultResetRPS, setDefaultParams(BatchParams{BatchType: BatchTypeReset}).RPS)
+	s.Equal(DefaultSignalWithStartRPS, setDefaultParams(BatchParams{BatchType: BatchTypeSignalWithStart}).RPS)
+	s.Equal(7, setDefaultParams(BatchParams{BatchType: BatchTypeReset, RPS: 7}).RPS)
+}
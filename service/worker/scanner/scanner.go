@@ -170,7 +170,10 @@ func (s *Scanner) startWorkflow(client cclient.Client, options cclient.StartWork
 
 func (s *Scanner) buildContext() error {
 	cfg := &s.context.cfg
-	pFactory := pfactory.New(cfg.Persistence, cfg.ClusterMetadata.GetCurrentClusterName(), s.context.metricsClient, s.context.logger)
+	pFactory, err := pfactory.New(cfg.Persistence, cfg.ClusterMetadata.GetCurrentClusterName(), s.context.metricsClient, s.context.logger)
+	if err != nil {
+		return err
+	}
 	domainDB, err := pFactory.NewMetadataManager()
 	if err != nil {
 		return err
@@ -0,0 +1,141 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+)
+
+const (
+	watchPollTimeout = time.Minute
+)
+
+// startWorkflowActivity starts the workflow of an action, the workflow ID and the request ID are derived
+// from the nominal time so that retries of the activity do not start the workflow twice
+func startWorkflowActivity(ctx context.Context, request startWorkflowRequest) (*shared.WorkflowExecution, error) {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := scheduler.clientBean.GetFrontendClient()
+
+	action := request.Action
+	prefix := action.GetWorkflowIdPrefix()
+	if prefix == "" {
+		prefix = request.ScheduleID
+	}
+	workflowID := prefix + "-" + request.NominalTime.UTC().Format(time.RFC3339)
+	requestID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(request.Domain+"/"+workflowID)).String()
+
+	resp, err := client.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(request.Domain),
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        action.WorkflowType,
+		TaskList:                            action.TaskList,
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: action.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      action.TaskStartToCloseTimeoutSeconds,
+		Identity:                            common.StringPtr(WorkflowTypeName),
+		RequestId:                           common.StringPtr(requestID),
+		RetryPolicy:                         action.RetryPolicy,
+		Memo:                                action.Memo,
+		SearchAttributes:                    action.SearchAttributes,
+		Header:                              action.Header,
+	})
+	if err != nil {
+		if alreadyStarted, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
+			return &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      alreadyStarted.RunId,
+			}, nil
+		}
+		return nil, toActivityError(err)
+	}
+	return &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      resp.RunId,
+	}, nil
+}
+
+// cancelWorkflowActivity requests cancellation of a workflow started by the schedule
+func cancelWorkflowActivity(ctx context.Context, domain string, execution shared.WorkflowExecution) error {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := scheduler.clientBean.GetFrontendClient()
+
+	err := client.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{
+		Domain:            common.StringPtr(domain),
+		WorkflowExecution: &execution,
+		Identity:          common.StringPtr(WorkflowTypeName),
+		RequestId:         common.StringPtr(uuid.New().String()),
+	})
+	switch err.(type) {
+	case nil, *shared.CancellationAlreadyRequestedError, *shared.EntityNotExistsError:
+		return nil
+	}
+	return toActivityError(err)
+}
+
+// watchWorkflowActivity long polls the history of a workflow started by the schedule until the workflow is closed
+func watchWorkflowActivity(ctx context.Context, domain string, execution shared.WorkflowExecution) error {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := scheduler.clientBean.GetFrontendClient()
+
+	var nextPageToken []byte
+	for {
+		activity.RecordHeartbeat(ctx)
+		pollCtx, cancel := context.WithTimeout(ctx, watchPollTimeout)
+		resp, err := client.GetWorkflowExecutionHistory(pollCtx, &shared.GetWorkflowExecutionHistoryRequest{
+			Domain:                 common.StringPtr(domain),
+			Execution:              &execution,
+			NextPageToken:          nextPageToken,
+			WaitForNewEvent:        common.BoolPtr(true),
+			HistoryEventFilterType: shared.HistoryEventFilterTypeCloseEvent.Ptr(),
+		})
+		cancel()
+		switch err.(type) {
+		case nil:
+		case *shared.EntityNotExistsError:
+			// the workflow is already deleted by retention
+			return nil
+		default:
+			if ctx.Err() == nil && pollCtx.Err() == context.DeadlineExceeded {
+				continue
+			}
+			return err
+		}
+		if resp.History != nil && len(resp.History.Events) > 0 {
+			return nil
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+func toActivityError(err error) error {
+	switch err.(type) {
+	case *shared.BadRequestError, *shared.EntityNotExistsError, *shared.DomainNotActiveError:
+		return cadence.NewCustomError(errReasonNonRetryable, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// decisionTimeoutInSeconds is the decision task timeout of the schedule workflows
+	decisionTimeoutInSeconds = 10
+)
+
+var (
+	errScheduleAlreadyExists = &shared.BadRequestError{Message: "Schedule already exists."}
+	errScheduleNotFound      = &shared.EntityNotExistsError{Message: "Schedule not found."}
+)
+
+type (
+	// Client is used by the frontend to manage the schedule workflows
+	Client interface {
+		CreateSchedule(ctx context.Context, request *shared.CreateScheduleRequest) error
+		DescribeSchedule(ctx context.Context, request *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse, error)
+		UpdateSchedule(ctx context.Context, request *shared.UpdateScheduleRequest) error
+		PauseSchedule(ctx context.Context, request *shared.PauseScheduleRequest) error
+		TriggerSchedule(ctx context.Context, request *shared.TriggerScheduleRequest) error
+		BackfillSchedule(ctx context.Context, request *shared.BackfillScheduleRequest) error
+		DeleteSchedule(ctx context.Context, request *shared.DeleteScheduleRequest) error
+	}
+
+	clientImpl struct {
+		frontendClient frontend.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new schedule client
+func NewClient(frontendClient frontend.Client) Client {
+	return &clientImpl{
+		frontendClient: frontendClient,
+	}
+}
+
+func (c *clientImpl) CreateSchedule(ctx context.Context, request *shared.CreateScheduleRequest) error {
+	input, err := json.Marshal(Schedule{
+		Domain:     request.GetDomain(),
+		ScheduleID: request.GetScheduleId(),
+		Spec:       request.Spec,
+		Action:     request.Action,
+		Policies:   request.Policies,
+		Paused:     request.GetPaused(),
+	})
+	if err != nil {
+		return err
+	}
+	requestID := request.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	_, err = c.frontendClient.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(common.SystemLocalDomainName),
+		WorkflowId:                          common.StringPtr(WorkflowID(request.GetDomain(), request.GetScheduleId())),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr(WorkflowTypeName)},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr(TaskListName)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(infiniteDuration.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(decisionTimeoutInSeconds),
+		Identity:                            request.Identity,
+		RequestId:                           common.StringPtr(requestID),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicyAllowDuplicate.Ptr(),
+	})
+	if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
+		return errScheduleAlreadyExists
+	}
+	return err
+}
+
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+) (*shared.DescribeScheduleResponse, error) {
+	resp, err := c.frontendClient.QueryWorkflow(ctx, &shared.QueryWorkflowRequest{
+		Domain: common.StringPtr(common.SystemLocalDomainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(WorkflowID(request.GetDomain(), request.GetScheduleId())),
+		},
+		Query: &shared.WorkflowQuery{
+			QueryType: common.StringPtr(describeQueryType),
+		},
+		QueryRejectCondition: shared.QueryRejectConditionNotOpen.Ptr(),
+	})
+	if err != nil {
+		return nil, toScheduleError(err)
+	}
+	if resp.QueryRejected != nil {
+		return nil, errScheduleNotFound
+	}
+	var response shared.DescribeScheduleResponse
+	if err := json.Unmarshal(resp.QueryResult, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *clientImpl) UpdateSchedule(ctx context.Context, request *shared.UpdateScheduleRequest) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleId(), updateSignalName, request.Identity, request)
+}
+
+func (c *clientImpl) PauseSchedule(ctx context.Context, request *shared.PauseScheduleRequest) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleId(), pauseSignalName, request.Identity, request)
+}
+
+func (c *clientImpl) TriggerSchedule(ctx context.Context, request *shared.TriggerScheduleRequest) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleId(), triggerSignalName, request.Identity, request)
+}
+
+func (c *clientImpl) BackfillSchedule(ctx context.Context, request *shared.BackfillScheduleRequest) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleId(), backfillSignalName, request.Identity, request)
+}
+
+func (c *clientImpl) DeleteSchedule(ctx context.Context, request *shared.DeleteScheduleRequest) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleId(), deleteSignalName, request.Identity, request)
+}
+
+func (c *clientImpl) signal(
+	ctx context.Context,
+	domain string,
+	scheduleID string,
+	signalName string,
+	identity *string,
+	request interface{},
+) error {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	err = c.frontendClient.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(common.SystemLocalDomainName),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(WorkflowID(domain, scheduleID)),
+		},
+		SignalName: common.StringPtr(signalName),
+		Input:      input,
+		Identity:   identity,
+		RequestId:  common.StringPtr(uuid.New().String()),
+	})
+	return toScheduleError(err)
+}
+
+func toScheduleError(err error) error {
+	if _, ok := err.(*shared.EntityNotExistsError); ok {
+		return errScheduleNotFound
+	}
+	return err
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"time"
+
+	"github.com/robfig/cron"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+var (
+	errInvalidCronExpression     = &shared.BadRequestError{Message: "Invalid CronExpression."}
+	errCronExpressionNotSet      = &shared.BadRequestError{Message: "CronExpression is not set on request."}
+	errInvalidTimezone           = &shared.BadRequestError{Message: "Invalid Timezone."}
+	errInvalidJitterSeconds      = &shared.BadRequestError{Message: "JitterSeconds cannot be negative."}
+	errInvalidTimeRange          = &shared.BadRequestError{Message: "EndTime cannot be before StartTime."}
+	errWorkflowTypeNotSet        = &shared.BadRequestError{Message: "WorkflowType is not set on request."}
+	errTaskListNotSet            = &shared.BadRequestError{Message: "TaskList is not set on request."}
+	errInvalidExecutionTimeout   = &shared.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidTaskTimeout        = &shared.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidOverlapPolicy      = &shared.BadRequestError{Message: "Invalid OverlapPolicy."}
+	errInvalidCatchUpWindowInSec = &shared.BadRequestError{Message: "CatchUpWindowSeconds cannot be negative."}
+)
+
+type (
+	// compiledSpec is the parsed form of a ScheduleSpec, it is what the
+	// schedule workflow uses to compute the times of the actions
+	compiledSpec struct {
+		schedule  cron.Schedule
+		location  *time.Location
+		jitter    time.Duration
+		startTime time.Time
+		endTime   time.Time
+	}
+)
+
+// ValidateSchedule validates the parts of a schedule, parts which are not set are not validated
+func ValidateSchedule(
+	spec *shared.ScheduleSpec,
+	action *shared.ScheduleAction,
+	policies *shared.SchedulePolicies,
+) error {
+	if spec != nil {
+		if _, err := compileSpec(spec); err != nil {
+			return err
+		}
+	}
+	if action != nil {
+		if err := validateAction(action); err != nil {
+			return err
+		}
+	}
+	if policies != nil {
+		if err := validatePolicies(policies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAction(action *shared.ScheduleAction) error {
+	if action.WorkflowType == nil || action.WorkflowType.GetName() == "" {
+		return errWorkflowTypeNotSet
+	}
+	if action.TaskList == nil || action.TaskList.GetName() == "" {
+		return errTaskListNotSet
+	}
+	if action.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
+		return errInvalidExecutionTimeout
+	}
+	if action.GetTaskStartToCloseTimeoutSeconds() <= 0 {
+		return errInvalidTaskTimeout
+	}
+	return nil
+}
+
+func validatePolicies(policies *shared.SchedulePolicies) error {
+	if policies.OverlapPolicy != nil {
+		switch policies.GetOverlapPolicy() {
+		case shared.ScheduleOverlapPolicySkip,
+			shared.ScheduleOverlapPolicyBufferOne,
+			shared.ScheduleOverlapPolicyCancelPrevious,
+			shared.ScheduleOverlapPolicyAllowAll:
+		default:
+			return errInvalidOverlapPolicy
+		}
+	}
+	if policies.GetCatchUpWindowSeconds() < 0 {
+		return errInvalidCatchUpWindowInSec
+	}
+	return nil
+}
+
+func compileSpec(spec *shared.ScheduleSpec) (*compiledSpec, error) {
+	if spec.GetCronExpression() == "" {
+		return nil, errCronExpressionNotSet
+	}
+	schedule, err := cron.ParseStandard(spec.GetCronExpression())
+	if err != nil {
+		return nil, errInvalidCronExpression
+	}
+	// an empty timezone is UTC
+	location, err := time.LoadLocation(spec.GetTimezone())
+	if err != nil {
+		return nil, errInvalidTimezone
+	}
+	if spec.GetJitterSeconds() < 0 {
+		return nil, errInvalidJitterSeconds
+	}
+	compiled := &compiledSpec{
+		schedule: schedule,
+		location: location,
+		jitter:   time.Duration(spec.GetJitterSeconds()) * time.Second,
+	}
+	if spec.StartTime != nil {
+		compiled.startTime = time.Unix(0, spec.GetStartTime())
+	}
+	if spec.EndTime != nil {
+		compiled.endTime = time.Unix(0, spec.GetEndTime())
+	}
+	if !compiled.startTime.IsZero() && !compiled.endTime.IsZero() && compiled.endTime.Before(compiled.startTime) {
+		return nil, errInvalidTimeRange
+	}
+	return compiled, nil
+}
+
+// nextNominalTime returns the first time matched by the spec which is strictly after the given time,
+// zero time is returned when the spec does not match any time after it
+func (s *compiledSpec) nextNominalTime(after time.Time) time.Time {
+	if !s.startTime.IsZero() && after.Before(s.startTime) {
+		after = s.startTime.Add(-time.Nanosecond)
+	}
+	// the cron schedule matches the wall clock of the location of the time it is given
+	next := s.schedule.Next(after.In(s.location))
+	if next.IsZero() || (!s.endTime.IsZero() && next.After(s.endTime)) {
+		return time.Time{}
+	}
+	return next.UTC()
+}
+
+// actionTime returns the time at which the action for the nominal time is taken, the jitter
+// is derived from the schedule ID and the nominal time so it is stable across workflow replays
+func (s *compiledSpec) actionTime(scheduleID string, nominalTime time.Time) time.Time {
+	if s.jitter <= 0 {
+		return nominalTime
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(scheduleID))
+	binary.Write(hash, binary.BigEndian, nominalTime.UnixNano())
+	return nominalTime.Add(time.Duration(hash.Sum64() % uint64(s.jitter)))
+}
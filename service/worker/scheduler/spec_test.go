@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type specSuite struct {
+	suite.Suite
+}
+
+func TestSpecSuite(t *testing.T) {
+	suite.Run(t, new(specSuite))
+}
+
+func (s *specSuite) TestNextNominalTime_Timezone() {
+	spec, err := compileSpec(&shared.ScheduleSpec{
+		CronExpression: common.StringPtr("0 9 * * *"),
+		Timezone:       common.StringPtr("America/New_York"),
+	})
+	s.NoError(err)
+
+	after := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.Equal(time.Date(2019, 6, 1, 13, 0, 0, 0, time.UTC), spec.nextNominalTime(after))
+	after = time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)
+	s.Equal(time.Date(2019, 12, 1, 14, 0, 0, 0, time.UTC), spec.nextNominalTime(after))
+}
+
+func (s *specSuite) TestNextNominalTime_StartAndEndTime() {
+	startTime := time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)
+	endTime := time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)
+	spec, err := compileSpec(&shared.ScheduleSpec{
+		CronExpression: common.StringPtr("0 * * * *"),
+		StartTime:      common.Int64Ptr(startTime.UnixNano()),
+		EndTime:        common.Int64Ptr(endTime.UnixNano()),
+	})
+	s.NoError(err)
+
+	s.Equal(startTime, spec.nextNominalTime(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)))
+	s.Equal(endTime, spec.nextNominalTime(time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)))
+	s.True(spec.nextNominalTime(endTime).IsZero())
+}
+
+func (s *specSuite) TestActionTime_Jitter() {
+	spec, err := compileSpec(&shared.ScheduleSpec{
+		CronExpression: common.StringPtr("*/5 * * * *"),
+		JitterSeconds:  common.Int32Ptr(60),
+	})
+	s.NoError(err)
+
+	nominalTime := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 100; i++ {
+		actionTime := spec.actionTime("schedule", nominalTime)
+		s.False(actionTime.Before(nominalTime))
+		s.True(actionTime.Before(nominalTime.Add(time.Minute)))
+		s.Equal(actionTime, spec.actionTime("schedule", nominalTime))
+		nominalTime = spec.nextNominalTime(nominalTime)
+	}
+}
+
+func (s *specSuite) TestValidateSchedule() {
+	validAction := &shared.ScheduleAction{
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflow-type")},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr("task-list")},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+	}
+	s.NoError(ValidateSchedule(nil, nil, nil))
+	s.NoError(ValidateSchedule(&shared.ScheduleSpec{CronExpression: common.StringPtr("@hourly")}, validAction, &shared.SchedulePolicies{}))
+
+	s.Equal(errCronExpressionNotSet, ValidateSchedule(&shared.ScheduleSpec{}, nil, nil))
+	s.Equal(errInvalidCronExpression, ValidateSchedule(&shared.ScheduleSpec{CronExpression: common.StringPtr("* * *")}, nil, nil))
+	s.Equal(errInvalidTimezone, ValidateSchedule(&shared.ScheduleSpec{
+		CronExpression: common.StringPtr("@hourly"),
+		Timezone:       common.StringPtr("Mars/Olympus_Mons"),
+	}, nil, nil))
+	s.Equal(errInvalidJitterSeconds, ValidateSchedule(&shared.ScheduleSpec{
+		CronExpression: common.StringPtr("@hourly"),
+		JitterSeconds:  common.Int32Ptr(-1),
+	}, nil, nil))
+	s.Equal(errInvalidTimeRange, ValidateSchedule(&shared.ScheduleSpec{
+		CronExpression: common.StringPtr("@hourly"),
+		StartTime:      common.Int64Ptr(2),
+		EndTime:        common.Int64Ptr(1),
+	}, nil, nil))
+	s.Equal(errTaskListNotSet, ValidateSchedule(nil, &shared.ScheduleAction{
+		WorkflowType: validAction.WorkflowType,
+	}, nil))
+	s.Equal(errInvalidOverlapPolicy, ValidateSchedule(nil, nil, &shared.SchedulePolicies{
+		OverlapPolicy: shared.ScheduleOverlapPolicy(100).Ptr(),
+	}))
+	s.Equal(errInvalidCatchUpWindowInSec, ValidateSchedule(nil, nil, &shared.SchedulePolicies{
+		CatchUpWindowSeconds: common.Int32Ptr(-1),
+	}))
+}
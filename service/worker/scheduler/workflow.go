@@ -0,0 +1,560 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	schedulerContextKey = "schedulerContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type
+	WorkflowTypeName           = "cadence-sys-scheduler-workflow"
+	startWorkflowActivityName  = "cadence-sys-scheduler-start-activity"
+	cancelWorkflowActivityName = "cadence-sys-scheduler-cancel-activity"
+	watchWorkflowActivityName  = "cadence-sys-scheduler-watch-activity"
+
+	updateSignalName   = "update"
+	pauseSignalName    = "pause"
+	triggerSignalName  = "trigger"
+	backfillSignalName = "backfill"
+	deleteSignalName   = "delete"
+	describeQueryType  = "describe"
+
+	workflowIDPrefix = "cadence-sys-schedule"
+	// infiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	infiniteDuration     = 20 * 365 * 24 * time.Hour
+	defaultCatchUpWindow = time.Minute
+
+	maxRecentActions    = 10
+	maxNextActionTimes  = 5
+	maxBackfillActions  = 1000
+	maxActionsPerRun    = 100
+	maxIterationsPerRun = 500
+
+	errReasonNonRetryable = "cadence-sys-scheduler-non-retryable-error"
+
+	skippedReasonOverlap = "skipped by overlap policy"
+)
+
+type (
+	// Schedule is the input of the schedule workflow, it is carried over when the workflow continues as new
+	Schedule struct {
+		Domain     string
+		ScheduleID string
+		Spec       *shared.ScheduleSpec
+		Action     *shared.ScheduleAction
+		Policies   *shared.SchedulePolicies
+		Paused     bool
+		Notes      string
+		Info       ScheduleInfo
+	}
+
+	// ScheduleInfo is the state the schedule workflow keeps about the actions it has taken
+	ScheduleInfo struct {
+		CreateTime time.Time
+		UpdateTime time.Time
+		// LastProcessedTime is the last nominal time that has been processed
+		LastProcessedTime   time.Time
+		ActionCount         int64
+		MissedCatchUpWindow int64
+		OverlapSkipped      int64
+		RunningWorkflows    []*shared.WorkflowExecution
+		RecentActions       []*shared.ScheduleActionResult
+		BufferedActions     []*BufferedAction
+	}
+
+	// BufferedAction is an action waiting for the running workflows of the schedule to close
+	BufferedAction struct {
+		NominalTime   time.Time
+		OverlapPolicy shared.ScheduleOverlapPolicy
+	}
+
+	// startWorkflowRequest is the input of the start workflow activity
+	startWorkflowRequest struct {
+		Domain      string
+		ScheduleID  string
+		NominalTime time.Time
+		Action      *shared.ScheduleAction
+	}
+
+	scheduleWorkflow struct {
+		ctx      workflow.Context
+		logger   *zap.Logger
+		schedule Schedule
+		spec     *compiledSpec
+
+		updateCh   workflow.Channel
+		pauseCh    workflow.Channel
+		triggerCh  workflow.Channel
+		backfillCh workflow.Channel
+		deleteCh   workflow.Channel
+		// closedCh receives the run IDs of the started workflows once they are closed
+		closedCh workflow.Channel
+
+		deleted         bool
+		actionsInRun    int
+		cancelRequested map[string]bool
+	}
+)
+
+var (
+	startActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          time.Second,
+		BackoffCoefficient:       2,
+		MaximumInterval:          time.Minute,
+		ExpirationInterval:       10 * time.Minute,
+		NonRetriableErrorReasons: []string{errReasonNonRetryable},
+	}
+
+	startActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &startActivityRetryPolicy,
+	}
+
+	watchActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: infiniteDuration,
+	}
+
+	watchActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy:            &watchActivityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	activity.RegisterWithOptions(startWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	activity.RegisterWithOptions(cancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	activity.RegisterWithOptions(watchWorkflowActivity, activity.RegisterOptions{Name: watchWorkflowActivityName})
+}
+
+// WorkflowID returns the ID of the workflow running the schedule
+func WorkflowID(domain string, scheduleID string) string {
+	return fmt.Sprintf("%v:%v:%v", workflowIDPrefix, domain, scheduleID)
+}
+
+// ScheduleWorkflow is the workflow that takes the actions of a schedule, one workflow runs per schedule
+func ScheduleWorkflow(ctx workflow.Context, schedule Schedule) error {
+	spec, err := compileSpec(schedule.Spec)
+	if err != nil {
+		return err
+	}
+	w := &scheduleWorkflow{
+		ctx:             ctx,
+		logger:          workflow.GetLogger(ctx),
+		schedule:        schedule,
+		spec:            spec,
+		updateCh:        workflow.GetSignalChannel(ctx, updateSignalName),
+		pauseCh:         workflow.GetSignalChannel(ctx, pauseSignalName),
+		triggerCh:       workflow.GetSignalChannel(ctx, triggerSignalName),
+		backfillCh:      workflow.GetSignalChannel(ctx, backfillSignalName),
+		deleteCh:        workflow.GetSignalChannel(ctx, deleteSignalName),
+		closedCh:        workflow.NewChannel(ctx),
+		cancelRequested: make(map[string]bool),
+	}
+	return w.run()
+}
+
+func (w *scheduleWorkflow) run() error {
+	if err := workflow.SetQueryHandler(w.ctx, describeQueryType, w.describe); err != nil {
+		return err
+	}
+
+	now := workflow.Now(w.ctx)
+	info := &w.schedule.Info
+	if info.CreateTime.IsZero() {
+		info.CreateTime = now
+		info.UpdateTime = now
+	}
+	if info.LastProcessedTime.IsZero() {
+		info.LastProcessedTime = now
+	}
+	// workflows started by the previous run are watched again after continue as new
+	for _, execution := range info.RunningWorkflows {
+		w.watchWorkflow(execution)
+	}
+
+	for i := 0; i < maxIterationsPerRun && w.actionsInRun < maxActionsPerRun; i++ {
+		w.drainSignals()
+		if w.deleted {
+			return nil
+		}
+		w.processSpec(workflow.Now(w.ctx))
+		w.processBuffer()
+		w.wait()
+	}
+
+	// signals received by this run must not be lost by continuing as new
+	w.drainSignals()
+	if w.deleted {
+		return nil
+	}
+	return workflow.NewContinueAsNewError(w.ctx, WorkflowTypeName, w.schedule)
+}
+
+// wait blocks until the next action of the schedule is due, a signal is received or a started workflow is closed
+func (w *scheduleWorkflow) wait() {
+	timerCtx, cancelTimer := workflow.WithCancel(w.ctx)
+	defer cancelTimer()
+
+	noop := func(workflow.Channel, bool) {}
+	selector := workflow.NewSelector(w.ctx)
+	if !w.schedule.Paused {
+		if next := w.nextActionTime(); !next.IsZero() {
+			selector.AddFuture(workflow.NewTimer(timerCtx, next.Sub(workflow.Now(w.ctx))), func(workflow.Future) {})
+		}
+	}
+	// the messages are consumed by drainSignals once the selector is unblocked
+	selector.AddReceive(w.updateCh, noop)
+	selector.AddReceive(w.pauseCh, noop)
+	selector.AddReceive(w.triggerCh, noop)
+	selector.AddReceive(w.backfillCh, noop)
+	selector.AddReceive(w.deleteCh, noop)
+	selector.AddReceive(w.closedCh, noop)
+	selector.Select(w.ctx)
+}
+
+func (w *scheduleWorkflow) drainSignals() {
+	for {
+		received := false
+
+		var updateRequest shared.UpdateScheduleRequest
+		if w.updateCh.ReceiveAsync(&updateRequest) {
+			w.handleUpdate(&updateRequest)
+			received = true
+		}
+		var pauseRequest shared.PauseScheduleRequest
+		if w.pauseCh.ReceiveAsync(&pauseRequest) {
+			w.handlePause(&pauseRequest)
+			received = true
+		}
+		var triggerRequest shared.TriggerScheduleRequest
+		if w.triggerCh.ReceiveAsync(&triggerRequest) {
+			w.handleTrigger(&triggerRequest)
+			received = true
+		}
+		var backfillRequest shared.BackfillScheduleRequest
+		if w.backfillCh.ReceiveAsync(&backfillRequest) {
+			w.handleBackfill(&backfillRequest)
+			received = true
+		}
+		var deleteRequest shared.DeleteScheduleRequest
+		if w.deleteCh.ReceiveAsync(&deleteRequest) {
+			w.deleted = true
+			received = true
+		}
+		var runID string
+		if w.closedCh.ReceiveAsync(&runID) {
+			w.removeRunningWorkflow(runID)
+			received = true
+		}
+
+		if !received {
+			return
+		}
+	}
+}
+
+func (w *scheduleWorkflow) handleUpdate(request *shared.UpdateScheduleRequest) {
+	if request.Spec != nil {
+		spec, err := compileSpec(request.Spec)
+		if err != nil {
+			w.logger.Warn("Ignore update of schedule with invalid spec.", zap.Error(err))
+			return
+		}
+		w.spec = spec
+		w.schedule.Spec = request.Spec
+	}
+	if request.Action != nil {
+		w.schedule.Action = request.Action
+	}
+	if request.Policies != nil {
+		w.schedule.Policies = request.Policies
+	}
+	w.schedule.Info.UpdateTime = workflow.Now(w.ctx)
+}
+
+func (w *scheduleWorkflow) handlePause(request *shared.PauseScheduleRequest) {
+	now := workflow.Now(w.ctx)
+	if w.schedule.Paused && request.GetUnpause() {
+		// the actions nominally due while the schedule was paused are not caught up
+		w.schedule.Info.LastProcessedTime = now
+	}
+	w.schedule.Paused = !request.GetUnpause()
+	w.schedule.Notes = request.GetReason()
+	w.schedule.Info.UpdateTime = now
+}
+
+func (w *scheduleWorkflow) handleTrigger(request *shared.TriggerScheduleRequest) {
+	overlapPolicy := w.overlapPolicy()
+	if request.OverlapPolicy != nil {
+		overlapPolicy = request.GetOverlapPolicy()
+	}
+	w.addAction(workflow.Now(w.ctx), overlapPolicy)
+}
+
+func (w *scheduleWorkflow) handleBackfill(request *shared.BackfillScheduleRequest) {
+	overlapPolicy := w.overlapPolicy()
+	if request.OverlapPolicy != nil {
+		overlapPolicy = request.GetOverlapPolicy()
+	}
+	startTime := time.Unix(0, request.GetStartTime())
+	endTime := time.Unix(0, request.GetEndTime())
+	nominalTime := w.spec.nextNominalTime(startTime.Add(-time.Nanosecond))
+	for count := 0; count < maxBackfillActions; count++ {
+		if nominalTime.IsZero() || nominalTime.After(endTime) {
+			return
+		}
+		w.addAction(nominalTime, overlapPolicy)
+		nominalTime = w.spec.nextNominalTime(nominalTime)
+	}
+	w.logger.Warn("Backfill of schedule is truncated.", zap.Int("max-actions", maxBackfillActions))
+}
+
+// processSpec takes the actions of all the nominal times whose action time has passed
+func (w *scheduleWorkflow) processSpec(now time.Time) {
+	info := &w.schedule.Info
+	if w.schedule.Paused {
+		info.LastProcessedTime = now
+		return
+	}
+	catchUpWindow := w.catchUpWindow()
+	for {
+		nominalTime := w.spec.nextNominalTime(info.LastProcessedTime)
+		if nominalTime.IsZero() {
+			return
+		}
+		actionTime := w.spec.actionTime(w.schedule.ScheduleID, nominalTime)
+		if actionTime.After(now) {
+			return
+		}
+		info.LastProcessedTime = nominalTime
+		if now.Sub(actionTime) > catchUpWindow {
+			info.MissedCatchUpWindow++
+			continue
+		}
+		w.addAction(nominalTime, w.overlapPolicy())
+	}
+}
+
+// addAction applies the overlap policy to an action, the action is either skipped or buffered
+func (w *scheduleWorkflow) addAction(nominalTime time.Time, overlapPolicy shared.ScheduleOverlapPolicy) {
+	info := &w.schedule.Info
+	w.actionsInRun++
+	switch overlapPolicy {
+	case shared.ScheduleOverlapPolicySkip:
+		if len(info.RunningWorkflows) > 0 || len(info.BufferedActions) > 0 {
+			w.skipAction(nominalTime)
+			return
+		}
+	case shared.ScheduleOverlapPolicyBufferOne:
+		if len(info.BufferedActions) > 0 {
+			w.skipAction(nominalTime)
+			return
+		}
+	}
+	info.BufferedActions = append(info.BufferedActions, &BufferedAction{
+		NominalTime:   nominalTime,
+		OverlapPolicy: overlapPolicy,
+	})
+}
+
+func (w *scheduleWorkflow) skipAction(nominalTime time.Time) {
+	w.schedule.Info.OverlapSkipped++
+	w.addRecentAction(&shared.ScheduleActionResult{
+		ScheduledTime: common.Int64Ptr(nominalTime.UnixNano()),
+		ActualTime:    common.Int64Ptr(workflow.Now(w.ctx).UnixNano()),
+		SkippedReason: common.StringPtr(skippedReasonOverlap),
+	})
+}
+
+// processBuffer starts the workflows of the buffered actions which are not blocked by running workflows
+func (w *scheduleWorkflow) processBuffer() {
+	info := &w.schedule.Info
+	for len(info.BufferedActions) > 0 {
+		action := info.BufferedActions[0]
+		if len(info.RunningWorkflows) > 0 {
+			switch action.OverlapPolicy {
+			case shared.ScheduleOverlapPolicyAllowAll:
+			case shared.ScheduleOverlapPolicyCancelPrevious:
+				// the action is started once the canceled workflows are closed
+				w.cancelRunningWorkflows()
+				return
+			default:
+				return
+			}
+		}
+		info.BufferedActions = info.BufferedActions[1:]
+		w.startWorkflow(action.NominalTime)
+	}
+}
+
+func (w *scheduleWorkflow) startWorkflow(nominalTime time.Time) {
+	ctx := workflow.WithActivityOptions(w.ctx, startActivityOptions)
+	request := startWorkflowRequest{
+		Domain:      w.schedule.Domain,
+		ScheduleID:  w.schedule.ScheduleID,
+		NominalTime: nominalTime,
+		Action:      w.schedule.Action,
+	}
+	var execution shared.WorkflowExecution
+	err := workflow.ExecuteActivity(ctx, startWorkflowActivityName, request).Get(ctx, &execution)
+
+	result := &shared.ScheduleActionResult{
+		ScheduledTime: common.Int64Ptr(nominalTime.UnixNano()),
+		ActualTime:    common.Int64Ptr(workflow.Now(w.ctx).UnixNano()),
+	}
+	if err != nil {
+		w.logger.Error("Failed to start workflow of schedule.", zap.Error(err))
+		result.SkippedReason = common.StringPtr(errorMessage(err))
+	} else {
+		result.StartedWorkflow = &execution
+		w.schedule.Info.ActionCount++
+		w.schedule.Info.RunningWorkflows = append(w.schedule.Info.RunningWorkflows, &execution)
+		w.watchWorkflow(&execution)
+	}
+	w.addRecentAction(result)
+}
+
+func (w *scheduleWorkflow) cancelRunningWorkflows() {
+	ctx := workflow.WithActivityOptions(w.ctx, startActivityOptions)
+	for _, execution := range w.schedule.Info.RunningWorkflows {
+		if w.cancelRequested[execution.GetRunId()] {
+			continue
+		}
+		err := workflow.ExecuteActivity(ctx, cancelWorkflowActivityName, w.schedule.Domain, execution).Get(ctx, nil)
+		if err != nil {
+			w.logger.Error("Failed to cancel workflow of schedule.", zap.Error(err))
+			continue
+		}
+		w.cancelRequested[execution.GetRunId()] = true
+	}
+}
+
+// watchWorkflow sends the run ID of the workflow to closedCh once the workflow is closed
+func (w *scheduleWorkflow) watchWorkflow(execution *shared.WorkflowExecution) {
+	workflow.Go(w.ctx, func(ctx workflow.Context) {
+		ctx = workflow.WithActivityOptions(ctx, watchActivityOptions)
+		err := workflow.ExecuteActivity(ctx, watchWorkflowActivityName, w.schedule.Domain, execution).Get(ctx, nil)
+		if err != nil {
+			w.logger.Error("Failed to watch workflow of schedule.", zap.Error(err))
+		}
+		w.closedCh.Send(ctx, execution.GetRunId())
+	})
+}
+
+func (w *scheduleWorkflow) removeRunningWorkflow(runID string) {
+	info := &w.schedule.Info
+	for i, execution := range info.RunningWorkflows {
+		if execution.GetRunId() == runID {
+			info.RunningWorkflows = append(info.RunningWorkflows[:i], info.RunningWorkflows[i+1:]...)
+			break
+		}
+	}
+	delete(w.cancelRequested, runID)
+}
+
+func (w *scheduleWorkflow) addRecentAction(result *shared.ScheduleActionResult) {
+	info := &w.schedule.Info
+	info.RecentActions = append(info.RecentActions, result)
+	if len(info.RecentActions) > maxRecentActions {
+		info.RecentActions = info.RecentActions[len(info.RecentActions)-maxRecentActions:]
+	}
+}
+
+// nextActionTime returns the action time of the next nominal time to process, zero time if there is none
+func (w *scheduleWorkflow) nextActionTime() time.Time {
+	nominalTime := w.spec.nextNominalTime(w.schedule.Info.LastProcessedTime)
+	if nominalTime.IsZero() {
+		return nominalTime
+	}
+	return w.spec.actionTime(w.schedule.ScheduleID, nominalTime)
+}
+
+func (w *scheduleWorkflow) overlapPolicy() shared.ScheduleOverlapPolicy {
+	if w.schedule.Policies == nil || w.schedule.Policies.OverlapPolicy == nil {
+		return shared.ScheduleOverlapPolicySkip
+	}
+	return w.schedule.Policies.GetOverlapPolicy()
+}
+
+func (w *scheduleWorkflow) catchUpWindow() time.Duration {
+	if w.schedule.Policies == nil || w.schedule.Policies.CatchUpWindowSeconds == nil {
+		return defaultCatchUpWindow
+	}
+	return time.Duration(w.schedule.Policies.GetCatchUpWindowSeconds()) * time.Second
+}
+
+func (w *scheduleWorkflow) describe() (*shared.DescribeScheduleResponse, error) {
+	info := w.schedule.Info
+	response := &shared.DescribeScheduleResponse{
+		Spec:                w.schedule.Spec,
+		Action:              w.schedule.Action,
+		Policies:            w.schedule.Policies,
+		Paused:              common.BoolPtr(w.schedule.Paused),
+		Notes:               common.StringPtr(w.schedule.Notes),
+		CreateTime:          common.Int64Ptr(info.CreateTime.UnixNano()),
+		UpdateTime:          common.Int64Ptr(info.UpdateTime.UnixNano()),
+		ActionCount:         common.Int64Ptr(info.ActionCount),
+		MissedCatchUpWindow: common.Int64Ptr(info.MissedCatchUpWindow),
+		OverlapSkipped:      common.Int64Ptr(info.OverlapSkipped),
+		RunningWorkflows:    info.RunningWorkflows,
+		RecentActions:       info.RecentActions,
+	}
+	if !w.schedule.Paused {
+		nominalTime := info.LastProcessedTime
+		for len(response.NextActionTimes) < maxNextActionTimes {
+			nominalTime = w.spec.nextNominalTime(nominalTime)
+			if nominalTime.IsZero() {
+				break
+			}
+			response.NextActionTimes = append(response.NextActionTimes, w.spec.actionTime(w.schedule.ScheduleID, nominalTime).UnixNano())
+		}
+	}
+	return response, nil
+}
+
+func errorMessage(err error) string {
+	if customErr, ok := err.(*cadence.CustomError); ok && customErr.HasDetails() {
+		var message string
+		if customErr.Details(&message) == nil {
+			return message
+		}
+	}
+	return err.Error()
+}
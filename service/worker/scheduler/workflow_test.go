@@ -0,0 +1,156 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/cadence/testsuite"
+)
+
+type workflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(workflowTestSuite))
+}
+
+func (s *workflowTestSuite) newEnv(workflowDuration time.Duration) *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartTime(time.Date(2019, 1, 1, 0, 0, 30, 0, time.UTC))
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request startWorkflowRequest) (*shared.WorkflowExecution, error) {
+			return &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(request.ScheduleID),
+				RunId:      common.StringPtr(request.NominalTime.String()),
+			}, nil
+		})
+	env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything, mock.Anything).After(workflowDuration).Return(nil)
+	return env
+}
+
+func (s *workflowTestSuite) newSchedule(overlapPolicy shared.ScheduleOverlapPolicy, paused bool) Schedule {
+	return Schedule{
+		Domain:     "test-domain",
+		ScheduleID: "test-schedule",
+		Spec:       &shared.ScheduleSpec{CronExpression: common.StringPtr("* * * * *")},
+		Action: &shared.ScheduleAction{
+			WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflow-type")},
+			TaskList:                            &shared.TaskList{Name: common.StringPtr("task-list")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(600),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		},
+		Policies: &shared.SchedulePolicies{OverlapPolicy: overlapPolicy.Ptr()},
+		Paused:   paused,
+	}
+}
+
+func (s *workflowTestSuite) describe(env *testsuite.TestWorkflowEnvironment) *shared.DescribeScheduleResponse {
+	value, err := env.QueryWorkflow(describeQueryType)
+	s.NoError(err)
+	var resp shared.DescribeScheduleResponse
+	s.NoError(value.Get(&resp))
+	return &resp
+}
+
+func (s *workflowTestSuite) executeUntilDeleted(env *testsuite.TestWorkflowEnvironment, schedule Schedule, deleteAfter time.Duration) {
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(deleteSignalName, shared.DeleteScheduleRequest{})
+	}, deleteAfter)
+	env.ExecuteWorkflow(WorkflowTypeName, schedule)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *workflowTestSuite) TestScheduleWorkflow_StartsWorkflows() {
+	env := s.newEnv(time.Second)
+	var resp *shared.DescribeScheduleResponse
+	env.RegisterDelayedCallback(func() {
+		resp = s.describe(env)
+	}, 3*time.Minute+15*time.Second)
+	s.executeUntilDeleted(env, s.newSchedule(shared.ScheduleOverlapPolicySkip, false), 4*time.Minute)
+
+	s.Equal(int64(3), resp.GetActionCount())
+	s.Equal(int64(0), resp.GetOverlapSkipped())
+	s.Len(resp.RecentActions, 3)
+	s.Empty(resp.RunningWorkflows)
+	s.Equal(time.Date(2019, 1, 1, 0, 4, 0, 0, time.UTC).UnixNano(), resp.NextActionTimes[0])
+}
+
+func (s *workflowTestSuite) TestScheduleWorkflow_OverlapSkip() {
+	env := s.newEnv(150 * time.Second)
+	var resp *shared.DescribeScheduleResponse
+	env.RegisterDelayedCallback(func() {
+		resp = s.describe(env)
+	}, 4*time.Minute)
+	s.executeUntilDeleted(env, s.newSchedule(shared.ScheduleOverlapPolicySkip, false), 5*time.Minute)
+
+	// the workflow started at 00:01 runs until 00:03:30, the actions of 00:02 and 00:03 are skipped
+	s.Equal(int64(2), resp.GetActionCount())
+	s.Equal(int64(2), resp.GetOverlapSkipped())
+	s.Len(resp.RunningWorkflows, 1)
+}
+
+func (s *workflowTestSuite) TestScheduleWorkflow_OverlapBufferOne() {
+	env := s.newEnv(150 * time.Second)
+	var resp *shared.DescribeScheduleResponse
+	env.RegisterDelayedCallback(func() {
+		resp = s.describe(env)
+	}, 4*time.Minute)
+	s.executeUntilDeleted(env, s.newSchedule(shared.ScheduleOverlapPolicyBufferOne, false), 5*time.Minute)
+
+	// the action of 00:02 is buffered until 00:03:30, the action of 00:03 is skipped
+	s.Equal(int64(2), resp.GetActionCount())
+	s.Equal(int64(1), resp.GetOverlapSkipped())
+	s.Equal(time.Date(2019, 1, 1, 0, 2, 0, 0, time.UTC).UnixNano(), resp.RecentActions[len(resp.RecentActions)-1].GetScheduledTime())
+}
+
+func (s *workflowTestSuite) TestScheduleWorkflow_PauseAndTrigger() {
+	env := s.newEnv(time.Second)
+	var pausedResp, unpausedResp *shared.DescribeScheduleResponse
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(triggerSignalName, shared.TriggerScheduleRequest{})
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		pausedResp = s.describe(env)
+		env.SignalWorkflow(pauseSignalName, shared.PauseScheduleRequest{Unpause: common.BoolPtr(true)})
+	}, 3*time.Minute)
+	env.RegisterDelayedCallback(func() {
+		unpausedResp = s.describe(env)
+	}, 4*time.Minute+15*time.Second)
+	s.executeUntilDeleted(env, s.newSchedule(shared.ScheduleOverlapPolicySkip, true), 5*time.Minute)
+
+	// only the triggered action is taken while the schedule is paused
+	s.True(pausedResp.GetPaused())
+	s.Equal(int64(1), pausedResp.GetActionCount())
+	s.Empty(pausedResp.NextActionTimes)
+	s.False(unpausedResp.GetPaused())
+	s.Equal(int64(2), unpausedResp.GetActionCount())
+	s.Equal(int64(0), unpausedResp.GetMissedCatchUpWindow())
+}
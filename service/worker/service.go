@@ -49,6 +49,7 @@ import (
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 type (
@@ -77,6 +78,7 @@ type (
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
+		EnableScheduler               dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -124,6 +126,7 @@ func NewConfig(params *service.BootstrapParams) *Config {
 		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
+		EnableScheduler:               dc.GetBoolProperty(dynamicconfig.EnableScheduler, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
@@ -154,7 +157,10 @@ func (s *Service) Start() {
 
 	pConfig := s.params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
-	pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
+	pFactory, err := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
+	if err != nil {
+		s.logger.Fatal("failed to create persistence factory", tag.Error(err))
+	}
 	s.ensureSystemDomainExists(pFactory, base.GetClusterMetadata().GetCurrentClusterName())
 	s.metricsClient = base.GetMetricsClient()
 
@@ -177,6 +183,7 @@ func (s *Service) Start() {
 	archiverEnabled := base.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
 	batcherEnabled := s.config.EnableBatcher()
 	parentClosePolicyEnabled := s.config.EnableParentClosePolicyWorker()
+	schedulerEnabled := s.config.EnableScheduler()
 
 	s.startScanner(base)
 	if replicatorEnabled {
@@ -191,6 +198,9 @@ func (s *Service) Start() {
 	if parentClosePolicyEnabled {
 		s.startParentClosePolicyProcessor(base)
 	}
+	if schedulerEnabled {
+		s.startScheduler(base)
+	}
 
 	s.logger.Info("service started", tag.ComponentWorker)
 	<-s.stopC
@@ -236,6 +246,20 @@ func (s *Service) startBatcher(base service.Service) {
 	}
 }
 
+func (s *Service) startScheduler(base service.Service) {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.metricsClient,
+		Logger:        s.logger,
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    base.GetClientBean(),
+	}
+	scheduler := scheduler.New(params)
+	if err := scheduler.Start(); err != nil {
+		s.logger.Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
 		Config:        *s.config.ScannerCfg,
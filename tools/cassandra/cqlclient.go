@@ -64,6 +64,9 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	describeColumnsCQL          = `SELECT table_name, column_name, type, kind from system_schema.columns where keyspace_name=?`
+	describeIndexesCQL          = `SELECT table_name, index_name, options from system_schema.indexes where keyspace_name=?`
+	describeTypesCQL            = `SELECT type_name, field_names, field_types from system_schema.types where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -86,7 +89,7 @@ const (
 		`WITH replication = { 'class' : 'SimpleStrategy', 'replication_factor' : %v};`
 )
 
-var _ schema.DB = (*cqlClient)(nil)
+var _ schema.InspectableDB = (*cqlClient)(nil)
 
 // NewCassandraCluster return gocql clusterConfig
 func NewCassandraCluster(hostsCsv string, port int, user, password, keyspace string, timeoutSeconds int) (*gocql.ClusterConfig, error) {
@@ -230,6 +233,63 @@ func (client *cqlClient) listTypes() ([]string, error) {
 	return names, nil
 }
 
+// DescribeSchema returns the tables and user defined types of the Keyspace
+func (client *cqlClient) DescribeSchema() (*schema.Description, error) {
+	keyspace := client.clusterConfig.Keyspace
+	tables, err := client.ListTables()
+	if err != nil {
+		return nil, err
+	}
+	desc := &schema.Description{
+		Tables: make(map[string]*schema.TableDescription, len(tables)),
+		Types:  make(map[string]map[string]string),
+	}
+	for _, name := range tables {
+		desc.Tables[name] = &schema.TableDescription{
+			Columns: make(map[string]string),
+			Indexes: make(map[string]string),
+		}
+	}
+
+	var table, name, colType, kind string
+	iter := client.session.Query(describeColumnsCQL, keyspace).Iter()
+	for iter.Scan(&table, &name, &colType, &kind) {
+		if t, ok := desc.Tables[table]; ok {
+			t.Columns[name] = fmt.Sprintf("%v %v", colType, kind)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	var options map[string]string
+	iter = client.session.Query(describeIndexesCQL, keyspace).Iter()
+	for iter.Scan(&table, &name, &options) {
+		if t, ok := desc.Tables[table]; ok {
+			t.Indexes[name] = options["target"]
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	var fieldNames, fieldTypes []string
+	iter = client.session.Query(describeTypesCQL, keyspace).Iter()
+	for iter.Scan(&name, &fieldNames, &fieldTypes) {
+		fields := make(map[string]string, len(fieldNames))
+		for i, field := range fieldNames {
+			if i < len(fieldTypes) {
+				fields[field] = fieldTypes[i]
+			}
+		}
+		desc.Types[name] = fields
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
 // dropTable drops a given table from the Keyspace
 func (client *cqlClient) dropTable(name string) error {
 	return client.Exec(fmt.Sprintf("DROP TABLE %v", name))
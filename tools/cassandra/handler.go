@@ -121,6 +121,37 @@ func updateSchema(cli *cli.Context) error {
 	return nil
 }
 
+// validateSchema executes the validateSchemaTask, the expected
+// schema is built in a temporary Keyspace dropped afterwards
+func validateSchema(cli *cli.Context) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := newCQLClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+
+	expectedConfig := *config
+	expectedConfig.Keyspace = schema.ValidateDBName
+	if err := doCreateKeyspace(expectedConfig, expectedConfig.Keyspace); err != nil {
+		return handleErr(fmt.Errorf("error creating validation Keyspace: %v", err))
+	}
+	defer doDropKeyspace(expectedConfig, expectedConfig.Keyspace)
+	expectedClient, err := newCQLClient(&expectedConfig)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer expectedClient.Close()
+
+	if err := schema.Validate(cli, client, expectedClient); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
 // createKeyspace creates a cassandra Keyspace
 func createKeyspace(cli *cli.Context) error {
 	config, err := newCQLClientConfig(cli)
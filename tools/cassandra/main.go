@@ -148,11 +148,29 @@ func buildCLIOptions() *cli.App {
 					Name:  schema.CLIFlagDryrun,
 					Usage: "do a dryrun",
 				},
+				cli.BoolFlag{
+					Name:  schema.CLIFlagPrintOnly,
+					Usage: "print the version transitions and statements of the update without executing them",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema)
 			},
 		},
+		{
+			Name:    "validate-schema",
+			Aliases: []string{"validate"},
+			Usage:   "compare the live cassandra schema with the schema expected for its recorded version",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, validateSchema)
+			},
+		},
 		{
 			Name:    "create-Keyspace",
 			Aliases: []string{"create"},
@@ -60,5 +60,19 @@ func (s *UpdateSchemaTestSuite) TestDryrun() {
 	s.Nil(err)
 	defer client.Close()
 	dir := "../../schema/cassandra/cadence/versioned"
-	s.RunDryrunTest(buildCLIOptions(), client, "-k", dir, "0.23")
+	s.RunDryrunTest(buildCLIOptions(), client, "-k", dir, "0.26")
+}
+
+func (s *UpdateSchemaTestSuite) TestValidateSchema() {
+	client, err := newTestCQLClient(s.DBName)
+	s.Nil(err)
+	defer client.Close()
+	expectedKeyspace := s.DBName + "_expected"
+	s.Nil(client.createKeyspace(expectedKeyspace))
+	defer client.dropKeyspace(expectedKeyspace)
+	expectedClient, err := newTestCQLClient(expectedKeyspace)
+	s.Nil(err)
+	defer expectedClient.Close()
+	dir := "../../schema/cassandra/cadence/versioned"
+	s.RunValidateSchemaTest(buildCLIOptions(), client, expectedClient, "-k", dir)
 }
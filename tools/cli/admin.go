@@ -138,6 +138,48 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:    "refresh-tasks",
+			Aliases: []string{"rt"},
+			Usage:   "Regenerate the transfer and timer tasks of a workflow execution from its mutable state",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRefreshWorkflowTasks(c)
+			},
+		},
+		{
+			Name:    "refresh-tasks-batch",
+			Aliases: []string{"rtb"},
+			Usage:   "Regenerate the transfer and timer tasks of all workflow executions matching a visibility query",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagListQueryWithAlias,
+					Usage: "visibility query to get workflows to refresh tasks for",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultPageSizeForScan,
+					Usage: "page size of each visibility query",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Optional refresh request rate per second",
+					Value: 30,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRefreshWorkflowTasksInBatch(c)
+			},
+		},
 	}
 }
 
@@ -182,6 +224,98 @@ func newAdminShardManagementCommands() []cli.Command {
 	}
 }
 
+func newAdminTaskDLQCommands() []cli.Command {
+	shardFlag := cli.IntFlag{
+		Name:  FlagShardID,
+		Usage: "ShardID of the DLQ",
+	}
+	categoryFlag := cli.StringFlag{
+		Name:  FlagDLQCategoryWithAlias,
+		Value: "transfer",
+		Usage: "Optional DLQ category [transfer|timer]",
+	}
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the tasks in DLQ of a shard",
+			Flags: []cli.Flag{
+				shardFlag,
+				categoryFlag,
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultPageSizeForList,
+					Usage: "Result page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of default page size 500",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListTaskDLQ(c)
+			},
+		},
+		{
+			Name:    "show",
+			Aliases: []string{"s"},
+			Usage:   "Show a task in DLQ of a shard including the persisted task",
+			Flags: []cli.Flag{
+				shardFlag,
+				categoryFlag,
+				cli.Int64Flag{
+					Name:  FlagRemoveTaskID,
+					Usage: "TaskID of the DLQ entry",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShowTaskDLQ(c)
+			},
+		},
+		{
+			Name:    "replay",
+			Aliases: []string{"r"},
+			Usage:   "Reprocess the tasks stored in DLQ entries and remove the entries",
+			Flags: []cli.Flag{
+				shardFlag,
+				categoryFlag,
+				cli.Int64Flag{
+					Name:  FlagRemoveTaskID,
+					Usage: "Optional TaskID of the DLQ entry, all entries of the category are replayed if not set",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultPageSizeForList,
+					Usage: "Number of entries replayed per request",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReplayTaskDLQ(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Remove DLQ entries without processing them",
+			Flags: []cli.Flag{
+				shardFlag,
+				categoryFlag,
+				cli.Int64Flag{
+					Name:  FlagRemoveTaskID,
+					Usage: "Optional TaskID of the DLQ entry, all entries of the category are purged if not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Optional flag to disable confirmation prompt",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPurgeTaskDLQ(c)
+			},
+		},
+	}
+}
+
 func newAdminHistoryHostCommands() []cli.Command {
 	return []cli.Command{
 		{
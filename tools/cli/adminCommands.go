@@ -31,10 +31,12 @@ import (
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
 	cassp "github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/tokenbucket"
 	"github.com/uber/cadence/tools/cassandra"
 	"github.com/urfave/cli"
 )
@@ -237,6 +239,80 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	fmt.Println("delete current row successfully")
 }
 
+// AdminRefreshWorkflowTasks regenerates the tasks of a workflow execution
+func AdminRefreshWorkflowTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.RefreshWorkflowTasks(ctx, &shared.RefreshWorkflowTasksRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Refresh workflow tasks failed", err)
+	}
+	fmt.Println("Refresh workflow tasks succeeded.")
+}
+
+// AdminRefreshWorkflowTasksInBatch regenerates the tasks of all workflow executions matching a visibility query
+func AdminRefreshWorkflowTasksInBatch(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	wfClient := getWorkflowClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	query := getRequiredOption(c, FlagListQuery)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForScan
+	}
+	ratelimiter := tokenbucket.New(c.Int(FlagRPS), clock.NewRealTimeSource())
+
+	succeeded, failed := 0, 0
+	var nextPageToken []byte
+	for {
+		result, token := scanWorkflowExecutions(wfClient, pageSize, nextPageToken, query, c)
+		for _, we := range result {
+			ok, waitTime := ratelimiter.TryConsume(1)
+			if !ok {
+				time.Sleep(waitTime)
+			}
+
+			wid := we.Execution.GetWorkflowId()
+			rid := we.Execution.GetRunId()
+			ctx, cancel := newContext(c)
+			err := adminClient.RefreshWorkflowTasks(ctx, &shared.RefreshWorkflowTasksRequest{
+				Domain: common.StringPtr(domain),
+				Execution: &shared.WorkflowExecution{
+					WorkflowId: common.StringPtr(wid),
+					RunId:      common.StringPtr(rid),
+				},
+			})
+			cancel()
+			if err != nil {
+				failed++
+				fmt.Printf("Refresh workflow tasks failed for %v, %v: %v\n", wid, rid, err)
+				continue
+			}
+			succeeded++
+		}
+
+		nextPageToken = token
+		if nextPageToken == nil {
+			break
+		}
+	}
+	fmt.Printf("Refresh workflow tasks finished, succeeded: %v, failed: %v\n", succeeded, failed)
+}
+
 func readOneRow(query *gocql.Query) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
 	err := query.MapScan(result)
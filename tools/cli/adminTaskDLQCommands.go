@@ -0,0 +1,220 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminListTaskDLQ lists the transfer or timer tasks of a shard which were moved to DLQ
+func AdminListTaskDLQ(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	shardID := getRequiredIntOption(c, FlagShardID)
+	category, err := getTaskDLQCategory(c)
+	if err != nil {
+		ErrorAndExit("Invalid DLQ category.", err)
+		return
+	}
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+	more := c.Bool(FlagMore)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.ReadTaskDLQ(ctx, &shared.ReadTaskDLQRequest{
+			ShardID:         common.Int32Ptr(int32(shardID)),
+			Category:        category.Ptr(),
+			MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+			NextPageToken:   nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ReadTaskDLQ failed.", err)
+			return
+		}
+
+		printTaskDLQEntries(response.Entries)
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 || !more || !showNextPage() {
+			break
+		}
+	}
+}
+
+// AdminShowTaskDLQ shows a single DLQ entry of a shard including the persisted task
+func AdminShowTaskDLQ(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	shardID := getRequiredIntOption(c, FlagShardID)
+	category, err := getTaskDLQCategory(c)
+	if err != nil {
+		ErrorAndExit("Invalid DLQ category.", err)
+		return
+	}
+	taskID := getRequiredInt64Option(c, FlagRemoveTaskID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	response, err := adminClient.ReadTaskDLQ(ctx, &shared.ReadTaskDLQRequest{
+		ShardID:  common.Int32Ptr(int32(shardID)),
+		Category: category.Ptr(),
+		TaskID:   common.Int64Ptr(taskID),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ReadTaskDLQ failed.", err)
+		return
+	}
+	for _, entry := range response.Entries {
+		prettyPrintJSONObject(entry)
+	}
+}
+
+// AdminReplayTaskDLQ reprocesses the tasks stored in DLQ entries of a shard
+func AdminReplayTaskDLQ(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	shardID := getRequiredIntOption(c, FlagShardID)
+	category, err := getTaskDLQCategory(c)
+	if err != nil {
+		ErrorAndExit("Invalid DLQ category.", err)
+		return
+	}
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+	request := &shared.ReplayTaskDLQRequest{
+		ShardID:         common.Int32Ptr(int32(shardID)),
+		Category:        category.Ptr(),
+		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+	}
+	if c.IsSet(FlagRemoveTaskID) {
+		request.TaskID = common.Int64Ptr(c.Int64(FlagRemoveTaskID))
+	}
+
+	var replayedCount, failedCount int32
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.ReplayTaskDLQ(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ReplayTaskDLQ failed.", err)
+			return
+		}
+
+		replayedCount += response.GetReplayedCount()
+		failedCount += response.GetFailedCount()
+		request.NextPageToken = response.NextPageToken
+		if len(request.NextPageToken) == 0 {
+			break
+		}
+	}
+	fmt.Printf("Replay DLQ tasks finished, replayed: %v, failed: %v\n", replayedCount, failedCount)
+}
+
+// AdminPurgeTaskDLQ removes DLQ entries of a shard without processing them
+func AdminPurgeTaskDLQ(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	shardID := getRequiredIntOption(c, FlagShardID)
+	category, err := getTaskDLQCategory(c)
+	if err != nil {
+		ErrorAndExit("Invalid DLQ category.", err)
+		return
+	}
+	request := &shared.PurgeTaskDLQRequest{
+		ShardID:  common.Int32Ptr(int32(shardID)),
+		Category: category.Ptr(),
+	}
+	if c.IsSet(FlagRemoveTaskID) {
+		request.TaskID = common.Int64Ptr(c.Int64(FlagRemoveTaskID))
+	} else if !c.Bool(FlagYes) {
+		fmt.Printf("All %v DLQ tasks of shard %v will be purged.\n", strings.ToLower(category.String()), shardID)
+		reader := bufio.NewReader(os.Stdin)
+		fmt.Print("Please confirm[Yes/No]:")
+		text, err := reader.ReadString('\n')
+		if err != nil {
+			ErrorAndExit("Failed to get confirmation for purging DLQ tasks", err)
+			return
+		}
+		if !strings.EqualFold(strings.TrimSpace(text), "yes") {
+			fmt.Println("DLQ tasks are not purged")
+			return
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if err := adminClient.PurgeTaskDLQ(ctx, request); err != nil {
+		ErrorAndExit("Operation PurgeTaskDLQ failed.", err)
+		return
+	}
+	fmt.Println("Purge DLQ tasks succeeded.")
+}
+
+func getTaskDLQCategory(c *cli.Context) (shared.TaskDLQCategory, error) {
+	switch strings.ToLower(c.String(FlagDLQCategory)) {
+	case "transfer":
+		return shared.TaskDLQCategoryTransfer, nil
+	case "timer":
+		return shared.TaskDLQCategoryTimer, nil
+	default:
+		return shared.TaskDLQCategoryTransfer, fmt.Errorf("unknown DLQ category %v, valid values are [transfer|timer]", c.String(FlagDLQCategory))
+	}
+}
+
+func printTaskDLQEntries(entries []*shared.TaskDLQEntry) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Task ID", "Task Type", "Domain ID", "Workflow ID", "Run ID", "Attempt", "Enqueue Time", "Last Error"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue,
+		tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, entry := range entries {
+		table.Append([]string{
+			strconv.FormatInt(entry.GetTaskID(), 10),
+			strconv.Itoa(int(entry.GetTaskType())),
+			entry.GetDomainID(),
+			entry.GetWorkflowID(),
+			entry.GetRunID(),
+			strconv.Itoa(int(entry.GetAttempt())),
+			convertTime(entry.GetEnqueueTimestamp(), false),
+			entry.GetLastError(),
+		})
+	}
+	table.Render()
+}
@@ -96,6 +96,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on specific shard",
 					Subcommands: newAdminShardManagementCommands(),
 				},
+				{
+					Name:        "task_dlq",
+					Aliases:     []string{"tdlq"},
+					Usage:       "Run admin operation on transfer and timer tasks moved to DLQ after exhausting their attempts",
+					Subcommands: newAdminTaskDLQCommands(),
+				},
 				{
 					Name:        "history_host",
 					Aliases:     []string{"hist"},
@@ -140,6 +146,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence cluster",
 			Subcommands: newClusterCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate cadence schedule",
+			Subcommands: newScheduleCommands(),
+		},
 	}
 
 	// set builder if not customized
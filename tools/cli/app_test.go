@@ -328,6 +328,18 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestDeleteWorkflow() {
+	s.serverFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "delete", "-w", "wid", "--reason", "test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "delete", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.clientFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -519,6 +531,78 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminRefreshWorkflowTasks() {
+	s.serverAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "refresh-tasks", "-w", "test-wf-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRefreshWorkflowTasks_Failed() {
+	s.serverAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "wf", "refresh-tasks", "-w", "test-wf-id"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminRefreshWorkflowTasksInBatch() {
+	resp := &shared.ListWorkflowExecutionsResponse{
+		Executions: listClosedWorkflowExecutionsResponse.Executions,
+	}
+	s.clientFrontendClient.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(nil).Times(len(resp.Executions))
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "refresh-tasks-batch", "-q", "WorkflowType='test'"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListTaskDLQ() {
+	resp := &serverShared.ReadTaskDLQResponse{
+		Entries: []*serverShared.TaskDLQEntry{{TaskID: common.Int64Ptr(123)}},
+	}
+	s.serverAdminClient.EXPECT().ReadTaskDLQ(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "tdlq", "list", "--shard_id", "1", "--dc", "timer"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminShowTaskDLQ_Failed() {
+	s.serverAdminClient.EXPECT().ReadTaskDLQ(gomock.Any(), gomock.Any()).Return(nil, &serverShared.EntityNotExistsError{})
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "tdlq", "show", "--shard_id", "1", "--task_id", "123"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminReplayTaskDLQ() {
+	resp := &serverShared.ReplayTaskDLQResponse{
+		ReplayedCount: common.Int32Ptr(1),
+		FailedCount:   common.Int32Ptr(0),
+	}
+	s.serverAdminClient.EXPECT().ReplayTaskDLQ(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "tdlq", "replay", "--shard_id", "1", "--task_id", "123"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminReplayTaskDLQ_AllPages() {
+	s.serverAdminClient.EXPECT().ReplayTaskDLQ(gomock.Any(), gomock.Any()).Return(&serverShared.ReplayTaskDLQResponse{
+		ReplayedCount: common.Int32Ptr(2),
+		FailedCount:   common.Int32Ptr(0),
+		NextPageToken: []byte{1},
+	}, nil)
+	s.serverAdminClient.EXPECT().ReplayTaskDLQ(gomock.Any(), gomock.Any()).Return(&serverShared.ReplayTaskDLQResponse{
+		ReplayedCount: common.Int32Ptr(1),
+		FailedCount:   common.Int32Ptr(1),
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "tdlq", "replay", "--shard_id", "1", "--ps", "2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminPurgeTaskDLQ() {
+	s.serverAdminClient.EXPECT().PurgeTaskDLQ(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "admin", "tdlq", "purge", "--shard_id", "1", "--yes"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminPurgeTaskDLQ_UnknownCategory() {
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "tdlq", "purge", "--shard_id", "1", "--dc", "replication", "--yes"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	err := s.app.Run([]string{"", "--do", domainName, "admin", "cl", "asa", "--search_attr_key", "testKey", "--search_attr_type", "1"})
 	s.Nil(err)
@@ -618,6 +702,11 @@ func (s *cliAppSuite) TestGetWorkflowIdReusePolicy() {
 	s.Equal(res.String(), shared.WorkflowIdReusePolicyRejectDuplicate.String())
 }
 
+func (s *cliAppSuite) TestGetWorkflowIdReusePolicy_TerminateIfRunning() {
+	res := getWorkflowIDReusePolicy(3)
+	s.Equal(int32(serverShared.WorkflowIdReusePolicyTerminateIfRunning), int32(*res))
+}
+
 func (s *cliAppSuite) TestGetWorkflowIdReusePolicy_Failed_ExceedRange() {
 	oldOsExit := osExit
 	defer func() { osExit = oldOsExit }()
@@ -640,6 +729,23 @@ func (s *cliAppSuite) TestGetWorkflowIdReusePolicy_Failed_Negative() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestResetWorkflow_ByResetType() {
+	resp := &serverShared.ResetWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.serverFrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "reset", "-w", "wid", "-r", "rid", "--reason", "test",
+		"--reset_type", "LastDecisionCompleted"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "reset", "-w", "wid", "-r", "rid", "--reason", "test",
+		"--reset_type", "BadBinary", "--reset_bad_binary_checksum", "bad-binary", "--reset_reapply_type", "None"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResetWorkflow_InvalidReapplyType() {
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "reset", "-w", "wid", "-r", "rid", "--reason", "test",
+		"--reset_type", "LastDecisionCompleted", "--reset_reapply_type", "invalid"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestGetSearchAttributes() {
 	resp := &shared.GetSearchAttributesResponse{}
 	s.clientFrontendClient.EXPECT().GetSearchAttributes(gomock.Any(), callOptions...).Return(resp, nil).Times(2)
@@ -649,6 +755,45 @@ func (s *cliAppSuite) TestGetSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestCreateSchedule() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "create", "--sid", "schedule-id", "--cron", "0 9 * * *",
+		"--tz", "America/New_York", "--wt", "workflow-type", "--tl", "tasklist", "--et", "60", "--op", "buffer_one"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_Failed() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sid", "schedule-id", "--cron", "0 9 * * *",
+		"--wt", "workflow-type", "--tl", "tasklist", "--et", "60"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDescribeSchedule() {
+	resp := &serverShared.DescribeScheduleResponse{
+		RecentActions:   []*serverShared.ScheduleActionResult{{ScheduledTime: common.Int64Ptr(time.Now().UnixNano())}},
+		NextActionTimes: []int64{time.Now().UnixNano()},
+	}
+	s.serverFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "describe", "--sid", "schedule-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseSchedule() {
+	s.serverFrontendClient.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "pause", "--sid", "schedule-id", "--reason", "test"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "schedule", "unpause", "--sid", "schedule-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestBackfillSchedule() {
+	s.serverFrontendClient.EXPECT().BackfillSchedule(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "backfill", "--sid", "schedule-id",
+		"--start_time", "2019-01-01T00:00:00Z", "--end_time", "2019-01-02T00:00:00Z"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestParseBool() {
 	res, err := parseBool("true")
 	s.NoError(err)
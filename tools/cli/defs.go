@@ -26,6 +26,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
 	s "go.uber.org/cadence/.gen/go/shared"
 )
 
@@ -69,6 +70,18 @@ var resetTypesMap = map[string]string{
 	"BadBinary":              FlagResetBadBinaryChecksum,
 }
 
+var resetTypesToServerType = map[string]shared.ResetType{
+	"FirstDecisionCompleted": shared.ResetTypeFirstDecisionCompleted,
+	"LastDecisionCompleted":  shared.ResetTypeLastDecisionCompleted,
+	"LastContinuedAsNew":     shared.ResetTypeLastContinuedAsNew,
+	"BadBinary":              shared.ResetTypeBadBinary,
+}
+
+var resetReapplyTypesMap = map[string]shared.ResetReapplyType{
+	"Signal": shared.ResetReapplyTypeSignal,
+	"None":   shared.ResetReapplyTypeNone,
+}
+
 type jsonType int
 
 const (
@@ -296,12 +296,15 @@ func initializeMetadataMgr(
 		EnableSampling:                  dynamicconfig.GetBoolPropertyFn(false), // not used by domain operation
 		EnableReadFromClosedExecutionV2: dynamicconfig.GetBoolPropertyFn(false), // not used by domain operation
 	}
-	pFactory := persistenceFactory.New(
+	pFactory, err := persistenceFactory.New(
 		&pConfig,
 		clusterMetadata.GetCurrentClusterName(),
 		metricsClient,
 		logger,
 	)
+	if err != nil {
+		ErrorAndExit("Unable to initialize persistence factory.", err)
+	}
 	metadata, err := pFactory.NewMetadataManager()
 	if err != nil {
 		ErrorAndExit("Unable to initialize metadata manager.", err)
@@ -177,6 +177,7 @@ const (
 	FlagResetType                         = "reset_type"
 	FlagResetPointsOnly                   = "reset_points_only"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
+	FlagResetReapplyType                  = "reset_reapply_type"
 	FlagListQuery                         = "query"
 	FlagListQueryWithAlias                = FlagListQuery + ", q"
 	FlagBatchType                         = "batch_type"
@@ -185,7 +186,10 @@ const (
 	FlagSignalNameWithAlias               = FlagSignalName + ", sig"
 	FlagRemoveTaskID                      = "task_id"
 	FlagRemoveTypeID                      = "type_id"
+	FlagDLQCategory                       = "dlq_category"
+	FlagDLQCategoryWithAlias              = FlagDLQCategory + ", dc"
 	FlagRPS                               = "rps"
+	FlagWorkflowInput                     = "workflow_input"
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
 	FlagYes                               = "yes"
@@ -195,6 +199,19 @@ const (
 	FlagServiceEnvWithAlias               = FlagServiceEnv + ", se"
 	FlagServiceZone                       = "service_zone"
 	FlagServiceZoneWithAlias              = FlagServiceZone + ", sz"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sid"
+	FlagTimezone                          = "timezone"
+	FlagTimezoneWithAlias                 = FlagTimezone + ", tz"
+	FlagJitterSeconds                     = "jitter_seconds"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", op"
+	FlagCatchUpWindowSeconds              = "catch_up_window_seconds"
+	FlagWorkflowIDPrefix                  = "workflow_id_prefix"
+	FlagWorkflowIDPrefixWithAlias         = FlagWorkflowIDPrefix + ", wp"
+	FlagPaused                            = "paused"
+	FlagStartTime                         = "start_time"
+	FlagEndTime                           = "end_time"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -287,7 +304,7 @@ func getFlagsForStart() []cli.Flag {
 		cli.IntFlag{
 			Name: FlagWorkflowIDReusePolicyAlias,
 			Usage: "Optional input to configure if the same workflow ID is allow to use for new workflow execution. " +
-				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate",
+				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate, 3: TerminateIfRunning",
 		},
 		cli.StringFlag{
 			Name:  FlagInputWithAlias,
@@ -326,6 +343,72 @@ func getFlagsForStart() []cli.Flag {
 	}
 }
 
+func getFlagsForSchedule() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagScheduleIDWithAlias,
+			Usage: "ScheduleID",
+		},
+		cli.StringFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron schedule of the schedule, in the same format as the cron schedule of a workflow",
+		},
+		cli.StringFlag{
+			Name:  FlagTimezoneWithAlias,
+			Usage: "Optional IANA timezone the cron schedule is evaluated in, like America/New_York. Default to UTC",
+		},
+		cli.IntFlag{
+			Name:  FlagJitterSeconds,
+			Usage: "Optional maximum random delay in seconds added to the time of each action",
+		},
+		cli.StringFlag{
+			Name:  FlagStartTime,
+			Usage: "Optional time before which the schedule takes no action, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagEndTime,
+			Usage: "Optional time after which the schedule takes no action, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDPrefixWithAlias,
+			Usage: "Optional prefix of the IDs of the started workflows. Default to the schedule ID",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeoutWithAlias,
+			Usage: "Execution start to close timeout in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagDecisionTimeoutWithAlias,
+			Value: defaultDecisionTimeoutInSeconds,
+			Usage: "Decision task start to close timeout in seconds",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the workflow, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		cli.StringFlag{
+			Name:  FlagInputFileWithAlias,
+			Usage: "Optional input for the workflow from JSON file. If there are multiple JSON, concatenate them and separate by space or newline.",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Usage: "Optional policy applied when an action is due while a workflow of the schedule is running. Available options: skip, buffer_one, cancel_previous, allow_all. Default to skip",
+		},
+		cli.IntFlag{
+			Name:  FlagCatchUpWindowSeconds,
+			Usage: "Optional duration in seconds after which a missed action is no longer taken. Default to 60",
+		},
+	}
+}
+
 func getFlagsForRun() []cli.Flag {
 	flagsForRun := []cli.Flag{
 		cli.BoolFlag{
@@ -0,0 +1,154 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule which starts a workflow at the times matched by its cron schedule",
+			Flags: append(getFlagsForSchedule(),
+				cli.BoolFlag{
+					Name:  FlagPaused,
+					Usage: "Create the schedule in paused state",
+				},
+			),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Show the spec, action, policies and recent actions of a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update a schedule, the spec, action and policies are only replaced if their options are provided",
+			Flags:   getFlagsForSchedule(),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for pausing the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseSchedule(c, false)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for unpausing the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseSchedule(c, true)
+			},
+		},
+		{
+			Name:  "trigger",
+			Usage: "Take an action of a schedule immediately",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy for this action. Default to the overlap policy of the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				TriggerSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take the actions of a schedule for the times matched by its cron schedule within a time range",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the time range, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "End of the time range, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy for the backfilled actions. Default to the overlap policy of the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, the workflows already started by it are not affected",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+	}
+}
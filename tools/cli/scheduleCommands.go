@@ -0,0 +1,323 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.CreateSchedule(ctx, &shared.CreateScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Spec:       getScheduleSpec(c),
+		Action:     getScheduleAction(c),
+		Policies:   getSchedulePolicies(c),
+		Paused:     common.BoolPtr(c.Bool(FlagPaused)),
+		Identity:   common.StringPtr(getCliIdentity()),
+		RequestId:  common.StringPtr(uuid.New()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Create schedule failed.", err)
+	} else {
+		fmt.Println("Create schedule succeeded.")
+	}
+}
+
+// DescribeSchedule shows the info of a schedule
+func DescribeSchedule(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := serviceClient.DescribeSchedule(ctx, &shared.DescribeScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+	})
+	if err != nil {
+		ErrorAndExit("Describe schedule failed.", err)
+	}
+	prettyPrintJSONObject(convertDescribeScheduleResponse(resp))
+}
+
+// UpdateSchedule updates the spec, action or policies of a schedule
+func UpdateSchedule(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	request := &shared.UpdateScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Identity:   common.StringPtr(getCliIdentity()),
+	}
+	if c.IsSet(FlagCronSchedule) {
+		request.Spec = getScheduleSpec(c)
+	}
+	if c.IsSet(FlagWorkflowType) {
+		request.Action = getScheduleAction(c)
+	}
+	if c.IsSet(FlagOverlapPolicy) || c.IsSet(FlagCatchUpWindowSeconds) {
+		request.Policies = getSchedulePolicies(c)
+	}
+	if request.Spec == nil && request.Action == nil && request.Policies == nil {
+		ErrorAndExit(fmt.Sprintf("At least one of %s, %s, %s or %s is required.",
+			FlagCronSchedule, FlagWorkflowType, FlagOverlapPolicy, FlagCatchUpWindowSeconds), nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.UpdateSchedule(ctx, request)
+
+	if err != nil {
+		ErrorAndExit("Update schedule failed.", err)
+	} else {
+		fmt.Println("Update schedule succeeded.")
+	}
+}
+
+// PauseSchedule pauses or unpauses a schedule
+func PauseSchedule(c *cli.Context, unpause bool) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.PauseSchedule(ctx, &shared.PauseScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Unpause:    common.BoolPtr(unpause),
+		Reason:     common.StringPtr(reason),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+
+	operation := "Pause"
+	if unpause {
+		operation = "Unpause"
+	}
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("%v schedule failed.", operation), err)
+	} else {
+		fmt.Printf("%v schedule succeeded.\n", operation)
+	}
+}
+
+// TriggerSchedule takes an action of a schedule immediately
+func TriggerSchedule(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.TriggerSchedule(ctx, &shared.TriggerScheduleRequest{
+		Domain:        common.StringPtr(domain),
+		ScheduleId:    common.StringPtr(scheduleID),
+		OverlapPolicy: getOverlapPolicy(c),
+		Identity:      common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Trigger schedule failed.", err)
+	} else {
+		fmt.Println("Trigger schedule succeeded.")
+	}
+}
+
+// BackfillSchedule takes the actions of a schedule within a time range
+func BackfillSchedule(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	startTime := parseTime(getRequiredOption(c, FlagStartTime), 0)
+	endTime := parseTime(getRequiredOption(c, FlagEndTime), 0)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.BackfillSchedule(ctx, &shared.BackfillScheduleRequest{
+		Domain:        common.StringPtr(domain),
+		ScheduleId:    common.StringPtr(scheduleID),
+		StartTime:     common.Int64Ptr(startTime),
+		EndTime:       common.Int64Ptr(endTime),
+		OverlapPolicy: getOverlapPolicy(c),
+		Identity:      common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Backfill schedule failed.", err)
+	} else {
+		fmt.Println("Backfill schedule succeeded.")
+	}
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.DeleteSchedule(ctx, &shared.DeleteScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Delete schedule failed.", err)
+	} else {
+		fmt.Println("Delete schedule succeeded.")
+	}
+}
+
+func getScheduleSpec(c *cli.Context) *shared.ScheduleSpec {
+	spec := &shared.ScheduleSpec{
+		CronExpression: common.StringPtr(getRequiredOption(c, FlagCronSchedule)),
+		Timezone:       getPtrOrNilIfEmpty(c.String(FlagTimezone)),
+	}
+	if c.IsSet(FlagJitterSeconds) {
+		spec.JitterSeconds = common.Int32Ptr(int32(c.Int(FlagJitterSeconds)))
+	}
+	if c.IsSet(FlagStartTime) {
+		spec.StartTime = common.Int64Ptr(parseTime(c.String(FlagStartTime), 0))
+	}
+	if c.IsSet(FlagEndTime) {
+		spec.EndTime = common.Int64Ptr(parseTime(c.String(FlagEndTime), 0))
+	}
+	return spec
+}
+
+func getScheduleAction(c *cli.Context) *shared.ScheduleAction {
+	workflowType := getRequiredOption(c, FlagWorkflowType)
+	taskList := getRequiredOption(c, FlagTaskList)
+	et := c.Int(FlagExecutionTimeout)
+	if et == 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+	}
+	dt := c.Int(FlagDecisionTimeout)
+
+	return &shared.ScheduleAction{
+		WorkflowIdPrefix:                    getPtrOrNilIfEmpty(c.String(FlagWorkflowIDPrefix)),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr(workflowType)},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr(taskList)},
+		Input:                               []byte(processJSONInput(c)),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(dt)),
+	}
+}
+
+func getSchedulePolicies(c *cli.Context) *shared.SchedulePolicies {
+	policies := &shared.SchedulePolicies{
+		OverlapPolicy: getOverlapPolicy(c),
+	}
+	if c.IsSet(FlagCatchUpWindowSeconds) {
+		policies.CatchUpWindowSeconds = common.Int32Ptr(int32(c.Int(FlagCatchUpWindowSeconds)))
+	}
+	return policies
+}
+
+func getOverlapPolicy(c *cli.Context) *shared.ScheduleOverlapPolicy {
+	if !c.IsSet(FlagOverlapPolicy) {
+		return nil
+	}
+	var policy shared.ScheduleOverlapPolicy
+	if err := policy.UnmarshalText([]byte(strings.ToUpper(c.String(FlagOverlapPolicy)))); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagOverlapPolicy), err)
+	}
+	return &policy
+}
+
+type scheduleActionResult struct {
+	ScheduledTime   string
+	ActualTime      string
+	StartedWorkflow *shared.WorkflowExecution `json:",omitempty"`
+	SkippedReason   string                    `json:",omitempty"`
+}
+
+type describeScheduleResponse struct {
+	Spec                *shared.ScheduleSpec
+	Action              *shared.ScheduleAction
+	Policies            *shared.SchedulePolicies
+	Paused              bool
+	Notes               string `json:",omitempty"`
+	CreateTime          string
+	UpdateTime          string
+	ActionCount         int64
+	MissedCatchUpWindow int64
+	OverlapSkipped      int64
+	RunningWorkflows    []*shared.WorkflowExecution
+	RecentActions       []*scheduleActionResult
+	NextActionTimes     []string
+}
+
+func convertDescribeScheduleResponse(resp *shared.DescribeScheduleResponse) *describeScheduleResponse {
+	result := &describeScheduleResponse{
+		Spec:                resp.Spec,
+		Action:              resp.Action,
+		Policies:            resp.Policies,
+		Paused:              resp.GetPaused(),
+		Notes:               resp.GetNotes(),
+		CreateTime:          convertTime(resp.GetCreateTime(), false),
+		UpdateTime:          convertTime(resp.GetUpdateTime(), false),
+		ActionCount:         resp.GetActionCount(),
+		MissedCatchUpWindow: resp.GetMissedCatchUpWindow(),
+		OverlapSkipped:      resp.GetOverlapSkipped(),
+		RunningWorkflows:    resp.RunningWorkflows,
+	}
+	for _, action := range resp.RecentActions {
+		result.RecentActions = append(result.RecentActions, &scheduleActionResult{
+			ScheduledTime:   convertTime(action.GetScheduledTime(), false),
+			ActualTime:      convertTime(action.GetActualTime(), false),
+			StartedWorkflow: action.StartedWorkflow,
+			SkippedReason:   action.GetSkippedReason(),
+		})
+	}
+	for _, nextActionTime := range resp.NextActionTimes {
+		result.NextActionTimes = append(result.NextActionTimes, convertTime(nextActionTime, false))
+	}
+	return result
+}
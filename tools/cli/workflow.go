@@ -129,6 +129,70 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, no task is dispatched until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to pause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to unpause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "delete a workflow execution, terminating it first if it is still running",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to delete the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -257,6 +321,10 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagResetBadBinaryChecksum,
 					Usage: "Binary checksum for resetType of BadBinary",
 				},
+				cli.StringFlag{
+					Name:  FlagResetReapplyType,
+					Usage: "whether to reapply the events received after the reset point. Support one of these: Signal,None. Default to Signal",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetWorkflow(c)
@@ -306,6 +374,10 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagResetBadBinaryChecksum,
 					Usage: "Binary checksum for resetType of BadBinary",
 				},
+				cli.StringFlag{
+					Name:  FlagResetReapplyType,
+					Usage: "whether to reapply the events received after the reset point. Support one of these: Signal,None. Default to Signal",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetInBatch(c)
@@ -454,16 +526,68 @@ func newBatchCommands() []cli.Command {
 				//below are optional
 				cli.StringFlag{
 					Name:  FlagSignalNameWithAlias,
-					Usage: "Required for batch signal",
+					Usage: "Required for batch signal and signalwithstart",
 				},
 				cli.StringFlag{
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for batch reset of BadBinary",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipCurrent,
+					Usage: "Optional for batch reset, skip the workflow if the current run is open",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "Required for batch signalwithstart, workflow type to start if the workflow is not running",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Required for batch signalwithstart, tasklist of the started workflow",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowInput,
+					Usage: "Optional for batch signalwithstart, input of the started workflow",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Required for batch signalwithstart, execution start to close timeout in seconds of the started workflow",
+				},
+				cli.IntFlag{
+					Name:  FlagDecisionTimeoutWithAlias,
+					Value: defaultDecisionTimeoutInSeconds,
+					Usage: "Optional for batch signalwithstart, decision task start to close timeout in seconds of the started workflow",
+				},
+				cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Optional for batch upsert, search attributes keys to upsert. If there are multiple keys, concatenate them and separate by |",
+				},
+				cli.StringFlag{
+					Name:  FlagSearchAttributesVal,
+					Usage: "Optional for batch upsert, search attributes values to upsert. If there are multiple values, concatenate them and separate by |",
+				},
+				cli.StringFlag{
+					Name:  FlagMemoKey,
+					Usage: "Optional for batch upsert, memo keys to upsert. If there are multiple keys, concatenate them and separate by space",
+				},
+				cli.StringFlag{
+					Name:  FlagMemo,
+					Usage: "Optional for batch upsert, memo values to upsert in JSON format. If there are multiple JSON, concatenate them and separate by space",
+				},
+				cli.StringFlag{
+					Name:  FlagMemoFile,
+					Usage: "Optional for batch upsert, memo values to upsert from JSON format file",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
-					Value: batcher.DefaultRPS,
-					Usage: "RPS of processing",
+					Usage: "RPS of processing. Default depends on the batch type",
 				},
 				cli.BoolFlag{
 					Name:  FlagYes,
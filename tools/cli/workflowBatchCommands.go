@@ -139,6 +139,39 @@ func StartBatchJob(c *cli.Context) {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
+	var resetParams batcher.ResetParams
+	if batchType == batcher.BatchTypeReset {
+		resetParams.ResetType = getRequiredOption(c, FlagResetType)
+		if !validateResetType(resetParams.ResetType) {
+			ErrorAndExit("resetType is not valid, supported:"+strings.Join(batcher.AllResetTypes, ","), nil)
+		}
+		if resetParams.ResetType == batcher.ResetTypeBadBinary {
+			resetParams.BadBinaryChecksum = getRequiredOption(c, FlagResetBadBinaryChecksum)
+		}
+		resetParams.SkipOpen = c.Bool(FlagSkipCurrent)
+	}
+	var signalWithStartParams batcher.SignalWithStartParams
+	if batchType == batcher.BatchTypeSignalWithStart {
+		signalWithStartParams = batcher.SignalWithStartParams{
+			SignalName:                          getRequiredOption(c, FlagSignalName),
+			SignalInput:                         c.String(FlagInput),
+			WorkflowType:                        getRequiredOption(c, FlagWorkflowType),
+			TaskList:                            getRequiredOption(c, FlagTaskList),
+			Input:                               c.String(FlagWorkflowInput),
+			ExecutionStartToCloseTimeoutSeconds: int32(getRequiredIntOption(c, FlagExecutionTimeout)),
+			TaskStartToCloseTimeoutSeconds:      int32(c.Int(FlagDecisionTimeout)),
+		}
+	}
+	var upsertParams batcher.UpsertParams
+	if batchType == batcher.BatchTypeUpsert {
+		upsertParams = batcher.UpsertParams{
+			SearchAttributes: processSearchAttr(c),
+			Memo:             processMemo(c),
+		}
+		if len(upsertParams.SearchAttributes) == 0 && len(upsertParams.Memo) == 0 {
+			ErrorAndExit("Search attributes or memo is required for batch upsert.", nil)
+		}
+	}
 	rps := c.Int(FlagRPS)
 
 	svcClient := cFactory.ClientFrontendClient(c)
@@ -192,7 +225,10 @@ func StartBatchJob(c *cli.Context) {
 			SignalName: sigName,
 			Input:      sigVal,
 		},
-		RPS: rps,
+		ResetParams:           resetParams,
+		SignalWithStartParams: signalWithStartParams,
+		UpsertParams:          upsertParams,
+		RPS:                   rps,
 	}
 	wf, err := client.StartWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
 	if err != nil {
@@ -213,3 +249,12 @@ func validateBatchType(bt string) bool {
 	}
 	return false
 }
+
+func validateResetType(rt string) bool {
+	for _, r := range batcher.AllResetTypes {
+		if r == rt {
+			return true
+		}
+	}
+	return false
+}
@@ -41,8 +41,6 @@ import (
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
-	"github.com/uber/cadence/common/clock"
-	"github.com/uber/cadence/service/history"
 	"github.com/urfave/cli"
 	"github.com/valyala/fastjson"
 	s "go.uber.org/cadence/.gen/go/shared"
@@ -418,6 +416,90 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.PauseWorkflowExecution(ctx, &shared.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:   common.StringPtr(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.UnpauseWorkflowExecution(ctx, &shared.UnpauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:   common.StringPtr(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
+// DeleteWorkflow deletes a workflow execution
+func DeleteWorkflow(c *cli.Context) {
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.DeleteWorkflowExecution(ctx, &shared.DeleteWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:   common.StringPtr(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Delete workflow failed.", err)
+	} else {
+		fmt.Println("Delete workflow succeeded.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -837,6 +919,8 @@ type describeWorkflowExecutionResponse struct {
 	WorkflowExecutionInfo  workflowExecutionInfo
 	PendingActivities      []*pendingActivityInfo
 	PendingChildren        []*shared.PendingChildExecutionInfo
+	PendingTimers          []*pendingTimerInfo
+	PendingDecision        *pendingDecisionInfo
 }
 
 // workflowExecutionInfo has same fields as shared.WorkflowExecutionInfo, but has datetime instead of raw time
@@ -851,6 +935,8 @@ type workflowExecutionInfo struct {
 	ParentExecution  *shared.WorkflowExecution
 	SearchAttributes map[string]interface{}
 	AutoResetPoints  *shared.ResetPoints
+	Paused           *bool `json:",omitempty"`
+	WaitingToStart   *bool `json:",omitempty"`
 }
 
 // pendingActivityInfo has same fields as shared.PendingActivityInfo, but different field type for better display
@@ -870,6 +956,22 @@ type pendingActivityInfo struct {
 	LastFailureDetails     *string `json:",omitempty"` // change from []byte
 }
 
+// pendingTimerInfo has same fields as shared.PendingTimerInfo, but has datetime instead of raw time
+type pendingTimerInfo struct {
+	TimerID         *string
+	StartedEventID  *int64
+	ExpiryTimestamp *string // change from *int64
+}
+
+// pendingDecisionInfo has same fields as shared.PendingDecisionInfo, but has datetime instead of raw time
+type pendingDecisionInfo struct {
+	State                      *shared.PendingDecisionState
+	ScheduledTimestamp         *string `json:",omitempty"` // change from *int64
+	StartedTimestamp           *string `json:",omitempty"` // change from *int64
+	Attempt                    *int64
+	OriginalScheduledTimestamp *string `json:",omitempty"` // change from *int64
+}
+
 func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecutionResponse,
 	wfClient workflowserviceclient.Interface, c *cli.Context) *describeWorkflowExecutionResponse {
 
@@ -885,6 +987,8 @@ func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecu
 		ParentExecution:  info.ParentExecution,
 		SearchAttributes: convertSearchAttributesToMapOfInterface(info.SearchAttributes, wfClient, c),
 		AutoResetPoints:  info.AutoResetPoints,
+		Paused:           info.Paused,
+		WaitingToStart:   info.WaitingToStart,
 	}
 
 	var pendingActs []*pendingActivityInfo
@@ -912,11 +1016,33 @@ func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecu
 		pendingActs = append(pendingActs, tmpAct)
 	}
 
+	var pendingTimers []*pendingTimerInfo
+	for _, pt := range resp.PendingTimers {
+		pendingTimers = append(pendingTimers, &pendingTimerInfo{
+			TimerID:         pt.TimerID,
+			StartedEventID:  pt.StartedEventID,
+			ExpiryTimestamp: timestampPtrToStringPtr(pt.ExpiryTimestamp, false),
+		})
+	}
+
+	var pendingDecision *pendingDecisionInfo
+	if pd := resp.PendingDecision; pd != nil {
+		pendingDecision = &pendingDecisionInfo{
+			State:                      pd.State,
+			ScheduledTimestamp:         timestampPtrToStringPtr(pd.ScheduledTimestamp, false),
+			StartedTimestamp:           timestampPtrToStringPtr(pd.StartedTimestamp, false),
+			Attempt:                    pd.Attempt,
+			OriginalScheduledTimestamp: timestampPtrToStringPtr(pd.OriginalScheduledTimestamp, false),
+		}
+	}
+
 	return &describeWorkflowExecutionResponse{
 		ExecutionConfiguration: resp.ExecutionConfiguration,
 		WorkflowExecutionInfo:  executionInfo,
 		PendingActivities:      pendingActs,
 		PendingChildren:        resp.PendingChildren,
+		PendingTimers:          pendingTimers,
+		PendingDecision:        pendingDecision,
 	}
 }
 
@@ -1316,7 +1442,7 @@ func getWorkflowStatus(statusStr string) s.WorkflowExecutionCloseStatus {
 }
 
 func getWorkflowIDReusePolicy(value int) *s.WorkflowIdReusePolicy {
-	if value >= 0 && value <= len(s.WorkflowIdReusePolicy_Values()) {
+	if value >= 0 && value < len(shared.WorkflowIdReusePolicy_Values()) {
 		return s.WorkflowIdReusePolicy(value).Ptr()
 	}
 	// At this point, the policy should return if the value is valid
@@ -1371,37 +1497,44 @@ func ResetWorkflow(c *cli.Context) {
 		getRequiredOption(c, extraForResetType)
 	}
 
+	reapplyType, err := getResetReapplyType(c)
+	if err != nil {
+		ErrorAndExit("Invalid reset reapply type", err)
+		return
+	}
+
 	ctx, cancel := newContext(c)
 	defer cancel()
 
 	frontendClient := cFactory.ServerFrontendClient(c)
 
-	resetBaseRunID := rid
-	decisionFinishID := eventID
-	var err error
-	if resetType != "" {
-		resetBaseRunID, decisionFinishID, err = getResetEventIDByType(ctx, c, resetType, domain, wid, rid, frontendClient)
-		if err != nil {
-			ErrorAndExit("getResetEventIDByType failed", err)
-		}
-	}
-	resp, err := frontendClient.ResetWorkflowExecution(ctx, &shared.ResetWorkflowExecutionRequest{
+	request := &shared.ResetWorkflowExecutionRequest{
 		Domain: common.StringPtr(domain),
 		WorkflowExecution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr(wid),
-			RunId:      common.StringPtr(resetBaseRunID),
+			RunId:      common.StringPtr(rid),
 		},
-		Reason:                common.StringPtr(fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), reason)),
-		DecisionFinishEventId: common.Int64Ptr(decisionFinishID),
-		RequestId:             common.StringPtr(uuid.New()),
-	})
+		Reason:           common.StringPtr(fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), reason)),
+		RequestId:        common.StringPtr(uuid.New()),
+		ResetReapplyType: reapplyType,
+	}
+	if resetType != "" {
+		// the reset point is resolved by the server
+		request.ResetType = resetTypesToServerType[resetType].Ptr()
+		if resetType == "BadBinary" {
+			request.ResetBinaryChecksum = common.StringPtr(c.String(FlagResetBadBinaryChecksum))
+		}
+	} else {
+		request.DecisionFinishEventId = common.Int64Ptr(eventID)
+	}
+	resp, err := frontendClient.ResetWorkflowExecution(ctx, request)
 	if err != nil {
 		ErrorAndExit("reset failed", err)
 	}
 	prettyPrintJSONObject(resp)
 }
 
-func processResets(c *cli.Context, domain string, wes chan shared.WorkflowExecution, done chan bool, wg *sync.WaitGroup, reason, resetType string, skipOpen bool) {
+func processResets(c *cli.Context, domain string, wes chan shared.WorkflowExecution, done chan bool, wg *sync.WaitGroup, reason, resetType string, skipOpen bool, reapplyType *shared.ResetReapplyType) {
 	for {
 		select {
 		case we := <-wes:
@@ -1410,7 +1543,7 @@ func processResets(c *cli.Context, domain string, wes chan shared.WorkflowExecut
 			rid := we.GetRunId()
 			var err error
 			for i := 0; i < 3; i++ {
-				err = doReset(c, domain, wid, rid, reason, resetType, skipOpen)
+				err = doReset(c, domain, wid, rid, reason, resetType, skipOpen, reapplyType)
 				if err == nil {
 					break
 				}
@@ -1450,6 +1583,11 @@ func ResetInBatch(c *cli.Context) {
 	} else if len(extraForResetType) > 0 {
 		getRequiredOption(c, extraForResetType)
 	}
+	reapplyType, err := getResetReapplyType(c)
+	if err != nil {
+		ErrorAndExit("Invalid reset reapply type", err)
+		return
+	}
 
 	if inFileName == "" && query == "" {
 		ErrorAndExit("Must provide input file or list query to get target workflows to reset", nil)
@@ -1461,7 +1599,7 @@ func ResetInBatch(c *cli.Context) {
 	done := make(chan bool)
 	for i := 0; i < parallel; i++ {
 		wg.Add(1)
-		go processResets(c, domain, wes, done, wg, reason, resetType, skipOpen)
+		go processResets(c, domain, wes, done, wg, reason, resetType, skipOpen, reapplyType)
 	}
 
 	// read exclude
@@ -1582,7 +1720,7 @@ func printErrorAndReturn(msg string, err error) error {
 	return err
 }
 
-func doReset(c *cli.Context, domain, wid, rid string, reason, resetType string, skipOpen bool) error {
+func doReset(c *cli.Context, domain, wid, rid string, reason, resetType string, skipOpen bool, reapplyType *shared.ResetReapplyType) error {
 	ctx, cancel := newContext(c)
 	defer cancel()
 
@@ -1614,207 +1752,38 @@ func doReset(c *cli.Context, domain, wid, rid string, reason, resetType string,
 		}
 	}
 
-	resetBaseRunID, decisionFinishID, err := getResetEventIDByType(ctx, c, resetType, domain, wid, rid, frontendClient)
-	if err != nil {
-		return printErrorAndReturn("getResetEventIDByType failed", err)
-	}
-	fmt.Println("DecisionFinishEventId for reset:", wid, rid, resetBaseRunID, decisionFinishID)
-
-	resp2, err := frontendClient.ResetWorkflowExecution(ctx, &shared.ResetWorkflowExecutionRequest{
+	// the reset point is resolved by the server
+	request := &shared.ResetWorkflowExecutionRequest{
 		Domain: common.StringPtr(domain),
 		WorkflowExecution: &shared.WorkflowExecution{
-			WorkflowId: common.StringPtr(wid),
-			RunId:      common.StringPtr(resetBaseRunID),
-		},
-		DecisionFinishEventId: common.Int64Ptr(decisionFinishID),
-		RequestId:             common.StringPtr(uuid.New()),
-		Reason:                common.StringPtr(fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), reason)),
-	})
-
-	if err != nil {
-		return printErrorAndReturn("ResetWorkflowExecution failed", err)
-	}
-	fmt.Println("new runID for wid/rid is ,", wid, rid, resp2.GetRunId())
-	return nil
-}
-
-func getResetEventIDByType(ctx context.Context, c *cli.Context, resetType, domain, wid, rid string, frontendClient workflowserviceclient.Interface) (resetBaseRunID string, decisionFinishID int64, err error) {
-	fmt.Println("resetType:", resetType)
-	switch resetType {
-	case "LastDecisionCompleted":
-		resetBaseRunID, decisionFinishID, err = getLastDecisionCompletedID(ctx, domain, wid, rid, frontendClient)
-		if err != nil {
-			return
-		}
-	case "LastContinuedAsNew":
-		resetBaseRunID, decisionFinishID, err = getLastContinueAsNewID(ctx, domain, wid, rid, frontendClient)
-		if err != nil {
-			return
-		}
-	case "FirstDecisionCompleted":
-		resetBaseRunID, decisionFinishID, err = getFirstDecisionCompletedID(ctx, domain, wid, rid, frontendClient)
-		if err != nil {
-			return
-		}
-	case "BadBinary":
-		binCheckSum := c.String(FlagResetBadBinaryChecksum)
-		resetBaseRunID, decisionFinishID, err = getBadDecisionCompletedID(ctx, domain, wid, rid, binCheckSum, frontendClient)
-		if err != nil {
-			return
-		}
-	default:
-		panic("not supported resetType")
-	}
-	return
-}
-
-func getLastDecisionCompletedID(ctx context.Context, domain, wid, rid string, frontendClient workflowserviceclient.Interface) (resetBaseRunID string, decisionFinishID int64, err error) {
-	resetBaseRunID = rid
-	req := &shared.GetWorkflowExecutionHistoryRequest{
-		Domain: common.StringPtr(domain),
-		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr(wid),
 			RunId:      common.StringPtr(rid),
 		},
-		MaximumPageSize: common.Int32Ptr(1000),
-		NextPageToken:   nil,
+		ResetType:        resetTypesToServerType[resetType].Ptr(),
+		RequestId:        common.StringPtr(uuid.New()),
+		Reason:           common.StringPtr(fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), reason)),
+		ResetReapplyType: reapplyType,
 	}
-
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == shared.EventTypeDecisionTaskCompleted {
-				decisionFinishID = e.GetEventId()
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
+	if resetType == "BadBinary" {
+		request.ResetBinaryChecksum = common.StringPtr(c.String(FlagResetBadBinaryChecksum))
 	}
-	if decisionFinishID == 0 {
-		return "", 0, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
-	}
-	return
-}
-
-func getBadDecisionCompletedID(ctx context.Context, domain, wid, rid, binChecksum string, frontendClient workflowserviceclient.Interface) (resetBaseRunID string, decisionFinishID int64, err error) {
-	resetBaseRunID = rid
-	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
-		Domain: common.StringPtr(domain),
-		Execution: &shared.WorkflowExecution{
-			WorkflowId: common.StringPtr(wid),
-			RunId:      common.StringPtr(rid),
-		},
-	})
+	resp2, err := frontendClient.ResetWorkflowExecution(ctx, request)
 	if err != nil {
-		return "", 0, printErrorAndReturn("DescribeWorkflowExecution failed", err)
-	}
-
-	_, p := history.FindAutoResetPoint(clock.NewRealTimeSource(), &shared.BadBinaries{
-		Binaries: map[string]*shared.BadBinaryInfo{
-			binChecksum: {},
-		},
-	}, resp.WorkflowExecutionInfo.AutoResetPoints)
-	if p != nil {
-		decisionFinishID = p.GetFirstDecisionCompletedId()
-	}
-
-	if decisionFinishID == 0 {
-		return "", 0, printErrorAndReturn("Get DecisionFinishID failed", &shared.BadRequestError{"no DecisionFinishID"})
+		return printErrorAndReturn("ResetWorkflowExecution failed", err)
 	}
-	return
+	fmt.Println("new runID for wid/rid is ,", wid, rid, resp2.GetRunId())
+	return nil
 }
 
-func getFirstDecisionCompletedID(ctx context.Context, domain, wid, rid string, frontendClient workflowserviceclient.Interface) (resetBaseRunID string, decisionFinishID int64, err error) {
-	resetBaseRunID = rid
-	req := &shared.GetWorkflowExecutionHistoryRequest{
-		Domain: common.StringPtr(domain),
-		Execution: &shared.WorkflowExecution{
-			WorkflowId: common.StringPtr(wid),
-			RunId:      common.StringPtr(rid),
-		},
-		MaximumPageSize: common.Int32Ptr(1000),
-		NextPageToken:   nil,
-	}
-
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == shared.EventTypeDecisionTaskCompleted {
-				decisionFinishID = e.GetEventId()
-				return resetBaseRunID, decisionFinishID, nil
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if decisionFinishID == 0 {
-		return "", 0, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
+func getResetReapplyType(c *cli.Context) (*shared.ResetReapplyType, error) {
+	if !c.IsSet(FlagResetReapplyType) {
+		return nil, nil
 	}
-	return
-}
-
-func getLastContinueAsNewID(ctx context.Context, domain, wid, rid string, frontendClient workflowserviceclient.Interface) (resetBaseRunID string, decisionFinishID int64, err error) {
-	// get first event
-	req := &shared.GetWorkflowExecutionHistoryRequest{
-		Domain: common.StringPtr(domain),
-		Execution: &shared.WorkflowExecution{
-			WorkflowId: common.StringPtr(wid),
-			RunId:      common.StringPtr(rid),
-		},
-		MaximumPageSize: common.Int32Ptr(1),
-		NextPageToken:   nil,
-	}
-	resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-	if err != nil {
-		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-	}
-	firstEvent := resp.History.Events[0]
-	resetBaseRunID = firstEvent.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
-	if resetBaseRunID == "" {
-		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", fmt.Errorf("cannot get resetBaseRunID"))
-	}
-
-	req = &shared.GetWorkflowExecutionHistoryRequest{
-		Domain: common.StringPtr(domain),
-		Execution: &shared.WorkflowExecution{
-			WorkflowId: common.StringPtr(wid),
-			RunId:      common.StringPtr(resetBaseRunID),
-		},
-		MaximumPageSize: common.Int32Ptr(1000),
-		NextPageToken:   nil,
-	}
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == shared.EventTypeDecisionTaskCompleted {
-				decisionFinishID = e.GetEventId()
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if decisionFinishID == 0 {
-		return "", 0, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
+	reapplyType, ok := resetReapplyTypesMap[c.String(FlagResetReapplyType)]
+	if !ok {
+		return nil, fmt.Errorf("not supported reset reapply type %v, supported: Signal,None", c.String(FlagResetReapplyType))
 	}
-	return
+	return reapplyType.Ptr(), nil
 }
 
 // CompleteActivity completes an activity
@@ -75,10 +75,29 @@ func Update(cli *cli.Context, db DB) error {
 	return newUpdateSchemaTask(db, cfg).Run()
 }
 
+// ValidateFromConfig compares the live schema of db with the expected one based on the given config
+func ValidateFromConfig(config *ValidateConfig, db InspectableDB, expectedDB InspectableDB) error {
+	if err := validateValidateConfig(config); err != nil {
+		return err
+	}
+	return newValidateSchemaTask(db, expectedDB, config).Run()
+}
+
+// Validate compares the live schema of the given database with the schema the versioned
+// schema files produce for its recorded version, the expected schema is built in expectedDB
+func Validate(cli *cli.Context, db InspectableDB, expectedDB InspectableDB) error {
+	cfg, err := newValidateConfig(cli)
+	if err != nil {
+		return err
+	}
+	return newValidateSchemaTask(db, expectedDB, cfg).Run()
+}
+
 func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
 	config.IsDryRun = cli.Bool(CLIOptDryrun)
+	config.IsPrintOnly = cli.Bool(CLIOptPrintOnly)
 	config.TargetVersion = cli.String(CLIOptTargetVersion)
 
 	if err := validateUpdateConfig(config); err != nil {
@@ -87,6 +106,15 @@ func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	return config, nil
 }
 
+func newValidateConfig(cli *cli.Context) (*ValidateConfig, error) {
+	config := new(ValidateConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+	if err := validateValidateConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func newSetupConfig(cli *cli.Context) (*SetupConfig, error) {
 	config := new(SetupConfig)
 	config.SchemaFilePath = cli.String(CLIOptSchemaFile)
@@ -123,6 +151,9 @@ func validateUpdateConfig(config *UpdateConfig) error {
 	if len(config.SchemaDir) == 0 {
 		return NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
 	}
+	if config.IsDryRun && config.IsPrintOnly {
+		return NewConfigError("only one of " + flag(CLIOptDryrun) + " and " + flag(CLIOptPrintOnly) + " can be specified")
+	}
 	if len(config.TargetVersion) > 0 {
 		ver, err := parseValidateVersion(config.TargetVersion)
 		if err != nil {
@@ -133,6 +164,13 @@ func validateUpdateConfig(config *UpdateConfig) error {
 	return nil
 }
 
+func validateValidateConfig(config *ValidateConfig) error {
+	if len(config.SchemaDir) == 0 {
+		return NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
+	}
+	return nil
+}
+
 func flag(opt string) string {
 	return "(-" + opt + ")"
 }
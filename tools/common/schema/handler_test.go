@@ -99,6 +99,23 @@ func (s *HandlerTestSuite) TestValidateUpdateConfig() {
 	config.TargetVersion = "v1.2"
 	s.assertValidateUpdateSucceeds(config)
 	s.Equal("1.2", config.TargetVersion)
+
+	config.IsPrintOnly = true
+	s.assertValidateUpdateSucceeds(config)
+
+	config.IsDryRun = true
+	s.assertValidateUpdateFails(config)
+}
+
+func (s *HandlerTestSuite) TestValidateValidateConfig() {
+	config := new(ValidateConfig)
+	err := validateValidateConfig(config)
+	s.NotNil(err)
+	_, ok := err.(*ConfigError)
+	s.True(ok)
+
+	config.SchemaDir = "/tmp"
+	s.Nil(validateValidateConfig(config))
 }
 
 func (s *HandlerTestSuite) assertValidateSetupSucceeds(input *SetupConfig) {
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/tools/common/schema"
 	"github.com/urfave/cli"
 )
 
@@ -79,6 +80,20 @@ func (tb *UpdateSchemaTestBase) RunDryrunTest(app *cli.App, db DB, dbNameFlag st
 	db.DropAllTables()
 }
 
+// RunValidateSchemaTest tests that validation passes on an up to date schema and
+// reports a drift, the expected schema is built in expectedDB
+func (tb *UpdateSchemaTestBase) RunValidateSchemaTest(app *cli.App, db schema.InspectableDB, expectedDB schema.InspectableDB, dbNameFlag string, dir string) {
+	app.Run([]string{"./tool", dbNameFlag, tb.DBName, "-q", "setup-schema", "-v", "0.0"})
+	app.Run([]string{"./tool", dbNameFlag, tb.DBName, "-q", "update-schema", "-d", dir})
+
+	config := &schema.ValidateConfig{SchemaDir: dir}
+	tb.NoError(schema.ValidateFromConfig(config, db, expectedDB))
+
+	tb.NoError(db.Exec("CREATE TABLE validate_drift (id int, PRIMARY KEY (id));"))
+	tb.Error(schema.ValidateFromConfig(config, db, expectedDB))
+	db.DropAllTables()
+}
+
 // RunUpdateSchemaTest tests schema update
 func (tb *UpdateSchemaTestBase) RunUpdateSchemaTest(app *cli.App, db DB, dbNameFlag string, sqlFileContent string, expectedTables []string) {
 	tmpDir, err := ioutil.TempDir("", "update_schema_test")
@@ -38,6 +38,12 @@ type (
 		TargetVersion string
 		SchemaDir     string
 		IsDryRun      bool
+		IsPrintOnly   bool
+	}
+	// ValidateConfig holds the config
+	// params for executing a ValidateTask
+	ValidateConfig struct {
+		SchemaDir string
 	}
 	// SetupConfig holds the config
 	// params need by the SetupTask
@@ -66,6 +72,27 @@ type (
 		// Close gracefully closes the client object
 		Close()
 	}
+	// InspectableDB is a DB that can describe its live schema,
+	// it is required by the validate command
+	InspectableDB interface {
+		DB
+		// DescribeSchema returns the tables of the keyspace / database
+		// along with their columns and indexes
+		DescribeSchema() (*Description, error)
+	}
+	// Description is the live layout of a keyspace / database
+	Description struct {
+		Tables map[string]*TableDescription
+		// Types holds the user defined types, only cassandra has them
+		Types map[string]map[string]string
+	}
+	// TableDescription is the live layout of a single table
+	TableDescription struct {
+		// Columns maps the column names to their types
+		Columns map[string]string
+		// Indexes maps the index names to their definitions
+		Indexes map[string]string
+	}
 )
 
 const (
@@ -103,6 +130,8 @@ const (
 	CLIOptReplicationFactor = "replication-factor"
 	// CLIOptQuiet is the cli option for quiet mode
 	CLIOptQuiet = "quiet"
+	// CLIOptPrintOnly is the cli option for printing the updates without executing them
+	CLIOptPrintOnly = "print-only"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint + ", ep"
@@ -138,11 +167,16 @@ const (
 	CLIFlagReplicationFactor = CLIOptReplicationFactor + ", rf"
 	// CLIFlagQuiet is the cli flag for quiet mode
 	CLIFlagQuiet = CLIOptQuiet + ", q"
+	// CLIFlagPrintOnly is the cli flag for printing the updates without executing them
+	CLIFlagPrintOnly = CLIOptPrintOnly + ", po"
 )
 
 // DryrunDBName is the db name used for dryrun
 const DryrunDBName = "_cadence_dryrun_"
 
+// ValidateDBName is the db name used to build the expected schema when validating
+const ValidateDBName = "_cadence_validate_"
+
 var rmspaceRegex = regexp.MustCompile("\\s+")
 
 // NewConfigError creates and returns an instance of ConfigError
@@ -28,8 +28,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"os"
 	"sort"
 	"strings"
 )
@@ -105,6 +107,12 @@ func (task *UpdateTask) Run() error {
 		return err
 	}
 
+	if config.IsPrintOnly {
+		printUpdates(os.Stdout, currVer, updates)
+		log.Printf("UpdateSchemeTask done, nothing was executed\n")
+		return nil
+	}
+
 	err = task.executeUpdates(currVer, updates)
 	if err != nil {
 		return err
@@ -140,6 +148,22 @@ func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) erro
 	return nil
 }
 
+// printUpdates writes the version transitions along with the
+// statements each of them would execute, in execution order
+func printUpdates(w io.Writer, currVer string, updates []changeSet) {
+	if len(updates) == 0 {
+		fmt.Fprintf(w, "-- schema is at version %v, no updates to apply\n", currVer)
+		return
+	}
+	for _, cs := range updates {
+		fmt.Fprintf(w, "-- %v -> %v: %v\n", currVer, cs.version, cs.manifest.Description)
+		for _, stmt := range cs.cqlStmts {
+			fmt.Fprintln(w, rmspaceRegex.ReplaceAllString(stmt, " "))
+		}
+		currVer = cs.version
+	}
+}
+
 func (task *UpdateTask) execCQLStmts(ver string, stmts []string) error {
 	log.Printf("---- Executing updates for version %v ----\n", ver)
 	for _, stmt := range stmts {
@@ -21,6 +21,7 @@
 package schema
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -141,6 +142,32 @@ func (s *UpdateTaskTestSuite) TestReadManifest() {
 	}
 }
 
+func (s *UpdateTaskTestSuite) TestPrintUpdates() {
+	updates := []changeSet{
+		{
+			version:  "0.2",
+			manifest: &manifest{CurrVersion: "0.2", Description: "add tasks"},
+			cqlStmts: []string{"CREATE TABLE tasks (\n  id int,\n  PRIMARY KEY (id)\n);"},
+		},
+		{
+			version:  "0.3",
+			manifest: &manifest{CurrVersion: "0.3", Description: "add state"},
+			cqlStmts: []string{"ALTER TABLE tasks ADD state int;"},
+		},
+	}
+
+	var out bytes.Buffer
+	printUpdates(&out, "0.1", updates)
+	s.Equal("-- 0.1 -> 0.2: add tasks\n"+
+		"CREATE TABLE tasks ( id int, PRIMARY KEY (id) );\n"+
+		"-- 0.2 -> 0.3: add state\n"+
+		"ALTER TABLE tasks ADD state int;\n", out.String())
+
+	out.Reset()
+	printUpdates(&out, "0.3", nil)
+	s.Equal("-- schema is at version 0.3, no updates to apply\n", out.String())
+}
+
 func (s *UpdateTaskTestSuite) runReadManifestTest(dir, input, currVer, minVer, desc string,
 	files []string, isErr bool) {
 
@@ -0,0 +1,150 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+// ValidateTask represents a task that compares the live
+// schema of a keyspace / database with the expected one
+type ValidateTask struct {
+	db         InspectableDB
+	expectedDB InspectableDB
+	config     *ValidateConfig
+}
+
+const baseVersion = "0.0"
+
+func newValidateSchemaTask(db InspectableDB, expectedDB InspectableDB, config *ValidateConfig) *ValidateTask {
+	return &ValidateTask{
+		db:         db,
+		expectedDB: expectedDB,
+		config:     config,
+	}
+}
+
+// Run executes the task. The expected schema is obtained by applying the
+// versioned schema files up to the recorded version on an empty database
+func (task *ValidateTask) Run() error {
+	config := task.config
+	log.Printf("ValidateSchemaTask started, config=%+v\n", config)
+
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	setupConfig := &SetupConfig{
+		Overwrite:      true,
+		InitialVersion: baseVersion,
+	}
+	if err := newSetupSchemaTask(task.expectedDB, setupConfig).Run(); err != nil {
+		return fmt.Errorf("error setting up expected schema:%v", err.Error())
+	}
+	if cmpVersion(currVer, baseVersion) > 0 {
+		updateConfig := &UpdateConfig{
+			SchemaDir:     config.SchemaDir,
+			TargetVersion: currVer,
+		}
+		if err := newUpdateSchemaTask(task.expectedDB, updateConfig).Run(); err != nil {
+			return fmt.Errorf("error building expected schema for version %v:%v", currVer, err.Error())
+		}
+	}
+
+	expected, err := task.expectedDB.DescribeSchema()
+	if err != nil {
+		return fmt.Errorf("error describing expected schema:%v", err.Error())
+	}
+	actual, err := task.db.DescribeSchema()
+	if err != nil {
+		return fmt.Errorf("error describing live schema:%v", err.Error())
+	}
+
+	diffs := diffSchema(expected, actual)
+	if len(diffs) > 0 {
+		for _, diff := range diffs {
+			fmt.Println(diff)
+		}
+		return fmt.Errorf("live schema differs from version %v in %v places", currVer, len(diffs))
+	}
+
+	log.Printf("ValidateSchemaTask done, live schema matches version %v\n", currVer)
+	return nil
+}
+
+// diffSchema returns the differences between the expected and the actual
+// schema, one line per difference, sorted for stable output
+func diffSchema(expected *Description, actual *Description) []string {
+	var diffs []string
+	for name, table := range expected.Tables {
+		actualTable, ok := actual.Tables[name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("missing table %v", name))
+			continue
+		}
+		diffs = append(diffs, diffMap("table "+name+": column", table.Columns, actualTable.Columns)...)
+		diffs = append(diffs, diffMap("table "+name+": index", table.Indexes, actualTable.Indexes)...)
+	}
+	for name := range actual.Tables {
+		if _, ok := expected.Tables[name]; !ok {
+			diffs = append(diffs, fmt.Sprintf("unexpected table %v", name))
+		}
+	}
+
+	for name, fields := range expected.Types {
+		actualFields, ok := actual.Types[name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("missing type %v", name))
+			continue
+		}
+		diffs = append(diffs, diffMap("type "+name+": field", fields, actualFields)...)
+	}
+	for name := range actual.Types {
+		if _, ok := expected.Types[name]; !ok {
+			diffs = append(diffs, fmt.Sprintf("unexpected type %v", name))
+		}
+	}
+
+	sort.Strings(diffs)
+	return diffs
+}
+
+func diffMap(prefix string, expected map[string]string, actual map[string]string) []string {
+	var diffs []string
+	for name, def := range expected {
+		actualDef, ok := actual[name]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%v %v is missing", prefix, name))
+		case actualDef != def:
+			diffs = append(diffs, fmt.Sprintf("%v %v is %v, expected %v", prefix, name, actualDef, def))
+		}
+	}
+	for name, def := range actual {
+		if _, ok := expected[name]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%v %v (%v) is unexpected", prefix, name, def))
+		}
+	}
+	return diffs
+}
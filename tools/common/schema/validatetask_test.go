@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ValidateTaskTestSuite struct {
+	*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+	suite.Suite
+}
+
+func TestValidateTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(ValidateTaskTestSuite))
+}
+
+func (s *ValidateTaskTestSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *ValidateTaskTestSuite) TestDiffSchemaMatch() {
+	s.Empty(diffSchema(newTestDescription(), newTestDescription()))
+}
+
+func (s *ValidateTaskTestSuite) TestDiffSchemaTables() {
+	actual := newTestDescription()
+	delete(actual.Tables, "tasks")
+	actual.Tables["extra"] = &TableDescription{}
+
+	s.Equal([]string{
+		"missing table tasks",
+		"unexpected table extra",
+	}, diffSchema(newTestDescription(), actual))
+}
+
+func (s *ValidateTaskTestSuite) TestDiffSchemaColumnsAndIndexes() {
+	actual := newTestDescription()
+	executions := actual.Tables["executions"]
+	executions.Columns["run_id"] = "text regular"
+	delete(executions.Columns, "state")
+	executions.Columns["extra"] = "int regular"
+	delete(executions.Indexes, "executions_state")
+
+	s.Equal([]string{
+		"table executions: column extra (int regular) is unexpected",
+		"table executions: column run_id is text regular, expected uuid clustering",
+		"table executions: column state is missing",
+		"table executions: index executions_state is missing",
+	}, diffSchema(newTestDescription(), actual))
+}
+
+func (s *ValidateTaskTestSuite) TestDiffSchemaTypes() {
+	actual := newTestDescription()
+	actual.Types["serialized_event_batch"]["encoding_type"] = "int"
+	delete(actual.Types, "domain")
+
+	s.Equal([]string{
+		"missing type domain",
+		"type serialized_event_batch: field encoding_type is int, expected text",
+	}, diffSchema(newTestDescription(), actual))
+}
+
+func newTestDescription() *Description {
+	return &Description{
+		Tables: map[string]*TableDescription{
+			"executions": {
+				Columns: map[string]string{
+					"shard_id": "int partition_key",
+					"run_id":   "uuid clustering",
+					"state":    "int regular",
+				},
+				Indexes: map[string]string{
+					"executions_state": "state",
+				},
+			},
+			"tasks": {
+				Columns: map[string]string{
+					"task_id": "bigint partition_key",
+				},
+				Indexes: map[string]string{},
+			},
+		},
+		Types: map[string]map[string]string{
+			"domain": {
+				"id": "uuid",
+			},
+			"serialized_event_batch": {
+				"encoding_type": "text",
+				"data":          "blob",
+			},
+		},
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+type (
+	// checkpoint records the progress of a migration so that an
+	// interrupted run resumes where it stopped. Every unit of work
+	// is idempotent, the checkpoint only saves redoing finished ones
+	checkpoint struct {
+		sync.Mutex
+		path  string
+		state checkpointState
+	}
+
+	checkpointState struct {
+		DomainsDone         bool                   `json:"domainsDone"`
+		CompletedShards     map[int]bool           `json:"completedShards"`
+		TaskLists           map[string]taskListKey `json:"taskLists"`
+		CompletedTaskLists  map[string]bool        `json:"completedTaskLists"`
+		CompletedVisibility map[string]bool        `json:"completedVisibility"`
+	}
+
+	taskListKey struct {
+		DomainID string `json:"domainID"`
+		Name     string `json:"name"`
+		TaskType int    `json:"taskType"`
+	}
+)
+
+// loadCheckpoint reads the checkpoint at the given path, a missing
+// file means that the migration starts from scratch. An empty path
+// keeps the checkpoint in memory only
+func loadCheckpoint(path string) (*checkpoint, error) {
+	c := &checkpoint{
+		path: path,
+		state: checkpointState{
+			CompletedShards:     make(map[int]bool),
+			TaskLists:           make(map[string]taskListKey),
+			CompletedTaskLists:  make(map[string]bool),
+			CompletedVisibility: make(map[string]bool),
+		},
+	}
+	if len(path) == 0 {
+		return c, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, fmt.Errorf("error reading checkpoint: %v", err)
+	}
+	if err := json.Unmarshal(data, &c.state); err != nil {
+		return nil, fmt.Errorf("error parsing checkpoint: %v", err)
+	}
+	return c, nil
+}
+
+func (c *checkpoint) domainsDone() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.state.DomainsDone
+}
+
+func (c *checkpoint) completeDomains() error {
+	c.Lock()
+	defer c.Unlock()
+	c.state.DomainsDone = true
+	return c.saveLocked()
+}
+
+func (c *checkpoint) shardDone(shardID int) bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.state.CompletedShards[shardID]
+}
+
+// completeShard marks the shard as copied along with the task lists
+// its running workflows reference, both are saved in a single write
+func (c *checkpoint) completeShard(shardID int, taskLists []taskListKey) error {
+	c.Lock()
+	defer c.Unlock()
+	for _, key := range taskLists {
+		c.state.TaskLists[key.String()] = key
+	}
+	c.state.CompletedShards[shardID] = true
+	return c.saveLocked()
+}
+
+// pendingTaskLists returns the discovered task lists that are not copied yet
+func (c *checkpoint) pendingTaskLists() []taskListKey {
+	c.Lock()
+	defer c.Unlock()
+	var result []taskListKey
+	for id, key := range c.state.TaskLists {
+		if !c.state.CompletedTaskLists[id] {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+func (c *checkpoint) taskLists() []taskListKey {
+	c.Lock()
+	defer c.Unlock()
+	result := make([]taskListKey, 0, len(c.state.TaskLists))
+	for _, key := range c.state.TaskLists {
+		result = append(result, key)
+	}
+	return result
+}
+
+func (c *checkpoint) completeTaskList(key taskListKey) error {
+	c.Lock()
+	defer c.Unlock()
+	c.state.CompletedTaskLists[key.String()] = true
+	return c.saveLocked()
+}
+
+func (c *checkpoint) visibilityDone(domainID string) bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.state.CompletedVisibility[domainID]
+}
+
+func (c *checkpoint) completeVisibility(domainID string) error {
+	c.Lock()
+	defer c.Unlock()
+	c.state.CompletedVisibility[domainID] = true
+	return c.saveLocked()
+}
+
+// saveLocked writes the checkpoint to a temporary file first and renames
+// it over the previous one, so a crash never leaves a truncated checkpoint
+func (c *checkpoint) saveLocked() error {
+	if len(c.path) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(&c.state)
+	if err != nil {
+		return fmt.Errorf("error serializing checkpoint: %v", err)
+	}
+	tmp := c.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("error writing checkpoint: %v", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("error writing checkpoint: %v", err)
+	}
+	return nil
+}
+
+func (k taskListKey) String() string {
+	return fmt.Sprintf("%v/%v/%v", k.DomainID, k.TaskType, k.Name)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	checkpointSuite struct {
+		suite.Suite
+		*require.Assertions
+		dir string
+	}
+)
+
+func TestCheckpointSuite(t *testing.T) {
+	suite.Run(t, new(checkpointSuite))
+}
+
+func (s *checkpointSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "checkpoint")
+	s.NoError(err)
+	s.dir = dir
+}
+
+func (s *checkpointSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *checkpointSuite) TestLoadMissingFile() {
+	c, err := loadCheckpoint(filepath.Join(s.dir, "missing"))
+	s.NoError(err)
+	s.False(c.domainsDone())
+	s.False(c.shardDone(0))
+	s.Empty(c.pendingTaskLists())
+}
+
+func (s *checkpointSuite) TestLoadCorruptedFile() {
+	path := filepath.Join(s.dir, "corrupted")
+	s.NoError(ioutil.WriteFile(path, []byte("{"), 0644))
+	_, err := loadCheckpoint(path)
+	s.Error(err)
+}
+
+func (s *checkpointSuite) TestResume() {
+	path := filepath.Join(s.dir, "checkpoint")
+	decision := taskListKey{DomainID: "domain", Name: "tl", TaskType: p.TaskListTypeDecision}
+	activity := taskListKey{DomainID: "domain", Name: "tl", TaskType: p.TaskListTypeActivity}
+
+	c, err := loadCheckpoint(path)
+	s.NoError(err)
+	s.NoError(c.completeDomains())
+	s.NoError(c.completeShard(3, []taskListKey{decision, activity}))
+	s.NoError(c.completeShard(5, []taskListKey{decision}))
+	s.NoError(c.completeTaskList(decision))
+	s.NoError(c.completeVisibility("domain"))
+
+	c, err = loadCheckpoint(path)
+	s.NoError(err)
+	s.True(c.domainsDone())
+	s.True(c.shardDone(3))
+	s.True(c.shardDone(5))
+	s.False(c.shardDone(4))
+	s.ElementsMatch([]taskListKey{decision, activity}, c.taskLists())
+	s.Equal([]taskListKey{activity}, c.pendingTaskLists())
+	s.True(c.visibilityDone("domain"))
+	s.False(c.visibilityDone("other"))
+
+	_, err = os.Stat(path + ".tmp")
+	s.True(os.IsNotExist(err))
+}
+
+func (s *checkpointSuite) TestInMemoryOnly() {
+	c, err := loadCheckpoint("")
+	s.NoError(err)
+	s.NoError(c.completeShard(1, nil))
+	s.True(c.shardDone(1))
+
+	files, err := ioutil.ReadDir(s.dir)
+	s.NoError(err)
+	s.Empty(files)
+}
@@ -0,0 +1,507 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// shardMigration holds the state of copying a single shard
+	shardMigration struct {
+		*migrator
+		shardID    int
+		rangeID    int64
+		sourceExec p.ExecutionManager
+		targetExec p.ExecutionManager
+		tasks      map[p.ConcreteExecutionKey]*pendingTasks
+		taskLists  map[taskListKey]struct{}
+	}
+
+	// pendingTasks are the transfer and timer tasks of an execution
+	// that the source cluster did not process yet
+	pendingTasks struct {
+		transfer []p.Task
+		timer    []p.Task
+	}
+)
+
+// migrateShard copies the shard record and all concrete executions of the
+// shard, the current execution records are rebuilt by the create modes.
+// It returns the task lists referenced by the running executions
+func (m *migrator) migrateShard(shardID int) ([]taskListKey, error) {
+	resp, err := m.source.shardMgr.GetShard(&p.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// the shard was never acquired by the source cluster, nothing to copy
+			return nil, nil
+		}
+		return nil, err
+	}
+	err = m.target.shardMgr.CreateShard(&p.CreateShardRequest{ShardInfo: resp.ShardInfo})
+	if _, ok := err.(*p.ShardAlreadyExistError); !ok && err != nil {
+		return nil, err
+	}
+	// the executions are written under the range of the target shard,
+	// which differs from the source one when the shard existed already
+	targetShard, err := m.target.shardMgr.GetShard(&p.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return nil, err
+	}
+
+	s := &shardMigration{
+		migrator:  m,
+		shardID:   shardID,
+		rangeID:   targetShard.ShardInfo.RangeID,
+		tasks:     make(map[p.ConcreteExecutionKey]*pendingTasks),
+		taskLists: make(map[taskListKey]struct{}),
+	}
+	if s.sourceExec, err = m.source.factory.NewExecutionManager(shardID); err != nil {
+		return nil, err
+	}
+	defer s.sourceExec.Close()
+	if s.targetExec, err = m.target.factory.NewExecutionManager(shardID); err != nil {
+		return nil, err
+	}
+	defer s.targetExec.Close()
+
+	if err := s.loadPendingTasks(); err != nil {
+		return nil, err
+	}
+	if err := s.migrateExecutions(); err != nil {
+		return nil, err
+	}
+
+	result := make([]taskListKey, 0, len(s.taskLists))
+	for key := range s.taskLists {
+		result = append(result, key)
+	}
+	return result, nil
+}
+
+// migrateExecutions copies the current runs before the others, the update of
+// a run that is not current asserts against the current record of its workflow
+func (s *shardMigration) migrateExecutions() error {
+	var nonCurrent []*p.ConcreteExecutionKey
+	var pageToken []byte
+	for {
+		resp, err := s.sourceExec.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  s.pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range resp.Executions {
+			current, err := s.sourceExec.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+				DomainID:   key.DomainID,
+				WorkflowID: key.WorkflowID,
+			})
+			if err != nil {
+				if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+					return err
+				}
+			}
+			if current == nil || current.RunID != key.RunID {
+				nonCurrent = append(nonCurrent, key)
+				continue
+			}
+			if err := s.migrateExecution(key, true); err != nil {
+				return err
+			}
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			break
+		}
+	}
+
+	for _, key := range nonCurrent {
+		if err := s.migrateExecution(key, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateExecution copies the history of an execution followed by its mutable
+// state. Closed executions cannot be created directly, they are created running
+// or as zombies and then moved to their final state by an update
+func (s *shardMigration) migrateExecution(key *p.ConcreteExecutionKey, isCurrent bool) error {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(key.WorkflowID),
+		RunId:      common.StringPtr(key.RunID),
+	}
+	resp, err := s.sourceExec.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID:  key.DomainID,
+		Execution: execution,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// deleted after it was listed
+			return nil
+		}
+		return err
+	}
+	state := resp.State
+	info := state.ExecutionInfo
+	if !isCurrent && (info.State == p.WorkflowStateCreated || info.State == p.WorkflowStateRunning) {
+		return fmt.Errorf("execution %v/%v/%v is running but not current", key.DomainID, key.WorkflowID, key.RunID)
+	}
+
+	if err := s.copyHistory(state); err != nil {
+		return fmt.Errorf("error copying history of %v/%v/%v: %v", key.DomainID, key.WorkflowID, key.RunID, err)
+	}
+	if info.State != p.WorkflowStateCompleted {
+		s.collectTaskLists(state)
+	}
+
+	existing, err := s.targetExec.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID:  key.DomainID,
+		Execution: execution,
+	})
+	switch err.(type) {
+	case nil:
+	case *workflow.EntityNotExistsError:
+		if err := s.createExecution(key, state, isCurrent); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	createdState := p.WorkflowStateRunning
+	if !isCurrent {
+		createdState = p.WorkflowStateZombie
+	}
+	if existing != nil {
+		createdState = existing.State.ExecutionInfo.State
+	}
+	missingBufferedEvents := len(state.BufferedEvents) > 0 &&
+		(existing == nil || len(existing.State.BufferedEvents) == 0)
+	if createdState == info.State && !missingBufferedEvents {
+		return nil
+	}
+
+	mode := p.UpdateWorkflowModeUpdateCurrent
+	if !isCurrent {
+		mode = p.UpdateWorkflowModeBypassCurrent
+	}
+	mutation := p.WorkflowMutation{
+		ExecutionInfo:    info,
+		ExecutionStats:   state.ExecutionStats,
+		ReplicationState: state.ReplicationState,
+		VersionHistories: state.VersionHistories,
+		Condition:        info.NextEventID,
+	}
+	if missingBufferedEvents {
+		mutation.NewBufferedEvents = state.BufferedEvents
+	}
+	_, err = s.targetExec.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+		RangeID:                s.rangeID,
+		Mode:                   mode,
+		UpdateWorkflowMutation: mutation,
+		Encoding:               s.executionEncoding(),
+	})
+	return err
+}
+
+func (s *shardMigration) createExecution(key *p.ConcreteExecutionKey, state *p.WorkflowMutableState, isCurrent bool) error {
+	info := *state.ExecutionInfo
+	mode := p.CreateWorkflowModeBrandNew
+	switch {
+	case !isCurrent:
+		mode = p.CreateWorkflowModeZombie
+		info.State = p.WorkflowStateZombie
+		info.CloseStatus = p.WorkflowCloseStatusNone
+	case info.State == p.WorkflowStateCompleted:
+		info.State = p.WorkflowStateRunning
+		info.CloseStatus = p.WorkflowCloseStatusNone
+	}
+
+	snapshot := p.WorkflowSnapshot{
+		ExecutionInfo:    &info,
+		ExecutionStats:   state.ExecutionStats,
+		ReplicationState: state.ReplicationState,
+		VersionHistories: state.VersionHistories,
+		Condition:        info.NextEventID,
+	}
+	for _, activityInfo := range state.ActivityInfos {
+		snapshot.ActivityInfos = append(snapshot.ActivityInfos, activityInfo)
+	}
+	for _, timerInfo := range state.TimerInfos {
+		snapshot.TimerInfos = append(snapshot.TimerInfos, timerInfo)
+	}
+	for _, childInfo := range state.ChildExecutionInfos {
+		snapshot.ChildExecutionInfos = append(snapshot.ChildExecutionInfos, childInfo)
+	}
+	for _, cancelInfo := range state.RequestCancelInfos {
+		snapshot.RequestCancelInfos = append(snapshot.RequestCancelInfos, cancelInfo)
+	}
+	for _, signalInfo := range state.SignalInfos {
+		snapshot.SignalInfos = append(snapshot.SignalInfos, signalInfo)
+	}
+	for signalID := range state.SignalRequestedIDs {
+		snapshot.SignalRequestedIDs = append(snapshot.SignalRequestedIDs, signalID)
+	}
+	if tasks, ok := s.tasks[*key]; ok {
+		snapshot.TransferTasks = tasks.transfer
+		snapshot.TimerTasks = tasks.timer
+	}
+
+	_, err := s.targetExec.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+		RangeID:             s.rangeID,
+		Mode:                mode,
+		NewWorkflowSnapshot: snapshot,
+		Encoding:            s.executionEncoding(),
+	})
+	return err
+}
+
+// collectTaskLists records the task lists a running execution may have
+// pending tasks on, sticky task lists are left out since they do not
+// outlive the worker that polls them
+func (s *shardMigration) collectTaskLists(state *p.WorkflowMutableState) {
+	domainID := state.ExecutionInfo.DomainID
+	s.taskLists[taskListKey{
+		DomainID: domainID,
+		Name:     state.ExecutionInfo.TaskList,
+		TaskType: p.TaskListTypeDecision,
+	}] = struct{}{}
+	for _, activityInfo := range state.ActivityInfos {
+		activityDomainID := activityInfo.DomainID
+		if len(activityDomainID) == 0 {
+			activityDomainID = domainID
+		}
+		s.taskLists[taskListKey{
+			DomainID: activityDomainID,
+			Name:     activityInfo.TaskList,
+			TaskType: p.TaskListTypeActivity,
+		}] = struct{}{}
+	}
+}
+
+// loadPendingTasks reads the transfer and timer tasks of the shard, they
+// are written to the target along with the execution they belong to
+func (s *shardMigration) loadPendingTasks() error {
+	var pageToken []byte
+	for {
+		resp, err := s.sourceExec.GetTransferTasks(&p.GetTransferTasksRequest{
+			ReadLevel:     0,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     s.pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, info := range resp.Tasks {
+			task, err := transferTaskFromInfo(info)
+			if err != nil {
+				return err
+			}
+			tasks := s.pendingTasks(info.DomainID, info.WorkflowID, info.RunID)
+			tasks.transfer = append(tasks.transfer, task)
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			break
+		}
+	}
+
+	for {
+		resp, err := s.sourceExec.GetTimerIndexTasks(&p.GetTimerIndexTasksRequest{
+			MinTimestamp:  time.Unix(0, 0),
+			MaxTimestamp:  time.Unix(0, math.MaxInt64),
+			BatchSize:     s.pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, info := range resp.Timers {
+			task, err := timerTaskFromInfo(info)
+			if err != nil {
+				return err
+			}
+			tasks := s.pendingTasks(info.DomainID, info.WorkflowID, info.RunID)
+			tasks.timer = append(tasks.timer, task)
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *shardMigration) pendingTasks(domainID string, workflowID string, runID string) *pendingTasks {
+	key := p.ConcreteExecutionKey{DomainID: domainID, WorkflowID: workflowID, RunID: runID}
+	tasks, ok := s.tasks[key]
+	if !ok {
+		tasks = &pendingTasks{}
+		s.tasks[key] = tasks
+	}
+	return tasks
+}
+
+func transferTaskFromInfo(info *p.TransferTaskInfo) (p.Task, error) {
+	switch info.TaskType {
+	case p.TransferTaskTypeDecisionTask:
+		return &p.DecisionTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			DomainID:            info.TargetDomainID,
+			TaskList:            info.TaskList,
+			ScheduleID:          info.ScheduleID,
+			Version:             info.Version,
+			RecordVisibility:    info.RecordVisibility,
+		}, nil
+	case p.TransferTaskTypeActivityTask:
+		return &p.ActivityTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			DomainID:            info.TargetDomainID,
+			TaskList:            info.TaskList,
+			ScheduleID:          info.ScheduleID,
+			Version:             info.Version,
+		}, nil
+	case p.TransferTaskTypeCloseExecution:
+		return &p.CloseExecutionTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			Version:             info.Version,
+		}, nil
+	case p.TransferTaskTypeCancelExecution:
+		return &p.CancelExecutionTask{
+			VisibilityTimestamp:     info.VisibilityTimestamp,
+			TaskID:                  info.TaskID,
+			TargetDomainID:          info.TargetDomainID,
+			TargetWorkflowID:        info.TargetWorkflowID,
+			TargetRunID:             info.TargetRunID,
+			TargetChildWorkflowOnly: info.TargetChildWorkflowOnly,
+			InitiatedID:             info.ScheduleID,
+			Version:                 info.Version,
+		}, nil
+	case p.TransferTaskTypeStartChildExecution:
+		return &p.StartChildExecutionTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			TargetDomainID:      info.TargetDomainID,
+			TargetWorkflowID:    info.TargetWorkflowID,
+			InitiatedID:         info.ScheduleID,
+			Version:             info.Version,
+		}, nil
+	case p.TransferTaskTypeSignalExecution:
+		return &p.SignalExecutionTask{
+			VisibilityTimestamp:     info.VisibilityTimestamp,
+			TaskID:                  info.TaskID,
+			TargetDomainID:          info.TargetDomainID,
+			TargetWorkflowID:        info.TargetWorkflowID,
+			TargetRunID:             info.TargetRunID,
+			TargetChildWorkflowOnly: info.TargetChildWorkflowOnly,
+			InitiatedID:             info.ScheduleID,
+			Version:                 info.Version,
+		}, nil
+	case p.TransferTaskTypeRecordWorkflowStarted:
+		return &p.RecordWorkflowStartedTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			Version:             info.Version,
+		}, nil
+	case p.TransferTaskTypeResetWorkflow:
+		return &p.ResetWorkflowTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			Version:             info.Version,
+		}, nil
+	case p.TransferTaskTypeUpsertWorkflowSearchAttributes:
+		return &p.UpsertWorkflowSearchAttributesTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			Version:             info.Version,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown transfer task type: %v", info.TaskType)
+	}
+}
+
+func timerTaskFromInfo(info *p.TimerTaskInfo) (p.Task, error) {
+	switch info.TaskType {
+	case p.TaskTypeDecisionTimeout:
+		return &p.DecisionTimeoutTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			EventID:             info.EventID,
+			ScheduleAttempt:     info.ScheduleAttempt,
+			TimeoutType:         info.TimeoutType,
+			Version:             info.Version,
+		}, nil
+	case p.TaskTypeActivityTimeout:
+		return &p.ActivityTimeoutTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			TimeoutType:         info.TimeoutType,
+			EventID:             info.EventID,
+			Attempt:             info.ScheduleAttempt,
+			Version:             info.Version,
+		}, nil
+	case p.TaskTypeUserTimer:
+		return &p.UserTimerTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			EventID:             info.EventID,
+			Version:             info.Version,
+		}, nil
+	case p.TaskTypeWorkflowTimeout:
+		return &p.WorkflowTimeoutTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			Version:             info.Version,
+		}, nil
+	case p.TaskTypeDeleteHistoryEvent:
+		return &p.DeleteHistoryEventTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			Version:             info.Version,
+		}, nil
+	case p.TaskTypeActivityRetryTimer:
+		return &p.ActivityRetryTimerTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			EventID:             info.EventID,
+			Version:             info.Version,
+			Attempt:             int32(info.ScheduleAttempt),
+		}, nil
+	case p.TaskTypeWorkflowBackoffTimer:
+		return &p.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: info.VisibilityTimestamp,
+			TaskID:              info.TaskID,
+			EventID:             info.EventID,
+			Version:             info.Version,
+			TimeoutType:         info.TimeoutType,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown timer task type: %v", info.TaskType)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// copyHistory copies every history branch of an execution, a run with
+// version histories may own several branches
+func (s *shardMigration) copyHistory(state *p.WorkflowMutableState) error {
+	info := state.ExecutionInfo
+	cleanupInfo := p.BuildHistoryGarbageCleanupInfo(info.DomainID, info.WorkflowID, info.RunID)
+
+	var branchTokens [][]byte
+	if state.VersionHistories != nil {
+		for _, history := range state.VersionHistories.ToThrift().Histories {
+			branchTokens = append(branchTokens, history.BranchToken)
+		}
+	} else {
+		branchTokens = append(branchTokens, info.BranchToken)
+	}
+
+	for _, branchToken := range branchTokens {
+		if len(branchToken) == 0 {
+			continue
+		}
+		if err := s.copyBranch(branchToken, cleanupInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyBranch copies the nodes of a branch range by range. The ranges inherited
+// from ancestors are written under the ancestor branch IDs, so that branches
+// forked from the same ancestor keep sharing its nodes in the target
+func (s *shardMigration) copyBranch(branchToken []byte, cleanupInfo string) error {
+	encoder := codec.NewThriftRWEncoder()
+	var branch workflow.HistoryBranch
+	if err := encoder.Decode(branchToken, &branch); err != nil {
+		return err
+	}
+
+	beginNodeID := common.FirstEventID
+	for i, ancestor := range branch.Ancestors {
+		ancestorToken, err := encoder.Encode(&workflow.HistoryBranch{
+			TreeID:    branch.TreeID,
+			BranchID:  ancestor.BranchID,
+			Ancestors: branch.Ancestors[:i],
+		})
+		if err != nil {
+			return err
+		}
+		endNodeID := ancestor.GetEndNodeID()
+		if err := s.copyBranchRange(ancestorToken, beginNodeID, endNodeID, false, cleanupInfo); err != nil {
+			return err
+		}
+		beginNodeID = endNodeID
+	}
+	return s.copyBranchRange(branchToken, beginNodeID, common.EndEventID, true, cleanupInfo)
+}
+
+// copyBranchRange appends the batches of [beginNodeID, endNodeID) that the
+// target does not have yet. The first append of a branch's own range also
+// writes the tree record of the branch
+func (s *shardMigration) copyBranchRange(
+	branchToken []byte,
+	beginNodeID int64,
+	endNodeID int64,
+	ownBranch bool,
+	cleanupInfo string,
+) error {
+	if beginNodeID >= endNodeID {
+		return nil
+	}
+	nextNodeID, err := s.nextBranchNodeID(s.target, branchToken, beginNodeID, endNodeID)
+	if err != nil {
+		return err
+	}
+
+	var pageToken []byte
+	for {
+		// batches are read raw so that they can be written with the encoding they are stored with
+		resp, err := s.source.historyMgr.ReadRawHistoryBranch(&p.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    beginNodeID,
+			MaxEventID:    endNodeID,
+			PageSize:      s.pageSize,
+			NextPageToken: pageToken,
+			ShardID:       common.IntPtr(s.shardID),
+		})
+		if err != nil {
+			if _, ok := err.(*workflow.EntityNotExistsError); ok {
+				// a branch forked without any event appended to it
+				return nil
+			}
+			return err
+		}
+		for _, blob := range resp.HistoryEventBlobs {
+			events, err := s.serializer.DeserializeBatchEvents(blob)
+			if err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				continue
+			}
+			firstEventID := events[0].GetEventId()
+			if firstEventID < nextNodeID {
+				continue
+			}
+			if _, err := s.target.historyMgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+				IsNewBranch:   ownBranch && firstEventID == beginNodeID,
+				Info:          cleanupInfo,
+				BranchToken:   branchToken,
+				Events:        events,
+				TransactionID: firstEventID,
+				Encoding:      s.historyEncoding(blob.Encoding),
+				ShardID:       common.IntPtr(s.shardID),
+			}); err != nil {
+				return err
+			}
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// nextBranchNodeID returns the ID following the last event that the store has
+// in the given range of the branch
+func (s *shardMigration) nextBranchNodeID(
+	st *store,
+	branchToken []byte,
+	beginNodeID int64,
+	endNodeID int64,
+) (int64, error) {
+	nextNodeID := beginNodeID
+	var pageToken []byte
+	for {
+		resp, err := st.historyMgr.ReadHistoryBranchByBatch(&p.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    beginNodeID,
+			MaxEventID:    endNodeID,
+			PageSize:      s.pageSize,
+			NextPageToken: pageToken,
+			ShardID:       common.IntPtr(s.shardID),
+		})
+		if err != nil {
+			if _, ok := err.(*workflow.EntityNotExistsError); ok {
+				return nextNodeID, nil
+			}
+			return 0, err
+		}
+		for _, batch := range resp.History {
+			events := batch.Events
+			nextNodeID = events[len(events)-1].GetEventId() + 1
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			return nextNodeID, nil
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"log"
+	"os"
+
+	"github.com/urfave/cli"
+)
+
+const (
+	cliFlagSource      = "source"
+	cliFlagTarget      = "target"
+	cliFlagCheckpoint  = "checkpoint"
+	cliFlagParallelism = "parallelism"
+	cliFlagPageSize    = "page-size"
+	cliFlagEncoding    = "encoding"
+	cliFlagSkipVerify  = "skip-verify"
+)
+
+// RunTool runs the cadence-migration-tool command line tool
+func RunTool(args []string) error {
+	app := buildCLIOptions()
+	return app.Run(args)
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(c *cli.Context) error) {
+	if err := handler(c); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+
+func buildCLIOptions() *cli.App {
+
+	app := cli.NewApp()
+	app.Name = "cadence-migration-tool"
+	app.Usage = "Command line tool to copy cadence data between persistence backends"
+	app.Version = "0.0.1"
+
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  cliFlagSource,
+			Usage: "path to the cadence config file describing the persistence to copy from",
+		},
+		cli.StringFlag{
+			Name:  cliFlagTarget,
+			Usage: "path to the cadence config file describing the persistence to copy to",
+		},
+		cli.IntFlag{
+			Name:  cliFlagPageSize,
+			Value: 100,
+			Usage: "number of records read from the source per request",
+		},
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:    "run",
+			Aliases: []string{"r"},
+			Usage:   "copy all data from the source to the target and verify the result",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  cliFlagCheckpoint,
+					Value: "cadence-migration.checkpoint",
+					Usage: "path to the checkpoint file, an interrupted migration resumes from it",
+				},
+				cli.IntFlag{
+					Name:  cliFlagParallelism,
+					Value: 8,
+					Usage: "number of shards copied in parallel",
+				},
+				cli.StringFlag{
+					Name: cliFlagEncoding,
+					Usage: "encoding of the history and mutable state blobs written to the target, by default history " +
+						"keeps the encoding of the source and mutable state is written with thriftrw",
+				},
+				cli.BoolFlag{
+					Name:  cliFlagSkipVerify,
+					Usage: "skip the verification pass once the copy is done",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, runMigration)
+			},
+		},
+		{
+			Name:    "verify",
+			Aliases: []string{"v"},
+			Usage:   "compare the counts and checksums of the source and the target",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  cliFlagCheckpoint,
+					Value: "cadence-migration.checkpoint",
+					Usage: "path to the checkpoint file of the migration, the task lists it recorded are compared",
+				},
+				cli.IntFlag{
+					Name:  cliFlagParallelism,
+					Value: 8,
+					Usage: "number of shards verified in parallel",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, runVerification)
+			},
+		},
+	}
+
+	return app
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"fmt"
+	"sync"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/urfave/cli"
+)
+
+type (
+	// migrator copies domains, shards, executions along with their history,
+	// task lists and visibility records from one persistence to another
+	migrator struct {
+		source      *store
+		target      *store
+		checkpoint  *checkpoint
+		parallelism int
+		pageSize    int
+		// encoding overrides the encoding of the blobs written to the target
+		encoding   common.EncodingType
+		serializer p.PayloadSerializer
+		logger     log.Logger
+	}
+)
+
+// runMigration copies all data from the source to the target
+func runMigration(c *cli.Context) error {
+	logger, err := loggerimpl.NewDevelopment()
+	if err != nil {
+		return err
+	}
+	m, err := newMigratorFromCLI(c, logger)
+	if err != nil {
+		return err
+	}
+	defer m.close()
+
+	if m.checkpoint, err = loadCheckpoint(c.String(cliFlagCheckpoint)); err != nil {
+		return err
+	}
+	if err := m.migrate(); err != nil {
+		return err
+	}
+	if c.Bool(cliFlagSkipVerify) {
+		return nil
+	}
+	return m.verify()
+}
+
+// runVerification compares the source and the target without copying anything
+func runVerification(c *cli.Context) error {
+	logger, err := loggerimpl.NewDevelopment()
+	if err != nil {
+		return err
+	}
+	m, err := newMigratorFromCLI(c, logger)
+	if err != nil {
+		return err
+	}
+	defer m.close()
+
+	// the task lists to compare are the ones discovered by the migration
+	if m.checkpoint, err = loadCheckpoint(c.String(cliFlagCheckpoint)); err != nil {
+		return err
+	}
+	return m.verify()
+}
+
+func newMigratorFromCLI(c *cli.Context, logger log.Logger) (*migrator, error) {
+	source, err := loadStore("source", c.GlobalString(cliFlagSource), logger)
+	if err != nil {
+		return nil, err
+	}
+	target, err := loadStore("target", c.GlobalString(cliFlagTarget), logger)
+	if err != nil {
+		source.close()
+		return nil, err
+	}
+	m, err := newMigrator(source, target, c.Int(cliFlagParallelism), c.GlobalInt(cliFlagPageSize), logger)
+	if err != nil {
+		source.close()
+		target.close()
+		return nil, err
+	}
+	if encoding := c.String(cliFlagEncoding); len(encoding) > 0 {
+		m.encoding = common.EncodingType(encoding)
+	}
+	return m, nil
+}
+
+func newMigrator(source *store, target *store, parallelism int, pageSize int, logger log.Logger) (*migrator, error) {
+	// executions are placed on shards by hashing the workflow ID,
+	// so both clusters need the same number of shards
+	if source.numShards != target.numShards {
+		return nil, fmt.Errorf(
+			"number of history shards mismatch, source: %v, target: %v", source.numShards, target.numShards)
+	}
+	if parallelism <= 0 || pageSize <= 0 {
+		return nil, fmt.Errorf("parallelism and page size must be positive")
+	}
+	return &migrator{
+		source:      source,
+		target:      target,
+		parallelism: parallelism,
+		pageSize:    pageSize,
+		serializer:  p.NewPayloadSerializer(),
+		logger:      logger,
+	}, nil
+}
+
+// historyEncoding returns the encoding of a history batch written to the target,
+// batches keep the encoding they are stored with in the source unless overridden
+func (m *migrator) historyEncoding(sourceEncoding common.EncodingType) common.EncodingType {
+	if m.encoding != common.EncodingTypeEmpty {
+		return m.encoding
+	}
+	return sourceEncoding
+}
+
+// executionEncoding returns the encoding of the mutable state blobs written to the target
+func (m *migrator) executionEncoding() common.EncodingType {
+	if m.encoding != common.EncodingTypeEmpty {
+		return m.encoding
+	}
+	return common.EncodingTypeThriftRW
+}
+
+func (m *migrator) close() {
+	m.source.close()
+	m.target.close()
+}
+
+// migrate copies everything that is not yet marked as done in the checkpoint.
+// Task lists are copied after the shards since they are discovered from the
+// running workflows, and visibility records go last so that a workflow never
+// becomes visible on the target before its execution is there
+func (m *migrator) migrate() error {
+	if err := m.migrateDomains(); err != nil {
+		return err
+	}
+	if err := m.migrateShards(); err != nil {
+		return err
+	}
+	if err := m.migrateTaskLists(); err != nil {
+		return err
+	}
+	return m.migrateVisibility()
+}
+
+func (m *migrator) migrateDomains() error {
+	if m.checkpoint.domainsDone() {
+		return nil
+	}
+
+	domains, err := m.listDomains(m.source)
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains {
+		_, err := m.target.metadataMgr.CreateDomain(&p.CreateDomainRequest{
+			Info:              domain.Info,
+			Config:            domain.Config,
+			ReplicationConfig: domain.ReplicationConfig,
+			IsGlobalDomain:    domain.IsGlobalDomain,
+			ConfigVersion:     domain.ConfigVersion,
+			FailoverVersion:   domain.FailoverVersion,
+		})
+		if _, ok := err.(*workflow.DomainAlreadyExistsError); ok {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error copying domain %v: %v", domain.Info.Name, err)
+		}
+	}
+	m.logger.Info("copied domains", tag.Counter(len(domains)))
+	return m.checkpoint.completeDomains()
+}
+
+func (m *migrator) listDomains(s *store) ([]*p.GetDomainResponse, error) {
+	var result []*p.GetDomainResponse
+	var pageToken []byte
+	for {
+		resp, err := s.metadataMgr.ListDomains(&p.ListDomainsRequest{
+			PageSize:      m.pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing %v domains: %v", s.name, err)
+		}
+		result = append(result, resp.Domains...)
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// migrateShards copies the shards in parallel. A failed shard does not stop
+// the others, it is left out of the checkpoint so that the next run retries it
+func (m *migrator) migrateShards() error {
+	var failed int
+	m.forEachShard(func(shardID int) error {
+		if m.checkpoint.shardDone(shardID) {
+			return nil
+		}
+		taskLists, err := m.migrateShard(shardID)
+		if err != nil {
+			m.logger.Error("error copying shard", tag.ShardID(shardID), tag.Error(err))
+			return err
+		}
+		m.logger.Info("copied shard", tag.ShardID(shardID))
+		return m.checkpoint.completeShard(shardID, taskLists)
+	}, &failed)
+
+	if failed > 0 {
+		return fmt.Errorf("copying %v shards failed, rerun the migration to resume", failed)
+	}
+	return nil
+}
+
+// forEachShard runs the given function for every shard with the configured
+// parallelism and counts the shards it failed for
+func (m *migrator) forEachShard(fn func(shardID int) error, failed *int) {
+	shardIDs := make(chan int)
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(m.parallelism)
+	for i := 0; i < m.parallelism; i++ {
+		go func() {
+			defer wg.Done()
+			for shardID := range shardIDs {
+				if err := fn(shardID); err != nil {
+					lock.Lock()
+					*failed++
+					lock.Unlock()
+				}
+			}
+		}()
+	}
+	for shardID := 0; shardID < m.source.numShards; shardID++ {
+		shardIDs <- shardID
+	}
+	close(shardIDs)
+	wg.Wait()
+}
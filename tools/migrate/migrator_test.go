@@ -0,0 +1,383 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/memory"
+)
+
+type (
+	migratorSuite struct {
+		suite.Suite
+		*require.Assertions
+		logger        log.Logger
+		sourceCluster *memory.TestCluster
+		targetCluster *memory.TestCluster
+		source        *store
+		target        *store
+		domainID      string
+		nextTaskID    int64
+	}
+)
+
+const (
+	testClusterName = "active"
+	testTaskList    = "migrate-tasklist"
+)
+
+func TestMigratorSuite(t *testing.T) {
+	suite.Run(t, new(migratorSuite))
+}
+
+func (s *migratorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	logger, err := loggerimpl.NewDevelopment()
+	s.NoError(err)
+	s.logger = logger
+
+	s.sourceCluster = memory.NewTestCluster("migrate-source-" + uuid.New())
+	s.targetCluster = memory.NewTestCluster("migrate-target-" + uuid.New())
+	s.source = s.newStore("source", s.sourceCluster)
+	s.target = s.newStore("target", s.targetCluster)
+	s.domainID = uuid.New()
+}
+
+func (s *migratorSuite) TearDownTest() {
+	s.source.close()
+	s.target.close()
+	s.sourceCluster.DropDatabase()
+	s.targetCluster.DropDatabase()
+}
+
+func (s *migratorSuite) TestShardCountMismatch() {
+	cfg := s.targetCluster.Config()
+	cfg.NumHistoryShards = 4
+	target, err := newStore("target", &cfg, testClusterName, s.logger)
+	s.NoError(err)
+	defer target.close()
+
+	_, err = newMigrator(s.source, target, 1, 10, s.logger)
+	s.Error(err)
+}
+
+func (s *migratorSuite) TestMigrate() {
+	s.createDomain()
+	s.NoError(s.source.shardMgr.CreateShard(&p.CreateShardRequest{
+		ShardInfo: &p.ShardInfo{ShardID: 0, RangeID: 1, Owner: "source"},
+	}))
+
+	closed := s.createExecution("closed-workflow")
+	s.completeExecution(closed)
+	running := s.createExecution("running-workflow")
+	s.createTask(running)
+
+	m := s.newMigrator()
+	s.NoError(m.migrate())
+	s.NoError(m.verify())
+
+	executionMgr, err := s.target.factory.NewExecutionManager(0)
+	s.NoError(err)
+	defer executionMgr.Close()
+	resp, err := executionMgr.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID:  s.domainID,
+		Execution: closed,
+	})
+	s.NoError(err)
+	s.Equal(p.WorkflowStateCompleted, resp.State.ExecutionInfo.State)
+	s.Equal(p.WorkflowCloseStatusCompleted, resp.State.ExecutionInfo.CloseStatus)
+
+	current, err := executionMgr.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+		DomainID:   s.domainID,
+		WorkflowID: running.GetWorkflowId(),
+	})
+	s.NoError(err)
+	s.Equal(running.GetRunId(), current.RunID)
+
+	transferTasks, err := executionMgr.GetTransferTasks(&p.GetTransferTasksRequest{
+		MaxReadLevel: 1 << 62,
+		BatchSize:    10,
+	})
+	s.NoError(err)
+	s.Len(transferTasks.Tasks, 2)
+}
+
+func (s *migratorSuite) TestMigrateIsIdempotent() {
+	s.createDomain()
+	s.NoError(s.source.shardMgr.CreateShard(&p.CreateShardRequest{
+		ShardInfo: &p.ShardInfo{ShardID: 0, RangeID: 1, Owner: "source"},
+	}))
+	closed := s.createExecution("closed-workflow")
+	s.completeExecution(closed)
+	s.createExecution("running-workflow")
+
+	s.NoError(s.newMigrator().migrate())
+	// a run without checkpoint goes over everything again
+	m := s.newMigrator()
+	s.NoError(m.migrate())
+	s.NoError(m.verify())
+}
+
+func (s *migratorSuite) TestMigrateKeepsHistoryEncoding() {
+	s.createDomain()
+	s.NoError(s.source.shardMgr.CreateShard(&p.CreateShardRequest{
+		ShardInfo: &p.ShardInfo{ShardID: 0, RangeID: 1, Owner: "source"},
+	}))
+	execution := s.createExecution("running-workflow")
+	s.NoError(s.newMigrator().migrate())
+
+	executionMgr, err := s.source.factory.NewExecutionManager(0)
+	s.NoError(err)
+	defer executionMgr.Close()
+	resp, err := executionMgr.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID:  s.domainID,
+		Execution: execution,
+	})
+	s.NoError(err)
+
+	readRequest := &p.ReadHistoryBranchRequest{
+		BranchToken: resp.State.ExecutionInfo.BranchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    10,
+		ShardID:     common.IntPtr(0),
+	}
+	sourceHistory, err := s.source.historyMgr.ReadRawHistoryBranch(readRequest)
+	s.NoError(err)
+	targetHistory, err := s.target.historyMgr.ReadRawHistoryBranch(readRequest)
+	s.NoError(err)
+	s.Len(targetHistory.HistoryEventBlobs, 1)
+	s.Equal(sourceHistory.HistoryEventBlobs[0].Encoding, targetHistory.HistoryEventBlobs[0].Encoding)
+}
+
+func (s *migratorSuite) TestVerifyDetectsMissingExecution() {
+	s.createDomain()
+	s.NoError(s.source.shardMgr.CreateShard(&p.CreateShardRequest{
+		ShardInfo: &p.ShardInfo{ShardID: 0, RangeID: 1, Owner: "source"},
+	}))
+	m := s.newMigrator()
+	s.NoError(m.migrate())
+
+	s.createExecution("late-workflow")
+	s.Error(m.verify())
+}
+
+func (s *migratorSuite) getNextTaskID() int64 {
+	s.nextTaskID++
+	return s.nextTaskID
+}
+
+func (s *migratorSuite) newStore(name string, cluster *memory.TestCluster) *store {
+	cfg := cluster.Config()
+	cfg.NumHistoryShards = 1
+	st, err := newStore(name, &cfg, testClusterName, s.logger)
+	s.NoError(err)
+	return st
+}
+
+func (s *migratorSuite) newMigrator() *migrator {
+	m, err := newMigrator(s.source, s.target, 2, 10, s.logger)
+	s.NoError(err)
+	m.checkpoint, err = loadCheckpoint("")
+	s.NoError(err)
+	return m
+}
+
+func (s *migratorSuite) createDomain() {
+	_, err := s.source.metadataMgr.CreateDomain(&p.CreateDomainRequest{
+		Info: &p.DomainInfo{
+			ID:     s.domainID,
+			Name:   "migrate-domain",
+			Status: p.DomainStatusRegistered,
+		},
+		Config: &p.DomainConfig{
+			Retention:  1,
+			EmitMetric: true,
+		},
+		ReplicationConfig: &p.DomainReplicationConfig{
+			ActiveClusterName: testClusterName,
+			Clusters: []*p.ClusterReplicationConfig{
+				{ClusterName: testClusterName},
+			},
+		},
+	})
+	s.NoError(err)
+}
+
+// createExecution starts a workflow on the source with a two events history,
+// an open visibility record and a pending decision
+func (s *migratorSuite) createExecution(workflowID string) workflow.WorkflowExecution {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	branchToken, err := p.NewHistoryBranchToken(execution.GetRunId())
+	s.NoError(err)
+	now := time.Now().UnixNano()
+	_, err = s.source.historyMgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+		IsNewBranch: true,
+		Info:        p.BuildHistoryGarbageCleanupInfo(s.domainID, workflowID, execution.GetRunId()),
+		BranchToken: branchToken,
+		Events: []*workflow.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(1),
+				Version:   common.Int64Ptr(common.EmptyVersion),
+				Timestamp: common.Int64Ptr(now),
+				EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr(),
+			},
+			{
+				EventId:   common.Int64Ptr(2),
+				Version:   common.Int64Ptr(common.EmptyVersion),
+				Timestamp: common.Int64Ptr(now),
+				EventType: workflow.EventTypeDecisionTaskScheduled.Ptr(),
+			},
+		},
+		TransactionID: 1,
+		ShardID:       common.IntPtr(0),
+	})
+	s.NoError(err)
+
+	executionMgr, err := s.source.factory.NewExecutionManager(0)
+	s.NoError(err)
+	defer executionMgr.Close()
+	_, err = executionMgr.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+		RangeID: 1,
+		Mode:    p.CreateWorkflowModeBrandNew,
+		NewWorkflowSnapshot: p.WorkflowSnapshot{
+			ExecutionInfo: &p.WorkflowExecutionInfo{
+				CreateRequestID:      uuid.New(),
+				DomainID:             s.domainID,
+				WorkflowID:           workflowID,
+				RunID:                execution.GetRunId(),
+				TaskList:             testTaskList,
+				WorkflowTypeName:     "migrate-type",
+				WorkflowTimeout:      60,
+				DecisionTimeoutValue: 10,
+				State:                p.WorkflowStateRunning,
+				CloseStatus:          p.WorkflowCloseStatusNone,
+				LastFirstEventID:     common.FirstEventID,
+				NextEventID:          3,
+				LastProcessedEvent:   common.EmptyEventID,
+				DecisionScheduleID:   2,
+				DecisionStartedID:    common.EmptyEventID,
+				DecisionTimeout:      10,
+				BranchToken:          branchToken,
+			},
+			ExecutionStats: &p.ExecutionStats{},
+			TransferTasks: []p.Task{
+				&p.DecisionTask{
+					TaskID:     s.getNextTaskID(),
+					DomainID:   s.domainID,
+					TaskList:   testTaskList,
+					ScheduleID: 2,
+				},
+			},
+			Condition: 3,
+		},
+	})
+	s.NoError(err)
+
+	s.NoError(s.source.visibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       s.domainID,
+		Execution:        execution,
+		WorkflowTypeName: "migrate-type",
+		StartTimestamp:   now,
+		WorkflowTimeout:  60,
+	}))
+	return execution
+}
+
+func (s *migratorSuite) completeExecution(execution workflow.WorkflowExecution) {
+	executionMgr, err := s.source.factory.NewExecutionManager(0)
+	s.NoError(err)
+	defer executionMgr.Close()
+	resp, err := executionMgr.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID:  s.domainID,
+		Execution: execution,
+	})
+	s.NoError(err)
+
+	info := resp.State.ExecutionInfo
+	condition := info.NextEventID
+	info.State = p.WorkflowStateCompleted
+	info.CloseStatus = p.WorkflowCloseStatusCompleted
+	_, err = executionMgr.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+		RangeID: 1,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+		UpdateWorkflowMutation: p.WorkflowMutation{
+			ExecutionInfo:  info,
+			ExecutionStats: resp.State.ExecutionStats,
+			Condition:      condition,
+		},
+	})
+	s.NoError(err)
+
+	s.NoError(s.source.visibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       s.domainID,
+		Execution:        execution,
+		WorkflowTypeName: "migrate-type",
+		StartTimestamp:   info.StartTimestamp.UnixNano(),
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           workflow.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    2,
+		RetentionSeconds: 24 * 60 * 60,
+	}))
+}
+
+// createTask adds a pending decision task of the execution to the source task list
+func (s *migratorSuite) createTask(execution workflow.WorkflowExecution) {
+	lease, err := s.source.taskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID:     s.domainID,
+		TaskList:     testTaskList,
+		TaskType:     p.TaskListTypeDecision,
+		TaskListKind: p.TaskListKindNormal,
+	})
+	s.NoError(err)
+	taskID := (lease.TaskListInfo.RangeID-1)*matchingRangeSize + 1
+	_, err = s.source.taskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: lease.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				Execution: execution,
+				Data: &p.TaskInfo{
+					DomainID:               s.domainID,
+					WorkflowID:             execution.GetWorkflowId(),
+					RunID:                  execution.GetRunId(),
+					TaskID:                 taskID,
+					ScheduleID:             2,
+					ScheduleToStartTimeout: 60,
+				},
+				TaskID: taskID,
+			},
+		},
+	})
+	s.NoError(err)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// store holds the managers of one side of the migration
+	store struct {
+		name          string
+		numShards     int
+		factory       pfactory.Factory
+		shardMgr      p.ShardManager
+		metadataMgr   p.MetadataManager
+		historyMgr    p.HistoryV2Manager
+		taskMgr       p.TaskManager
+		visibilityMgr p.VisibilityManager
+	}
+)
+
+// loadStore builds the managers for the persistence section of the
+// cadence config file at the given path
+func loadStore(name string, path string, logger log.Logger) (*store, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("missing config file for the %v", name)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the %v config file: %v", name, err)
+	}
+	var cfg config.Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing the %v config file: %v", name, err)
+	}
+	if err := cfg.Persistence.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %v config: %v", name, err)
+	}
+	clusterName := ""
+	if cfg.ClusterMetadata != nil {
+		clusterName = cfg.ClusterMetadata.CurrentClusterName
+	}
+	return newStore(name, &cfg.Persistence, clusterName, logger)
+}
+
+func newStore(name string, cfg *config.Persistence, clusterName string, logger log.Logger) (*store, error) {
+	if cfg.TransactionSizeLimit == nil {
+		cfg.TransactionSizeLimit = dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit)
+	}
+	factory, err := pfactory.New(cfg, clusterName, nil, logger)
+	if err != nil {
+		return nil, err
+	}
+	s := &store{
+		name:      name,
+		numShards: cfg.NumHistoryShards,
+		factory:   factory,
+	}
+
+	if s.shardMgr, err = s.factory.NewShardManager(); err != nil {
+		return nil, err
+	}
+	if s.metadataMgr, err = s.factory.NewMetadataManager(); err != nil {
+		return nil, err
+	}
+	if s.historyMgr, err = s.factory.NewHistoryV2Manager(); err != nil {
+		return nil, err
+	}
+	if s.taskMgr, err = s.factory.NewTaskManager(); err != nil {
+		return nil, err
+	}
+	if s.visibilityMgr, err = s.factory.NewVisibilityManager(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *store) close() {
+	s.shardMgr.Close()
+	s.metadataMgr.Close()
+	s.historyMgr.Close()
+	s.taskMgr.Close()
+	s.visibilityMgr.Close()
+	s.factory.Close()
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"fmt"
+	"math"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// matchingRangeSize mirrors the task ID block size matching uses for
+// every lease of a task list, see service/matching/config.go
+const matchingRangeSize = 100000
+
+// migrateTaskLists copies the pending tasks of the task lists discovered while
+// copying the shards. Tasks get new IDs from a lease of the target task list,
+// a task list copy interrupted half way may therefore deliver some tasks twice,
+// which matching already tolerates since history rejects stale dispatches
+func (m *migrator) migrateTaskLists() error {
+	for _, key := range m.checkpoint.pendingTaskLists() {
+		count, err := m.migrateTaskList(key)
+		if err != nil {
+			return fmt.Errorf("error copying task list %v: %v", key, err)
+		}
+		m.logger.Info("copied task list",
+			tag.WorkflowDomainID(key.DomainID),
+			tag.WorkflowTaskListName(key.Name),
+			tag.Counter(count))
+		if err := m.checkpoint.completeTaskList(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *migrator) migrateTaskList(key taskListKey) (int, error) {
+	var taskList *p.TaskListInfo
+	var nextTaskID, maxTaskID int64
+	count := 0
+	readLevel := int64(0)
+	for {
+		resp, err := m.source.taskMgr.GetTasks(&p.GetTasksRequest{
+			DomainID:     key.DomainID,
+			TaskList:     key.Name,
+			TaskType:     key.TaskType,
+			ReadLevel:    readLevel,
+			MaxReadLevel: common.Int64Ptr(math.MaxInt64),
+			BatchSize:    m.pageSize,
+		})
+		if err != nil {
+			return count, err
+		}
+		if len(resp.Tasks) == 0 {
+			return count, nil
+		}
+
+		tasks := make([]*p.CreateTaskInfo, 0, len(resp.Tasks))
+		for _, task := range resp.Tasks {
+			if nextTaskID > maxTaskID || taskList == nil {
+				// the leased block of task IDs is used up, or nothing is leased yet
+				lease, err := m.target.taskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+					DomainID:     key.DomainID,
+					TaskList:     key.Name,
+					TaskType:     key.TaskType,
+					TaskListKind: p.TaskListKindNormal,
+				})
+				if err != nil {
+					return count, err
+				}
+				if len(tasks) > 0 {
+					// tasks are written under the lease their IDs were assigned from
+					if err := m.createTasks(taskList, tasks); err != nil {
+						return count, err
+					}
+					tasks = tasks[:0]
+				}
+				taskList = lease.TaskListInfo
+				nextTaskID = (taskList.RangeID-1)*matchingRangeSize + 1
+				maxTaskID = taskList.RangeID * matchingRangeSize
+			}
+			data := *task
+			data.TaskID = nextTaskID
+			tasks = append(tasks, &p.CreateTaskInfo{
+				Execution: workflow.WorkflowExecution{
+					WorkflowId: common.StringPtr(task.WorkflowID),
+					RunId:      common.StringPtr(task.RunID),
+				},
+				Data:   &data,
+				TaskID: nextTaskID,
+			})
+			nextTaskID++
+			readLevel = task.TaskID
+		}
+		if err := m.createTasks(taskList, tasks); err != nil {
+			return count, err
+		}
+		count += len(resp.Tasks)
+	}
+}
+
+func (m *migrator) createTasks(taskList *p.TaskListInfo, tasks []*p.CreateTaskInfo) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	_, err := m.target.taskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: taskList,
+		Tasks:        tasks,
+	})
+	return err
+}
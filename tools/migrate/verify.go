@@ -0,0 +1,377 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"math"
+	"sort"
+	"sync"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// shardDigest summarizes the executions of a shard, the digests of the
+	// executions are XORed so that the listing order does not matter
+	shardDigest struct {
+		count  int
+		digest [sha256.Size]byte
+	}
+
+	// verifyReport collects the differences found between source and target
+	verifyReport struct {
+		sync.Mutex
+		mismatches []string
+	}
+)
+
+// verify compares the source and the target: the domains, the executions of
+// every shard including their history, the pending tasks of the discovered
+// task lists and the visibility record counts
+func (m *migrator) verify() error {
+	report := &verifyReport{}
+	if err := m.verifyDomains(report); err != nil {
+		return err
+	}
+
+	var failed int
+	m.forEachShard(func(shardID int) error {
+		source, err := m.digestShard(m.source, shardID)
+		if err != nil {
+			m.logger.Error("error reading source shard", tag.ShardID(shardID), tag.Error(err))
+			return err
+		}
+		target, err := m.digestShard(m.target, shardID)
+		if err != nil {
+			m.logger.Error("error reading target shard", tag.ShardID(shardID), tag.Error(err))
+			return err
+		}
+		if source.count != target.count {
+			report.add("shard %v: source has %v executions, target has %v", shardID, source.count, target.count)
+		} else if source.digest != target.digest {
+			report.add("shard %v: executions differ", shardID)
+		}
+		return nil
+	}, &failed)
+	if failed > 0 {
+		return fmt.Errorf("verifying %v shards failed", failed)
+	}
+
+	if err := m.verifyTaskLists(report); err != nil {
+		return err
+	}
+	if err := m.verifyVisibility(report); err != nil {
+		return err
+	}
+
+	if len(report.mismatches) == 0 {
+		fmt.Println("source and target match")
+		return nil
+	}
+	sort.Strings(report.mismatches)
+	for _, mismatch := range report.mismatches {
+		fmt.Println(mismatch)
+	}
+	return fmt.Errorf("found %v mismatches between source and target", len(report.mismatches))
+}
+
+func (m *migrator) verifyDomains(report *verifyReport) error {
+	sourceDomains, err := m.listDomains(m.source)
+	if err != nil {
+		return err
+	}
+	targetDomains, err := m.listDomains(m.target)
+	if err != nil {
+		return err
+	}
+	targetDigests := make(map[string]string, len(targetDomains))
+	for _, domain := range targetDomains {
+		targetDigests[domain.Info.ID] = domainDigest(domain)
+	}
+	for _, domain := range sourceDomains {
+		digest, ok := targetDigests[domain.Info.ID]
+		switch {
+		case !ok:
+			report.add("domain %v: missing in target", domain.Info.Name)
+		case digest != domainDigest(domain):
+			report.add("domain %v: differs", domain.Info.Name)
+		}
+		delete(targetDigests, domain.Info.ID)
+	}
+	for domainID := range targetDigests {
+		report.add("domain %v: missing in source", domainID)
+	}
+	return nil
+}
+
+func domainDigest(domain *p.GetDomainResponse) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%v|%v|%v|%v|%v|%v|%v|%v|%v",
+		domain.Info.ID,
+		domain.Info.Name,
+		domain.Info.Status,
+		domain.Info.Description,
+		domain.Info.OwnerEmail,
+		domain.Config.Retention,
+		domain.Config.EmitMetric,
+		domain.IsGlobalDomain,
+		domain.FailoverVersion,
+	)
+	if domain.ReplicationConfig != nil {
+		fmt.Fprintf(h, "|%v", domain.ReplicationConfig.ActiveClusterName)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func (m *migrator) digestShard(st *store, shardID int) (*shardDigest, error) {
+	executionMgr, err := st.factory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	defer executionMgr.Close()
+
+	result := &shardDigest{}
+	var pageToken []byte
+	for {
+		resp, err := executionMgr.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  m.pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range resp.Executions {
+			digest, err := m.digestExecution(st, executionMgr, shardID, key)
+			if err != nil {
+				return nil, err
+			}
+			for i := range digest {
+				result.digest[i] ^= digest[i]
+			}
+			result.count++
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// digestExecution hashes the parts of an execution the migration has to keep
+// identical: its state, the IDs of its pending items, whether it is the
+// current run and the events of its history branches
+func (m *migrator) digestExecution(
+	st *store,
+	executionMgr p.ExecutionManager,
+	shardID int,
+	key *p.ConcreteExecutionKey,
+) ([]byte, error) {
+	resp, err := executionMgr.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID: key.DomainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(key.WorkflowID),
+			RunId:      common.StringPtr(key.RunID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	current, err := executionMgr.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+		DomainID:   key.DomainID,
+		WorkflowID: key.WorkflowID,
+	})
+	if _, ok := err.(*workflow.EntityNotExistsError); !ok && err != nil {
+		return nil, err
+	}
+	isCurrent := current != nil && current.RunID == key.RunID
+
+	state := resp.State
+	info := state.ExecutionInfo
+	h := sha256.New()
+	fmt.Fprintf(h, "%v|%v|%v|%v|%v|%v|%v|%v|%v|%v",
+		key.DomainID,
+		key.WorkflowID,
+		key.RunID,
+		isCurrent,
+		info.State,
+		info.CloseStatus,
+		info.NextEventID,
+		info.LastFirstEventID,
+		info.LastProcessedEvent,
+		len(state.BufferedEvents),
+	)
+
+	var ids []int64
+	for id := range state.ActivityInfos {
+		ids = append(ids, id)
+	}
+	for id := range state.ChildExecutionInfos {
+		ids = append(ids, id)
+	}
+	for id := range state.RequestCancelInfos {
+		ids = append(ids, id)
+	}
+	for id := range state.SignalInfos {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	fmt.Fprintf(h, "|%v", ids)
+	var names []string
+	for id := range state.TimerInfos {
+		names = append(names, id)
+	}
+	for id := range state.SignalRequestedIDs {
+		names = append(names, id)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(h, "|%v", names)
+
+	var branchTokens [][]byte
+	if state.VersionHistories != nil {
+		for _, history := range state.VersionHistories.ToThrift().Histories {
+			branchTokens = append(branchTokens, history.BranchToken)
+		}
+	} else {
+		branchTokens = append(branchTokens, info.BranchToken)
+	}
+	for _, branchToken := range branchTokens {
+		if len(branchToken) == 0 {
+			continue
+		}
+		if err := m.digestBranch(st, shardID, branchToken, h); err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+func (m *migrator) digestBranch(st *store, shardID int, branchToken []byte, h hash.Hash) error {
+	var pageToken []byte
+	for {
+		resp, err := st.historyMgr.ReadHistoryBranchByBatch(&p.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    common.EndEventID,
+			PageSize:      m.pageSize,
+			NextPageToken: pageToken,
+			ShardID:       common.IntPtr(shardID),
+		})
+		if err != nil {
+			if _, ok := err.(*workflow.EntityNotExistsError); ok {
+				return nil
+			}
+			return err
+		}
+		for _, batch := range resp.History {
+			for _, event := range batch.Events {
+				fmt.Fprintf(h, "|%v:%v:%v:%v",
+					event.GetEventId(), event.GetVersion(), event.GetEventType(), event.GetTimestamp())
+			}
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (m *migrator) verifyTaskLists(report *verifyReport) error {
+	for _, key := range m.checkpoint.taskLists() {
+		source, err := m.countTasks(m.source, key)
+		if err != nil {
+			return err
+		}
+		target, err := m.countTasks(m.target, key)
+		if err != nil {
+			return err
+		}
+		if source != target {
+			report.add("task list %v: source has %v tasks, target has %v", key, source, target)
+		}
+	}
+	return nil
+}
+
+func (m *migrator) countTasks(st *store, key taskListKey) (int, error) {
+	count := 0
+	readLevel := int64(0)
+	for {
+		resp, err := st.taskMgr.GetTasks(&p.GetTasksRequest{
+			DomainID:     key.DomainID,
+			TaskList:     key.Name,
+			TaskType:     key.TaskType,
+			ReadLevel:    readLevel,
+			MaxReadLevel: common.Int64Ptr(math.MaxInt64),
+			BatchSize:    m.pageSize,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(resp.Tasks) == 0 {
+			return count, nil
+		}
+		count += len(resp.Tasks)
+		readLevel = resp.Tasks[len(resp.Tasks)-1].TaskID
+	}
+}
+
+func (m *migrator) verifyVisibility(report *verifyReport) error {
+	domains, err := m.listDomains(m.source)
+	if err != nil {
+		return err
+	}
+	noop := func(*workflow.WorkflowExecutionInfo) error { return nil }
+	for _, domain := range domains {
+		sourceOpen, err := m.forEachVisibilityRecord(domain, m.source.visibilityMgr.ListOpenWorkflowExecutions, noop)
+		if err != nil {
+			return err
+		}
+		targetOpen, err := m.forEachVisibilityRecord(domain, m.target.visibilityMgr.ListOpenWorkflowExecutions, noop)
+		if err != nil {
+			return err
+		}
+		if sourceOpen != targetOpen {
+			report.add("domain %v: source has %v open records, target has %v", domain.Info.Name, sourceOpen, targetOpen)
+		}
+		sourceClosed, err := m.forEachVisibilityRecord(domain, m.source.visibilityMgr.ListClosedWorkflowExecutions, noop)
+		if err != nil {
+			return err
+		}
+		targetClosed, err := m.forEachVisibilityRecord(domain, m.target.visibilityMgr.ListClosedWorkflowExecutions, noop)
+		if err != nil {
+			return err
+		}
+		if sourceClosed != targetClosed {
+			report.add("domain %v: source has %v closed records, target has %v", domain.Info.Name, sourceClosed, targetClosed)
+		}
+	}
+	return nil
+}
+
+func (r *verifyReport) add(format string, args ...interface{}) {
+	r.Lock()
+	defer r.Unlock()
+	r.mismatches = append(r.mismatches, fmt.Sprintf(format, args...))
+}
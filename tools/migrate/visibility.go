@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// openRecordTimeout is the workflow timeout given to the copied open records.
+// The timeout is only used for the TTL of open records on cassandra, which are
+// deleted anyway once the workflow closes, so the record is simply kept around
+const openRecordTimeout = int64(20 * 365 * 24 * time.Hour / time.Second)
+
+type visibilityPage func(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error)
+
+// migrateVisibility copies the open and closed records of every domain.
+// Advanced visibility records live in elasticsearch and are not copied
+func (m *migrator) migrateVisibility() error {
+	domains, err := m.listDomains(m.source)
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains {
+		if m.checkpoint.visibilityDone(domain.Info.ID) {
+			continue
+		}
+		open, err := m.forEachVisibilityRecord(domain, m.source.visibilityMgr.ListOpenWorkflowExecutions,
+			func(info *workflow.WorkflowExecutionInfo) error {
+				return m.target.visibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+					DomainUUID:         domain.Info.ID,
+					Domain:             domain.Info.Name,
+					Execution:          *info.Execution,
+					WorkflowTypeName:   info.Type.GetName(),
+					StartTimestamp:     info.GetStartTime(),
+					ExecutionTimestamp: info.GetExecutionTime(),
+					WorkflowTimeout:    openRecordTimeout,
+					Memo:               info.Memo,
+					SearchAttributes:   info.SearchAttributes.GetIndexedFields(),
+				})
+			})
+		if err != nil {
+			return fmt.Errorf("error copying open visibility records of %v: %v", domain.Info.Name, err)
+		}
+		closed, err := m.forEachVisibilityRecord(domain, m.source.visibilityMgr.ListClosedWorkflowExecutions,
+			func(info *workflow.WorkflowExecutionInfo) error {
+				return m.target.visibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+					DomainUUID:         domain.Info.ID,
+					Domain:             domain.Info.Name,
+					Execution:          *info.Execution,
+					WorkflowTypeName:   info.Type.GetName(),
+					StartTimestamp:     info.GetStartTime(),
+					ExecutionTimestamp: info.GetExecutionTime(),
+					CloseTimestamp:     info.GetCloseTime(),
+					Status:             info.GetCloseStatus(),
+					HistoryLength:      info.GetHistoryLength(),
+					RetentionSeconds:   int64(domain.Config.Retention) * 24 * 60 * 60,
+					Memo:               info.Memo,
+					SearchAttributes:   info.SearchAttributes.GetIndexedFields(),
+				})
+			})
+		if err != nil {
+			return fmt.Errorf("error copying closed visibility records of %v: %v", domain.Info.Name, err)
+		}
+
+		m.logger.Info("copied visibility records",
+			tag.WorkflowDomainName(domain.Info.Name),
+			tag.Number(int64(open+closed)))
+		if err := m.checkpoint.completeVisibility(domain.Info.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forEachVisibilityRecord pages through the records of a domain started up to
+// now and returns how many it went through
+func (m *migrator) forEachVisibilityRecord(
+	domain *p.GetDomainResponse,
+	list visibilityPage,
+	fn func(info *workflow.WorkflowExecutionInfo) error,
+) (int, error) {
+	count := 0
+	request := &p.ListWorkflowExecutionsRequest{
+		DomainUUID:        domain.Info.ID,
+		Domain:            domain.Info.Name,
+		EarliestStartTime: 0,
+		LatestStartTime:   time.Now().UnixNano(),
+		PageSize:          m.pageSize,
+	}
+	for {
+		resp, err := list(request)
+		if err != nil {
+			return count, err
+		}
+		for _, info := range resp.Executions {
+			if err := fn(info); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if request.NextPageToken = resp.NextPageToken; len(request.NextPageToken) == 0 {
+			return count, nil
+		}
+	}
+}
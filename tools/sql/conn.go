@@ -22,11 +22,14 @@ package sql
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/tools/common/schema"
 	"github.com/uber/cadence/tools/sql/mysql"
+	"github.com/uber/cadence/tools/sql/postgres"
+	"github.com/uber/cadence/tools/sql/sqlite"
 )
 
 type (
@@ -39,8 +42,20 @@ type (
 		driverName string
 	}
 	sqlConn struct {
-		database string
-		db       *sqlx.DB
+		driverName string
+		database   string
+		db         *sqlx.DB
+	}
+	columnRow struct {
+		TableName  string `db:"table_name"`
+		ColumnName string `db:"column_name"`
+		ColumnType string `db:"column_type"`
+		IsNullable string `db:"is_nullable"`
+	}
+	indexRow struct {
+		TableName  string `db:"table_name"`
+		IndexName  string `db:"index_name"`
+		Definition string `db:"definition"`
 	}
 )
 
@@ -64,23 +79,86 @@ const (
 		`new_version VARCHAR(64), ` +
 		`old_version VARCHAR(64), ` +
 		`PRIMARY KEY (year, month, update_time));`
+
+	writeSchemaVersionPostgresSQL = `INSERT into schema_version(db_name, creation_time, curr_version, min_compatible_version) VALUES ($1,$2,$3,$4) ` +
+		`ON CONFLICT (db_name) DO UPDATE ` +
+		`SET creation_time = excluded.creation_time, curr_version = excluded.curr_version, min_compatible_version = excluded.min_compatible_version`
+
+	createSchemaVersionTablePostgresSQL = `CREATE TABLE schema_version(db_name VARCHAR(255) not null PRIMARY KEY, ` +
+		`creation_time TIMESTAMP, ` +
+		`curr_version VARCHAR(64), ` +
+		`min_compatible_version VARCHAR(64));`
+
+	createSchemaUpdateHistoryTablePostgresSQL = `CREATE TABLE schema_update_history(` +
+		`year int not null, ` +
+		`month int not null, ` +
+		`update_time TIMESTAMP not null, ` +
+		`description VARCHAR(255), ` +
+		`manifest_md5 VARCHAR(64), ` +
+		`new_version VARCHAR(64), ` +
+		`old_version VARCHAR(64), ` +
+		`PRIMARY KEY (year, month, update_time));`
+
+	listTablesPostgresSQL = `SELECT table_name FROM information_schema.tables WHERE table_schema = 'public'`
+
+	// sqlite_ tables are internal to sqlite and cannot be dropped
+	listTablesSQLiteSQL = `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'`
+
+	describeColumnsSQL = `SELECT table_name AS table_name, column_name AS column_name, ` +
+		`column_type AS column_type, is_nullable AS is_nullable ` +
+		`FROM information_schema.columns WHERE table_schema = ?`
+
+	// the columns of an index are concatenated in their index order
+	describeIndexesSQL = `SELECT table_name AS table_name, index_name AS index_name, ` +
+		`CONCAT(IF(non_unique = 0, 'UNIQUE ', ''), '(', GROUP_CONCAT(column_name ORDER BY seq_in_index), ')') AS definition ` +
+		`FROM information_schema.statistics WHERE table_schema = ? GROUP BY table_name, index_name, non_unique`
+
+	describeColumnsPostgresSQL = `SELECT table_name, column_name, data_type AS column_type, is_nullable ` +
+		`FROM information_schema.columns WHERE table_schema = 'public'`
+
+	describeIndexesPostgresSQL = `SELECT tablename AS table_name, indexname AS index_name, indexdef AS definition ` +
+		`FROM pg_indexes WHERE schemaname = 'public'`
+
+	describeColumnsSQLiteSQL = `SELECT m.name AS table_name, c.name AS column_name, c.type AS column_type, ` +
+		`CASE c."notnull" WHEN 0 THEN 'YES' ELSE 'NO' END AS is_nullable ` +
+		`FROM sqlite_master m JOIN pragma_table_info(m.name) c ` +
+		`WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%'`
+
+	// indexes sqlite creates for primary keys and unique constraints have no sql,
+	// their names are derived from the table name so comparing them is enough
+	describeIndexesSQLiteSQL = `SELECT tbl_name AS table_name, name AS index_name, IFNULL(sql, '') AS definition ` +
+		`FROM sqlite_master WHERE type = 'index'`
 )
 
-var _ schema.DB = (*sqlConn)(nil)
+var _ schema.InspectableDB = (*sqlConn)(nil)
 
 func newConn(params *sqlConnectParams) (*sqlConn, error) {
-	if params.driverName != mysql.DriverName {
+	var db *sqlx.DB
+	var err error
+	switch params.driverName {
+	case mysql.DriverName:
+		db, err = mysql.NewConnection(params.host, params.port, params.user, params.password, params.database)
+	case postgres.DriverName:
+		db, err = postgres.NewConnection(params.host, params.port, params.user, params.password, params.database)
+	case sqlite.DriverName:
+		db, err = sqlite.NewConnection(params.database)
+	default:
 		return nil, fmt.Errorf("unsupported database driver: %v", params.driverName)
 	}
-	db, err := mysql.NewConnection(params.host, params.port, params.user, params.password, params.database)
 	if err != nil {
 		return nil, err
 	}
-	return &sqlConn{db: db, database: params.database}, nil
+	return &sqlConn{db: db, database: params.database, driverName: params.driverName}, nil
 }
 
 // CreateSchemaVersionTables sets up the schema version tables
 func (c *sqlConn) CreateSchemaVersionTables() error {
+	if c.driverName == postgres.DriverName {
+		if err := c.Exec(createSchemaVersionTablePostgresSQL); err != nil {
+			return err
+		}
+		return c.Exec(createSchemaUpdateHistoryTablePostgresSQL)
+	}
 	if err := c.Exec(createSchemaVersionTableSQL); err != nil {
 		return err
 	}
@@ -90,20 +168,24 @@ func (c *sqlConn) CreateSchemaVersionTables() error {
 // ReadSchemaVersion returns the current schema version for the keyspace
 func (c *sqlConn) ReadSchemaVersion() (string, error) {
 	var version string
-	err := c.db.Get(&version, readSchemaVersionSQL, c.database)
+	err := c.db.Get(&version, c.db.Rebind(readSchemaVersionSQL), c.database)
 	return version, err
 }
 
 // UpdateShemaVersion updates the schema version for the keyspace
 func (c *sqlConn) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
-	_, err := c.db.Exec(writeSchemaVersionSQL, c.database, time.Now(), newVersion, minCompatibleVersion)
+	query := writeSchemaVersionSQL
+	if c.driverName == postgres.DriverName {
+		query = writeSchemaVersionPostgresSQL
+	}
+	_, err := c.db.Exec(query, c.database, time.Now(), newVersion, minCompatibleVersion)
 	return err
 }
 
 // WriteSchemaUpdateLog adds an entry to the schema update history table
 func (c *sqlConn) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
 	now := time.Now().UTC()
-	_, err := c.db.Exec(writeSchemaUpdateHistorySQL, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
+	_, err := c.db.Exec(c.db.Rebind(writeSchemaUpdateHistorySQL), now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
 	return err
 }
 
@@ -116,10 +198,72 @@ func (c *sqlConn) Exec(stmt string) error {
 // ListTables returns a list of tables in this database
 func (c *sqlConn) ListTables() ([]string, error) {
 	var tables []string
+	switch c.driverName {
+	case postgres.DriverName:
+		err := c.db.Select(&tables, listTablesPostgresSQL)
+		return tables, err
+	case sqlite.DriverName:
+		err := c.db.Select(&tables, listTablesSQLiteSQL)
+		return tables, err
+	}
 	err := c.db.Select(&tables, fmt.Sprintf("SHOW TABLES FROM %v", c.database))
 	return tables, err
 }
 
+// DescribeSchema returns the tables of this database along with their columns and indexes
+func (c *sqlConn) DescribeSchema() (*schema.Description, error) {
+	tables, err := c.ListTables()
+	if err != nil {
+		return nil, err
+	}
+	desc := &schema.Description{Tables: make(map[string]*schema.TableDescription, len(tables))}
+	for _, name := range tables {
+		desc.Tables[name] = &schema.TableDescription{
+			Columns: make(map[string]string),
+			Indexes: make(map[string]string),
+		}
+	}
+
+	var columns []columnRow
+	var indexes []indexRow
+	switch c.driverName {
+	case postgres.DriverName:
+		err = c.db.Select(&columns, describeColumnsPostgresSQL)
+		if err == nil {
+			err = c.db.Select(&indexes, describeIndexesPostgresSQL)
+		}
+	case sqlite.DriverName:
+		err = c.db.Select(&columns, describeColumnsSQLiteSQL)
+		if err == nil {
+			err = c.db.Select(&indexes, describeIndexesSQLiteSQL)
+		}
+	default:
+		err = c.db.Select(&columns, describeColumnsSQL, c.database)
+		if err == nil {
+			err = c.db.Select(&indexes, describeIndexesSQL, c.database)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, column := range columns {
+		if t, ok := desc.Tables[column.TableName]; ok {
+			nullable := "NOT NULL"
+			if column.IsNullable == "YES" {
+				nullable = "NULL"
+			}
+			t.Columns[column.ColumnName] = fmt.Sprintf("%v %v", column.ColumnType, nullable)
+		}
+	}
+	for _, index := range indexes {
+		if t, ok := desc.Tables[index.TableName]; ok {
+			t.Indexes[index.IndexName] = index.Definition
+		}
+	}
+	return desc, nil
+}
+
 // DropTable drops a given table from the database
 func (c *sqlConn) DropTable(name string) error {
 	return c.Exec(fmt.Sprintf("DROP TABLE %v", name))
@@ -141,11 +285,29 @@ func (c *sqlConn) DropAllTables() error {
 
 // CreateDatabase creates a database if it doesn't exist
 func (c *sqlConn) CreateDatabase(name string) error {
+	switch c.driverName {
+	case postgres.DriverName:
+		return c.Exec(fmt.Sprintf("CREATE database %v", name))
+	case sqlite.DriverName:
+		// a sqlite database is a file, which gets created on first connect
+		db, err := sqlite.NewConnection(name)
+		if err != nil {
+			return err
+		}
+		return db.Close()
+	}
 	return c.Exec(fmt.Sprintf("CREATE database %v CHARACTER SET UTF8", name))
 }
 
 // DropDatabase drops a database
 func (c *sqlConn) DropDatabase(name string) error {
+	if c.driverName == sqlite.DriverName {
+		err := os.Remove(name)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
 	return c.Exec(fmt.Sprintf("DROP database %v", name))
 }
 
@@ -72,6 +72,37 @@ func updateSchema(cli *cli.Context) error {
 	return nil
 }
 
+// validateSchema executes the validateSchemaTask, the expected
+// schema is built in a temporary database dropped afterwards
+func validateSchema(cli *cli.Context) error {
+	params, err := parseConnectParams(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	conn, err := newConn(params)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer conn.Close()
+
+	expectedParams := *params
+	expectedParams.database = schema.ValidateDBName
+	if err := doCreateDatabase(expectedParams, expectedParams.database); err != nil {
+		return handleErr(fmt.Errorf("error creating validation database: %v", err))
+	}
+	defer doDropDatabase(expectedParams, expectedParams.database)
+	expectedConn, err := newConn(&expectedParams)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer expectedConn.Close()
+
+	if err := schema.Validate(cli, conn, expectedConn); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context) error {
 	params, err := parseConnectParams(cli)
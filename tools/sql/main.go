@@ -84,7 +84,7 @@ func buildCLIOptions() *cli.App {
 		cli.StringFlag{
 			Name:   schema.CLIFlagDriverName,
 			Value:  "mysql",
-			Usage:  "name of the sql driver",
+			Usage:  "name of the sql driver, one of mysql, postgres or sqlite3",
 			EnvVar: "SQL_DRIVER",
 		},
 		cli.BoolFlag{
@@ -137,11 +137,29 @@ func buildCLIOptions() *cli.App {
 					Name:  schema.CLIFlagDryrun,
 					Usage: "do a dryrun",
 				},
+				cli.BoolFlag{
+					Name:  schema.CLIFlagPrintOnly,
+					Usage: "print the version transitions and statements of the update without executing them",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema)
 			},
 		},
+		{
+			Name:    "validate-schema",
+			Aliases: []string{"validate"},
+			Usage:   "compare the live sql schema with the schema expected for its recorded version",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, validateSchema)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // needed to load the sqlite driver
+)
+
+const (
+	dataSourceName = "%s?_busy_timeout=10000"
+	// memoryDatabase is used when no database file is specified
+	memoryDatabase = ":memory:"
+)
+
+// DriverName refers to the name of the sqlite driver
+const DriverName = "sqlite3"
+
+// NewConnection returns a new connection to sqlite database. The database
+// is the path of the database file, which is created if it does not exist
+func NewConnection(database string) (*sqlx.DB, error) {
+	if database == "" {
+		database = memoryDatabase
+	}
+	db, err := sqlx.Connect(DriverName, fmt.Sprintf(dataSourceName, database))
+	if err != nil {
+		return nil, err
+	}
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
@@ -65,5 +65,19 @@ func (s *UpdateSchemaTestSuite) TestDryrun() {
 	s.Nil(err)
 	defer conn.Close()
 	dir := "../../schema/mysql/v57/cadence/versioned"
-	s.RunDryrunTest(buildCLIOptions(), conn, "--db", dir, "0.2")
+	s.RunDryrunTest(buildCLIOptions(), conn, "--db", dir, "0.3")
+}
+
+func (s *UpdateSchemaTestSuite) TestValidateSchema() {
+	conn, err := newTestConn(s.DBName)
+	s.Nil(err)
+	defer conn.Close()
+	expectedDBName := s.DBName + "_expected"
+	s.Nil(conn.CreateDatabase(expectedDBName))
+	defer conn.DropDatabase(expectedDBName)
+	expectedConn, err := newTestConn(expectedDBName)
+	s.Nil(err)
+	defer expectedConn.Close()
+	dir := "../../schema/mysql/v57/cadence/versioned"
+	s.RunValidateSchemaTest(buildCLIOptions(), conn, expectedConn, "--db", dir)
 }